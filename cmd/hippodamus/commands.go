@@ -0,0 +1,78 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+)
+
+// command is a subcommand, selected by the first argument
+type command struct {
+	Name    string
+	Usage   string // Arguments after the command name
+	Summary string
+	Run     func(c command, args []string) error
+}
+
+// commands are the subcommands of hippodamus, in the order they are listed
+var commands = []command{
+	{
+		Name:    "gallery",
+		Usage:   "[-t templates] [-o gallery.drawio|gallery.svg] [-columns N] [-only templates|providers]",
+		Summary: "Render every template and provider resource example into a catalog",
+		Run:     runGallery,
+	},
+	{
+		Name:    "templates",
+		Usage:   "list | show <key> | docs -o <dir> | lint",
+		Summary: "List templates, show a template, generate Markdown reference pages for hives or lint templates",
+		Run:     runTemplates,
+	},
+	{
+		Name:    "test",
+		Usage:   "[-t templates] [-update] [hive | hive/config ...]",
+		Summary: "Render the configs of the template hives and compare them with their golden files",
+		Run:     runTest,
+	},
+}
+
+// findCommand returns the subcommand called name
+func findCommand(name string) (command, bool) {
+	for _, command := range commands {
+		if command.Name == name {
+			return command, true
+		}
+	}
+	return command{}, false
+}
+
+// runCommand runs a subcommand and exits with status 1 when it fails
+func runCommand(command command, args []string) {
+	err := command.Run(command, args)
+	switch {
+	case err == nil:
+	case errors.Is(err, flag.ErrHelp):
+		// The flag set printed the usage of the command
+	default:
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// newFlags returns the flag set of a subcommand, which prints the usage of the command
+func (c command) newFlags() *flag.FlagSet {
+	flags := flag.NewFlagSet(c.Name, flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s %s %s\n\n%s\n\nOptions:\n", os.Args[0], c.Name, c.Usage, c.Summary)
+		flags.PrintDefaults()
+	}
+	return flags
+}
+
+// printCommands lists the subcommands in the usage
+func printCommands() {
+	for _, command := range commands {
+		fmt.Fprintf(os.Stderr, "  %-10s %s\n", command.Name, command.Summary)
+	}
+}
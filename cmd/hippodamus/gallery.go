@@ -0,0 +1,85 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/LederWorks/hippodamus/pkg/drawio"
+	"github.com/LederWorks/hippodamus/pkg/gallery"
+	"github.com/LederWorks/hippodamus/pkg/templates"
+)
+
+// runGallery renders the templates of a templates directory and the resource examples of
+// the builtin providers into a .drawio or .svg catalog
+func runGallery(c command, args []string) error {
+	var templatesDir, outputFile, only string
+	var columns int
+
+	flags := c.newFlags()
+	flags.StringVar(&templatesDir, "templates", "", "Templates directory path")
+	flags.StringVar(&templatesDir, "t", "", "Templates directory path (short form)")
+	flags.StringVar(&outputFile, "output", "gallery.drawio", "Output file path (.drawio, .xml or .svg)")
+	flags.StringVar(&outputFile, "o", "gallery.drawio", "Output file path (short form)")
+	flags.IntVar(&columns, "columns", gallery.DefaultColumns, "Items per row")
+	flags.StringVar(&only, "only", "", "Show only templates or only providers")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	options := gallery.Options{Columns: columns, Templates: true, Providers: true}
+	switch only {
+	case "":
+	case "templates":
+		options.Providers = false
+	case "providers":
+		options.Templates = false
+	default:
+		return fmt.Errorf("-only must be templates or providers, got %q", only)
+	}
+
+	templateProcessor := templates.NewTemplateProcessor(templatesDir)
+	if templatesDir != "" {
+		if err := templateProcessor.LoadTemplates(); err != nil {
+			return fmt.Errorf("failed to load templates: %w", err)
+		}
+	}
+
+	config, err := gallery.Build(templateProcessor, options)
+	if err != nil {
+		return fmt.Errorf("failed to build gallery: %w", err)
+	}
+
+	document, err := drawio.NewGenerator().Generate(config)
+	if err != nil {
+		return fmt.Errorf("failed to generate draw.io XML: %w", err)
+	}
+
+	if strings.EqualFold(filepath.Ext(outputFile), ".svg") {
+		err = writeSVG(document, outputFile)
+	} else {
+		err = writeDrawioXML(document, outputFile)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write output file: %w", err)
+	}
+
+	fmt.Printf("Successfully rendered a gallery of %d pages to %s\n", len(config.Diagram.Pages), outputFile)
+	return nil
+}
+
+// writeSVG writes the SVG preview of a document to a file
+func writeSVG(document *drawio.DrawioDocument, filename string) error {
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		return err
+	}
+
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return drawio.WriteSVG(file, document)
+}
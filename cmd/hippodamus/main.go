@@ -2,18 +2,29 @@ package main
 
 import (
 	"encoding/xml"
+	"errors"
 	"flag"
 	"fmt"
 	"os"
 	"path/filepath"
-
-	"gopkg.in/yaml.v3"
+	"sort"
+	"time"
 
 	"github.com/LederWorks/hippodamus/pkg/drawio"
+	"github.com/LederWorks/hippodamus/pkg/loader"
+	"github.com/LederWorks/hippodamus/pkg/plugin"
 	"github.com/LederWorks/hippodamus/pkg/providers"
 	"github.com/LederWorks/hippodamus/pkg/schema"
 	"github.com/LederWorks/hippodamus/pkg/templates"
+	"github.com/LederWorks/hippodamus/pkg/views"
+	"github.com/LederWorks/hippodamus/providers/aws"
+	"github.com/LederWorks/hippodamus/providers/azure"
+	"github.com/LederWorks/hippodamus/providers/bpmn"
+	"github.com/LederWorks/hippodamus/providers/c4"
 	"github.com/LederWorks/hippodamus/providers/core"
+	"github.com/LederWorks/hippodamus/providers/kubernetes"
+	"github.com/LederWorks/hippodamus/providers/network"
+	"github.com/LederWorks/hippodamus/providers/uml"
 )
 
 // Version information injected at build time
@@ -31,17 +42,29 @@ type Config struct {
 	ShowVersion   bool
 	ListProviders bool
 	Verbose       bool
+	View          string
+	Depth         int
+	DrillDown     string
+	PluginTimeout time.Duration
 }
 
 func main() {
-	config := parseFlags()
-
 	// Initialize built-in providers with the current application version
 	if err := initializeProviders(); err != nil {
 		fmt.Fprintf(os.Stderr, "Error initializing providers: %v\n", err)
 		os.Exit(1)
 	}
 
+	// Subcommands come before any option
+	if len(os.Args) > 1 {
+		if command, exists := findCommand(os.Args[1]); exists {
+			runCommand(command, os.Args[2:])
+			return
+		}
+	}
+
+	config := parseFlags()
+
 	if config.ShowVersion {
 		fmt.Printf("Hippodamus v%s\n", version)
 		fmt.Printf("Commit: %s\n", commit)
@@ -62,6 +85,11 @@ func main() {
 
 	if err := run(config); err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		// Show the rejected parameters of a provider resource one by one
+		var resourceErr *templates.ResourceError
+		if errors.As(err, &resourceErr) {
+			fmt.Fprintf(os.Stderr, "\n%s", resourceErr.Details())
+		}
 		os.Exit(1)
 	}
 }
@@ -80,10 +108,18 @@ func parseFlags() *Config {
 	flag.BoolVar(&config.ShowVersion, "version", false, "Show version information")
 	flag.BoolVar(&config.ListProviders, "list-providers", false, "List available providers and their resources")
 	flag.BoolVar(&config.Verbose, "verbose", false, "Enable verbose output")
+	flag.IntVar(&config.Depth, "depth", 0, "Collapse containers nested deeper than this level (overrides the page detail setting)")
+	flag.StringVar(&config.DrillDown, "drill-down", "", "Move containers of these comma-separated types, templates or resources to linked detail pages")
+	flag.DurationVar(&config.PluginTimeout, "plugin-timeout", plugin.DefaultCallTimeout, "Time allowed for each call to a provider plugin")
+	flag.StringVar(&config.View, "view", "", "Render only a view: a declared view ID or a tag expression (e.g. \"network && !internal\")")
 
 	flag.Usage = func() {
-		fmt.Fprintf(os.Stderr, "Usage: %s [options]\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Usage: %s [options]\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "       %s <command> [options]\n\n", os.Args[0])
 		fmt.Fprintf(os.Stderr, "Hippodamus v%s - YAML to Draw.io XML Converter\n\n", version)
+		fmt.Fprintf(os.Stderr, "Commands:\n")
+		printCommands()
+		fmt.Fprintf(os.Stderr, "\n")
 		fmt.Fprintf(os.Stderr, "Options:\n")
 		flag.PrintDefaults()
 		fmt.Fprintf(os.Stderr, "\nSupported output formats:\n")
@@ -94,6 +130,9 @@ func parseFlags() *Config {
 		fmt.Fprintf(os.Stderr, "  %s -i diagram.yaml -o diagram.drawio\n", os.Args[0])
 		fmt.Fprintf(os.Stderr, "  %s -input diagram.yaml -templates ./templates\n", os.Args[0])
 		fmt.Fprintf(os.Stderr, "  %s -validate -input diagram.yaml\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  %s -i diagram.yaml -view \"network && !internal\"\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  %s -i diagram.yaml -depth 2\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  %s -i diagram.yaml -drill-down group,azure-subscription\n", os.Args[0])
 	}
 
 	flag.Parse()
@@ -125,6 +164,15 @@ func run(config *Config) error {
 
 	// Initialize template processor
 	templateProcessor := templates.NewTemplateProcessor(config.TemplatesDir)
+	templateProcessor.SetPluginOptions(plugin.Options{
+		HostVersion: version,
+		CallTimeout: config.PluginTimeout,
+	})
+	defer func() {
+		if err := templateProcessor.Close(); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+		}
+	}()
 
 	// Load templates if template directory is specified
 	if config.TemplatesDir != "" {
@@ -146,12 +194,76 @@ func run(config *Config) error {
 		return fmt.Errorf("failed to process diagram templates: %w", err)
 	}
 
+	// Render tag-filtered views
+	var viewOutputs map[string]*schema.DiagramConfig
+	if config.View != "" {
+		if config.Verbose {
+			fmt.Printf("Rendering view: %s\n", config.View)
+		}
+		if err := views.Select(diagramConfig, config.View); err != nil {
+			return fmt.Errorf("failed to render view: %w", err)
+		}
+	} else {
+		viewOutputs, err = views.Expand(diagramConfig)
+		if err != nil {
+			return fmt.Errorf("failed to render views: %w", err)
+		}
+	}
+
+	// Move containers to linked detail pages
+	drillDown := views.ParseSelectors(config.DrillDown)
+	if err := views.ApplyDrillDown(diagramConfig, drillDown, templateProcessor.ResolveResource); err != nil {
+		return fmt.Errorf("failed to generate detail pages: %w", err)
+	}
+	for _, viewOutput := range viewOutputs {
+		if err := views.ApplyDrillDown(viewOutput, drillDown, templateProcessor.ResolveResource); err != nil {
+			return fmt.Errorf("failed to generate detail pages: %w", err)
+		}
+	}
+
+	// Collapse containers below the requested level of detail
+	if config.Depth < 0 {
+		return fmt.Errorf("depth must not be negative: %d", config.Depth)
+	}
+	views.ApplyDetail(diagramConfig, config.Depth)
+	for _, viewOutput := range viewOutputs {
+		views.ApplyDetail(viewOutput, config.Depth)
+	}
+
 	if config.ValidateOnly {
 		fmt.Println("YAML configuration is valid")
 		return nil
 	}
 
-	if config.Verbose {
+	if err := generateOutput(diagramConfig, config.OutputFile, config.Verbose); err != nil {
+		return err
+	}
+	fmt.Printf("Successfully converted %s to %s\n", config.InputFile, config.OutputFile)
+
+	// Views with their own output file are written next to the main output
+	outputFiles := make([]string, 0, len(viewOutputs))
+	for outputFile := range viewOutputs {
+		outputFiles = append(outputFiles, outputFile)
+	}
+	sort.Strings(outputFiles)
+
+	for _, outputFile := range outputFiles {
+		path := outputFile
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(filepath.Dir(config.OutputFile), path)
+		}
+		if err := generateOutput(viewOutputs[outputFile], path, config.Verbose); err != nil {
+			return err
+		}
+		fmt.Printf("Successfully rendered view to %s\n", path)
+	}
+
+	return nil
+}
+
+// generateOutput generates draw.io XML for a diagram and writes it to a file
+func generateOutput(diagramConfig *schema.DiagramConfig, outputFile string, verbose bool) error {
+	if verbose {
 		fmt.Printf("Generating draw.io XML output\n")
 	}
 
@@ -162,30 +274,25 @@ func run(config *Config) error {
 		return fmt.Errorf("failed to generate draw.io XML: %w", err)
 	}
 
-	if config.Verbose {
-		fmt.Printf("Writing output to: %s\n", config.OutputFile)
+	if verbose {
+		fmt.Printf("Writing output to: %s\n", outputFile)
 	}
 
 	// Write output file
-	if err := writeDrawioXML(document, config.OutputFile); err != nil {
+	if err := writeDrawioXML(document, outputFile); err != nil {
 		return fmt.Errorf("failed to write output file: %w", err)
 	}
 
-	fmt.Printf("Successfully converted %s to %s\n", config.InputFile, config.OutputFile)
 	return nil
 }
 
 func loadDiagramConfig(filename string) (*schema.DiagramConfig, error) {
-	data, err := os.ReadFile(filename)
+	// Load the configuration and resolve any included files
+	config, err := loader.LoadDiagramConfig(filename)
 	if err != nil {
 		return nil, err
 	}
 
-	var config schema.DiagramConfig
-	if err := yaml.Unmarshal(data, &config); err != nil {
-		return nil, err
-	}
-
 	// Validate required fields
 	if config.Version == "" {
 		return nil, fmt.Errorf("version field is required")
@@ -199,15 +306,15 @@ func loadDiagramConfig(filename string) (*schema.DiagramConfig, error) {
 	pageIDs := make(map[string]bool)
 	for _, page := range config.Diagram.Pages {
 		if page.ID == "" {
-			return nil, fmt.Errorf("page ID is required")
+			return nil, fmt.Errorf("%s: page ID is required", page.SourceFile)
 		}
 		if pageIDs[page.ID] {
-			return nil, fmt.Errorf("duplicate page ID: %s", page.ID)
+			return nil, fmt.Errorf("%s: duplicate page ID: %s", page.SourceFile, page.ID)
 		}
 		pageIDs[page.ID] = true
 	}
 
-	return &config, nil
+	return config, nil
 }
 
 func writeDrawioXML(document *drawio.DrawioDocument, filename string) error {
@@ -284,19 +391,26 @@ func listProviders() {
 	fmt.Println("   Example: template: \"aws-organization\"")
 }
 
-// initializeProviders registers all built-in providers with the current application version
+// initializeProviders registers all built-in providers with the current application version.
+// They are registered with their constructors because declarations with settings get their
+// own configured instance, and settings of providers that take none are rejected.
 func initializeProviders() error {
-	// Register core provider with the application version
-	coreProvider := core.NewCoreProviderWithVersion(version)
-	if err := providers.DefaultRegistry.Register(coreProvider); err != nil {
-		return fmt.Errorf("failed to register core provider '%s': %w", coreProvider.Name(), err)
+	factories := []func() providers.Provider{
+		func() providers.Provider { return core.NewCoreProviderWithVersion(version) },
+		func() providers.Provider { return aws.NewAWSProviderWithVersion(version) },
+		func() providers.Provider { return azure.NewAzureProviderWithVersion(version) },
+		func() providers.Provider { return bpmn.NewBPMNProviderWithVersion(version) },
+		func() providers.Provider { return c4.NewC4ProviderWithVersion(version) },
+		func() providers.Provider { return kubernetes.NewKubernetesProviderWithVersion(version) },
+		func() providers.Provider { return network.NewNetworkProviderWithVersion(version) },
+		func() providers.Provider { return uml.NewUMLProviderWithVersion(version) },
 	}
 
-	// TODO: Register other built-in providers when implemented
-	// awsProvider := aws.NewAWSProviderWithVersion(version)
-	// if err := providers.DefaultRegistry.Register(awsProvider); err != nil {
-	//     return fmt.Errorf("failed to register aws provider '%s': %w", awsProvider.Name(), err)
-	// }
+	for _, factory := range factories {
+		if err := providers.DefaultRegistry.RegisterFactory(factory); err != nil {
+			return fmt.Errorf("failed to register builtin provider: %w", err)
+		}
+	}
 
 	return nil
 }
@@ -0,0 +1,208 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/LederWorks/hippodamus/pkg/catalog"
+	"github.com/LederWorks/hippodamus/pkg/lint"
+	"github.com/LederWorks/hippodamus/pkg/templates"
+)
+
+// defaultTemplatesDir is the templates directory of the templates subcommands
+const defaultTemplatesDir = "templates"
+
+// templatesCommands are the subcommands of the templates command
+var templatesCommands = []command{
+	{
+		Name:    "list",
+		Usage:   "[-t templates]",
+		Summary: "List the templates by hive, with their versions",
+		Run:     runTemplatesList,
+	},
+	{
+		Name:    "show",
+		Usage:   "[-t templates] <key>",
+		Summary: "Show the parameters, dependencies and example usage of a template",
+		Run:     runTemplatesShow,
+	},
+	{
+		Name:    "docs",
+		Usage:   "[-t templates] -o <dir>",
+		Summary: "Generate a Markdown reference page for every hive",
+		Run:     runTemplatesDocs,
+	},
+	{
+		Name:    "lint",
+		Usage:   "[-t templates] [-traits a,b] [-json]",
+		Summary: "Check the templates for undefined variables, unused parameters and other mistakes",
+		Run:     runTemplatesLint,
+	},
+}
+
+// runTemplates runs a subcommand of the templates command
+func runTemplates(c command, args []string) error {
+	if len(args) > 0 {
+		for _, subcommand := range templatesCommands {
+			if subcommand.Name == args[0] {
+				subcommand.Name = c.Name + " " + subcommand.Name
+				return subcommand.Run(subcommand, args[1:])
+			}
+		}
+	}
+
+	fmt.Fprintf(os.Stderr, "Usage: %s %s <command> [options]\n\n%s\n\nCommands:\n", os.Args[0], c.Name, c.Summary)
+	for _, subcommand := range templatesCommands {
+		fmt.Fprintf(os.Stderr, "  %-10s %s\n", subcommand.Name, subcommand.Summary)
+	}
+	if len(args) == 0 {
+		return flag.ErrHelp
+	}
+	return fmt.Errorf("unknown templates command %q", args[0])
+}
+
+// addTemplatesFlag adds the templates directory option to the flags of a subcommand
+func addTemplatesFlag(flags *flag.FlagSet, templatesDir *string) {
+	flags.StringVar(templatesDir, "templates", defaultTemplatesDir, "Templates directory path")
+	flags.StringVar(templatesDir, "t", defaultTemplatesDir, "Templates directory path (short form)")
+}
+
+// loadTemplates returns a template processor with the templates of a directory
+func loadTemplates(templatesDir string) (*templates.TemplateProcessor, error) {
+	if _, err := os.Stat(templatesDir); err != nil {
+		return nil, fmt.Errorf("templates directory: %w", err)
+	}
+
+	templateProcessor := templates.NewTemplateProcessor(templatesDir)
+	if err := templateProcessor.LoadTemplates(); err != nil {
+		return nil, fmt.Errorf("failed to load templates: %w", err)
+	}
+	return templateProcessor, nil
+}
+
+func runTemplatesList(c command, args []string) error {
+	var templatesDir string
+
+	flags := c.newFlags()
+	addTemplatesFlag(flags, &templatesDir)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	templateProcessor, err := loadTemplates(templatesDir)
+	if err != nil {
+		return err
+	}
+	return catalog.WriteList(os.Stdout, templateProcessor)
+}
+
+func runTemplatesShow(c command, args []string) error {
+	var templatesDir string
+
+	flags := c.newFlags()
+	addTemplatesFlag(flags, &templatesDir)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return fmt.Errorf("expected one template key, got %d arguments", flags.NArg())
+	}
+
+	templateProcessor, err := loadTemplates(templatesDir)
+	if err != nil {
+		return err
+	}
+
+	entry, exists := catalog.Find(templateProcessor, flags.Arg(0))
+	if !exists {
+		return fmt.Errorf("template %s not found in %s", flags.Arg(0), templatesDir)
+	}
+	return catalog.WriteTemplate(os.Stdout, templateProcessor, entry)
+}
+
+func runTemplatesDocs(c command, args []string) error {
+	var templatesDir, outputDir string
+
+	flags := c.newFlags()
+	addTemplatesFlag(flags, &templatesDir)
+	flags.StringVar(&outputDir, "output", "", "Output directory, with a directory per hive")
+	flags.StringVar(&outputDir, "o", "", "Output directory (short form)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if outputDir == "" {
+		flags.Usage()
+		return fmt.Errorf("output directory is required")
+	}
+
+	templateProcessor, err := loadTemplates(templatesDir)
+	if err != nil {
+		return err
+	}
+
+	files, err := catalog.WriteDocs(templateProcessor, outputDir)
+	if err != nil {
+		return fmt.Errorf("failed to write template docs: %w", err)
+	}
+	for _, file := range files {
+		fmt.Printf("Generated %s\n", file)
+	}
+	return nil
+}
+
+func runTemplatesLint(c command, args []string) error {
+	var templatesDir, traits string
+	var jsonOutput bool
+
+	flags := c.newFlags()
+	addTemplatesFlag(flags, &templatesDir)
+	flags.StringVar(&traits, "traits", "", "Comma-separated dependency types accepted besides templates, in addition to "+strings.Join(lint.DefaultTraits, ", "))
+	flags.BoolVar(&jsonOutput, "json", false, "Write the diagnostics as JSON")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if _, err := os.Stat(templatesDir); err != nil {
+		return fmt.Errorf("templates directory: %w", err)
+	}
+
+	options := lint.Options{Traits: append([]string{}, lint.DefaultTraits...)}
+	for _, trait := range strings.Split(traits, ",") {
+		if trait = strings.TrimSpace(trait); trait != "" {
+			options.Traits = append(options.Traits, trait)
+		}
+	}
+
+	diagnostics, err := lint.Dir(templatesDir, options)
+	if err != nil {
+		return fmt.Errorf("failed to lint templates: %w", err)
+	}
+
+	if jsonOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if diagnostics == nil {
+			diagnostics = []lint.Diagnostic{}
+		}
+		if err := encoder.Encode(diagnostics); err != nil {
+			return err
+		}
+	} else {
+		errors := 0
+		for _, diagnostic := range diagnostics {
+			fmt.Println(diagnostic)
+			if diagnostic.Severity == lint.SeverityError {
+				errors++
+			}
+		}
+		fmt.Printf("%d errors, %d warnings\n", errors, len(diagnostics)-errors)
+	}
+
+	if lint.HasErrors(diagnostics) {
+		return fmt.Errorf("templates in %s have errors", templatesDir)
+	}
+	return nil
+}
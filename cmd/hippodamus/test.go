@@ -0,0 +1,85 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/LederWorks/hippodamus/pkg/hippotest"
+)
+
+// runTest renders the configs of the template hives and compares them with their golden
+// files, or rewrites the golden files with -update
+func runTest(c command, args []string) error {
+	var templatesDir string
+	var update bool
+
+	flags := c.newFlags()
+	addTemplatesFlag(flags, &templatesDir)
+	flags.BoolVar(&update, "update", false, "Rewrite the golden files with the current output")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	cases, err := hippotest.Discover(templatesDir)
+	if err != nil {
+		return fmt.Errorf("failed to find configs: %w", err)
+	}
+	cases, err = selectCases(cases, flags.Args())
+	if err != nil {
+		return err
+	}
+	if len(cases) == 0 {
+		return fmt.Errorf("no configs found in %s/*/%s", templatesDir, hippotest.ConfigsDir)
+	}
+
+	options := hippotest.Options{TemplatesDir: templatesDir, Update: update}
+	counts := make(map[hippotest.Status]int)
+	for _, testCase := range cases {
+		result := hippotest.Run(testCase, options)
+		counts[result.Status]++
+
+		fmt.Printf("%-8s %s\n", result.Status, testCase.Name)
+		if report := result.Report(); report != "" {
+			fmt.Println(indentLines(report, "    "))
+		}
+	}
+
+	fmt.Printf("\n%d passed, %d failed, %d errors", counts[hippotest.StatusPass], counts[hippotest.StatusFail], counts[hippotest.StatusError])
+	if update {
+		fmt.Printf(", %d updated", counts[hippotest.StatusUpdated])
+	}
+	fmt.Println()
+
+	if failed := counts[hippotest.StatusFail] + counts[hippotest.StatusError]; failed > 0 {
+		return fmt.Errorf("%d of %d configs failed", failed, len(cases))
+	}
+	return nil
+}
+
+// selectCases returns the cases named by the arguments, a hive or a hive/config each, or
+// all cases without arguments
+func selectCases(cases []hippotest.Case, names []string) ([]hippotest.Case, error) {
+	if len(names) == 0 {
+		return cases, nil
+	}
+
+	var selected []hippotest.Case
+	for _, name := range names {
+		found := false
+		for _, testCase := range cases {
+			if testCase.Name == name || testCase.Hive == name {
+				selected = append(selected, testCase)
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("no config %s", name)
+		}
+	}
+	return selected, nil
+}
+
+// indentLines prefixes every line of s
+func indentLines(s, prefix string) string {
+	return prefix + strings.ReplaceAll(s, "\n", "\n"+prefix)
+}
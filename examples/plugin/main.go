@@ -0,0 +1,130 @@
+// Command plugin is an example provider plugin. It serves a "badge" resource that draws a
+// rounded status label. Build it next to the demo configuration with:
+//
+//	go build -o examples/plugin/badge-plugin ./examples/plugin
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/LederWorks/hippodamus/pkg/plugin/sdk"
+	"github.com/LederWorks/hippodamus/pkg/providers"
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// statusColors are the fill colors of the supported badge statuses
+var statusColors = map[string]string{
+	"ok":      "#D5E8D4",
+	"warning": "#FFF2CC",
+	"error":   "#F8CECC",
+}
+
+// BadgeProvider provides status badges
+type BadgeProvider struct{}
+
+// Name returns the provider name
+func (p *BadgeProvider) Name() string {
+	return "badge"
+}
+
+// Version returns the provider version
+func (p *BadgeProvider) Version() string {
+	return "0.1.0"
+}
+
+// Resources returns the list of supported resources
+func (p *BadgeProvider) Resources() []providers.ResourceDefinition {
+	return []providers.ResourceDefinition{
+		{
+			Type:        "status",
+			Name:        "Status",
+			Description: "Rounded status label",
+			Category:    "annotations",
+			Schema:      p.statusSchema(),
+		},
+	}
+}
+
+// Validate validates resource parameters
+func (p *BadgeProvider) Validate(resourceType string, params map[string]interface{}) error {
+	if resourceType != "status" {
+		return &providers.ProviderError{
+			Provider: p.Name(),
+			Resource: resourceType,
+			Message:  fmt.Sprintf("unsupported resource type: %s", resourceType),
+			Code:     "UNSUPPORTED_RESOURCE",
+		}
+	}
+
+	if status, ok := params["status"].(string); ok {
+		if _, known := statusColors[status]; !known {
+			return &providers.ValidationError{
+				Field:   "status",
+				Message: fmt.Sprintf("invalid status %q, expected ok, warning or error", status),
+				Code:    "INVALID_ENUM",
+			}
+		}
+	}
+	return nil
+}
+
+// GenerateTemplate generates a status badge
+func (p *BadgeProvider) GenerateTemplate(resourceType string, params map[string]interface{}) (*schema.Element, error) {
+	if err := p.Validate(resourceType, params); err != nil {
+		return nil, err
+	}
+
+	label, _ := params["label"].(string)
+	status, _ := params["status"].(string)
+	if status == "" {
+		status = "ok"
+	}
+	x, _ := params["x"].(float64)
+	y, _ := params["y"].(float64)
+
+	return &schema.Element{
+		Type: schema.ElementTypeShape,
+		Properties: schema.ElementProperties{
+			X:      x,
+			Y:      y,
+			Width:  120,
+			Height: 30,
+			Label:  label,
+		},
+		Style: schema.Style{
+			FillColor: statusColors[status],
+			Rounded:   true,
+			Custom:    map[string]string{"arcSize": "50"},
+		},
+	}, nil
+}
+
+// GetSchema returns the JSON schema for a resource type
+func (p *BadgeProvider) GetSchema(resourceType string) (map[string]interface{}, error) {
+	if resourceType != "status" {
+		return nil, &providers.ProviderError{
+			Provider: p.Name(),
+			Resource: resourceType,
+			Message:  fmt.Sprintf("schema not found for resource type: %s", resourceType),
+			Code:     "SCHEMA_NOT_FOUND",
+		}
+	}
+	return p.statusSchema(), nil
+}
+
+func (p *BadgeProvider) statusSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"label":  map[string]interface{}{"type": "string"},
+			"status": map[string]interface{}{"type": "string", "enum": []string{"ok", "warning", "error"}},
+		},
+	}
+}
+
+func main() {
+	if err := sdk.Serve(&BadgeProvider{}); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,250 @@
+// Package catalog describes the templates loaded by a template processor: it lists them by
+// hive, shows the parameters, dependencies and usage of a template, and generates Markdown
+// reference pages for whole hives.
+package catalog
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/LederWorks/hippodamus/pkg/schema"
+	"github.com/LederWorks/hippodamus/pkg/templates"
+)
+
+// maxParents limits how many required parents are followed for the usage of a template
+const maxParents = 5
+
+// Hive is a template hive and its templates, sorted by key
+type Hive struct {
+	Name      string // Empty for the templates at the root of the templates directory
+	Templates []Entry
+}
+
+// Title returns the name of the hive for display
+func (h Hive) Title() string {
+	if h.Name == "" {
+		return "(root)"
+	}
+	return h.Name
+}
+
+// Entry is a loaded template and the key it is stored under, such as aws/aws-vpc
+type Entry struct {
+	Key      string
+	Template *schema.Template
+}
+
+// Hive returns the hive of the template, empty for templates at the root
+func (e Entry) Hive() string {
+	hive, _, found := strings.Cut(e.Key, "/")
+	if !found {
+		return ""
+	}
+	return hive
+}
+
+// Usage is an element using a template or resource, marshaled as the YAML a diagram
+// configuration needs
+type Usage struct {
+	ID         string                 `yaml:"id"`
+	Name       string                 `yaml:"name,omitempty"`
+	Template   string                 `yaml:"template,omitempty"`
+	Resource   string                 `yaml:"resource,omitempty"`
+	Properties *Properties            `yaml:"properties,omitempty"` // Template parameters
+	Parameters map[string]interface{} `yaml:"parameters,omitempty"` // Resource parameters
+	Children   []Usage                `yaml:"children,omitempty"`
+}
+
+// Properties are the element properties of a usage. Templates read their parameters from
+// the custom properties of the element they are applied to.
+type Properties struct {
+	Custom map[string]interface{} `yaml:"custom"`
+}
+
+// TemplateProperties returns the properties passing params to a template, nil without params
+func TemplateProperties(params map[string]interface{}) *Properties {
+	if len(params) == 0 {
+		return nil
+	}
+	return &Properties{Custom: params}
+}
+
+// YAML returns the usage as an item of an elements list
+func (u Usage) YAML() string {
+	var out strings.Builder
+	encoder := yaml.NewEncoder(&out)
+	encoder.SetIndent(2)
+	if err := encoder.Encode([]Usage{u}); err != nil {
+		return err.Error()
+	}
+	return strings.TrimRight(out.String(), "\n")
+}
+
+// IsTemplate reports whether a file loaded as a template defines one: it has a name and
+// parameters or a group. Template directories also hold diagram configurations and
+// examples, which the template processor loads as well.
+func IsTemplate(template *schema.Template) bool {
+	if template == nil || template.Name == "" {
+		return false
+	}
+	return len(template.Parameters) > 0 || !reflect.DeepEqual(template.Group, schema.GroupConfig{})
+}
+
+// Hives returns the hives of the templates loaded by tp, sorted by name, with the root
+// templates first
+func Hives(tp *templates.TemplateProcessor) []Hive {
+	var hives []Hive
+
+	var root []Entry
+	for _, key := range tp.ListAllTemplateKeys() {
+		if !strings.Contains(key, "/") {
+			if template, _ := tp.GetTemplate(key); IsTemplate(template) {
+				root = append(root, Entry{Key: key, Template: template})
+			}
+		}
+	}
+	if len(root) > 0 {
+		hives = append(hives, Hive{Templates: sortEntries(root)})
+	}
+
+	names := tp.ListHives()
+	sort.Strings(names)
+	for _, name := range names {
+		var entries []Entry
+		for _, templateName := range tp.ListTemplatesInHive(name) {
+			key := name + "/" + templateName
+			if template, _ := tp.GetTemplate(key); IsTemplate(template) {
+				entries = append(entries, Entry{Key: key, Template: template})
+			}
+		}
+		hives = append(hives, Hive{Name: name, Templates: sortEntries(entries)})
+	}
+	return hives
+}
+
+// sortEntries sorts entries by key, dropping templates defined twice in a hive
+func sortEntries(entries []Entry) []Entry {
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Key < entries[j].Key })
+	unique := entries[:0]
+	for i, entry := range entries {
+		if i == 0 || entry.Key != entries[i-1].Key {
+			unique = append(unique, entry)
+		}
+	}
+	return unique
+}
+
+// Find returns the template with the given key, or else the template the reference
+// resolves to from the root
+func Find(tp *templates.TemplateProcessor, ref string) (Entry, bool) {
+	key, template, exists := tp.ResolveTemplate(ref, "")
+	if !exists {
+		return Entry{}, false
+	}
+	return Entry{Key: key, Template: template}, true
+}
+
+// Defaults returns the parameters of a template that have a default, and a sample value
+// for the required parameters without one
+func Defaults(template *schema.Template) map[string]interface{} {
+	defaults := make(map[string]interface{})
+	for _, param := range template.Parameters {
+		switch {
+		case param.Default != nil:
+			defaults[param.Name] = param.Default
+		case param.Required:
+			defaults[param.Name] = sampleValue(param)
+		}
+	}
+	return defaults
+}
+
+// sampleValue returns a value of the type of a parameter
+func sampleValue(param schema.Parameter) interface{} {
+	switch param.Type {
+	case "number", "integer":
+		return 1
+	case "boolean":
+		return false
+	case "color":
+		return "#FFFFFF"
+	default:
+		return param.Name
+	}
+}
+
+// RequiredDependency returns the required parent dependency of a template, or else its
+// first required ancestor dependency
+func RequiredDependency(template *schema.Template) (schema.Dependency, bool) {
+	var ancestor *schema.Dependency
+	for i := range template.Dependencies {
+		dependency := &template.Dependencies[i]
+		switch {
+		case !dependency.Required:
+		case dependency.Relationship == "parent":
+			return *dependency, true
+		case dependency.Relationship == "ancestor" && ancestor == nil:
+			ancestor = dependency
+		}
+	}
+	if ancestor == nil {
+		return schema.Dependency{}, false
+	}
+	return *ancestor, true
+}
+
+// Parents returns the templates an element of entry must be placed in for its required
+// parent and ancestor dependencies, outermost first. Dependencies that do not resolve to
+// a loaded template end the chain.
+func Parents(tp *templates.TemplateProcessor, entry Entry) []Entry {
+	var parents []Entry
+	for len(parents) < maxParents {
+		dependency, required := RequiredDependency(entry.Template)
+		if !required {
+			break
+		}
+		key, template, exists := tp.ResolveTemplate(dependency.Type, entry.Hive())
+		if !exists {
+			break
+		}
+		entry = Entry{Key: key, Template: template}
+		parents = append([]Entry{entry}, parents...)
+	}
+	return parents
+}
+
+// Example returns an element using the template with the defaults of its parameters,
+// placed in the templates it requires
+func Example(tp *templates.TemplateProcessor, entry Entry) Usage {
+	usage := Usage{
+		ID:         "my-" + entry.Template.Name,
+		Name:       entry.Template.Name,
+		Template:   entry.Key,
+		Properties: TemplateProperties(Defaults(entry.Template)),
+	}
+
+	parents := Parents(tp, entry)
+	for i := len(parents) - 1; i >= 0; i-- {
+		parent := parents[i]
+		usage = Usage{
+			ID:         "my-" + parent.Template.Name,
+			Name:       parent.Template.Name,
+			Template:   parent.Key,
+			Properties: TemplateProperties(Defaults(parent.Template)),
+			Children:   []Usage{usage},
+		}
+	}
+	return usage
+}
+
+// formatValue returns a parameter value for display
+func formatValue(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+	return fmt.Sprint(value)
+}
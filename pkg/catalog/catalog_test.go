@@ -0,0 +1,482 @@
+package catalog
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/templates"
+	"github.com/LederWorks/hippodamus/pkg/templates/templatetest"
+)
+
+func TestHives(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+		want  []string
+	}{
+		{
+			name: "root and hive templates",
+			files: map[string]string{
+				"net/site.yaml": `name: site
+version: "1.0"
+description: A site
+parameters:
+  - name: city
+    type: string
+    default: Zurich
+group:
+  properties:
+    label: "{{.city}}"
+`,
+				"net/zone.yaml": `name: zone
+version: "2.1"
+description: A network zone | DMZ or internal
+dependencies:
+  - name: site
+    type: site
+    required: true
+    relationship: parent
+    description: Site of the zone
+parameters:
+  - name: cidr
+    type: string
+    required: true
+    description: Address range
+  - name: color
+    type: color
+    default: "#FFFFFF"
+group:
+  properties:
+    label: "{{.cidr}}"
+`,
+				"note.yaml": `name: note
+parameters:
+  - name: text
+    type: string
+    default: Hello
+group:
+  properties:
+    label: "{{.text}}"
+`,
+			},
+			want: []string{"(root): note", "net: net/site,net/zone"},
+		},
+		{
+			name: "configurations",
+			files: map[string]string{
+				"net/site.yaml": `name: site
+version: "1.0"
+description: A site
+parameters:
+  - name: city
+    type: string
+    default: Zurich
+group:
+  properties:
+    label: "{{.city}}"
+`,
+				"net/example.yaml": `version: "1.0"
+diagram:
+  pages: []
+`,
+			},
+			want: []string{"net: net/site"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, hive := range Hives(templatetest.NewProcessor(t, tt.files)) {
+				var keys []string
+				for _, entry := range hive.Templates {
+					keys = append(keys, entry.Key)
+				}
+				got = append(got, hive.Title()+": "+strings.Join(keys, ","))
+			}
+
+			if strings.Join(got, "; ") != strings.Join(tt.want, "; ") {
+				t.Errorf("Hives() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFind(t *testing.T) {
+	tp := templatetest.NewProcessor(t, map[string]string{
+		"net/zone.yaml": `name: zone
+version: "2.1"
+description: A network zone | DMZ or internal
+dependencies:
+  - name: site
+    type: site
+    required: true
+    relationship: parent
+    description: Site of the zone
+parameters:
+  - name: cidr
+    type: string
+    required: true
+    description: Address range
+  - name: color
+    type: color
+    default: "#FFFFFF"
+group:
+  properties:
+    label: "{{.cidr}}"
+`,
+		"note.yaml": `name: note
+parameters:
+  - name: text
+    type: string
+    default: Hello
+group:
+  properties:
+    label: "{{.text}}"
+`,
+	})
+
+	tests := []struct {
+		ref    string
+		key    string
+		exists bool
+	}{
+		{ref: "net/zone", key: "net/zone", exists: true},
+		{ref: "zone", key: "net/zone", exists: true},
+		{ref: "note", key: "note", exists: true},
+		{ref: "rack"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ref, func(t *testing.T) {
+			entry, exists := Find(tp, tt.ref)
+			if exists != tt.exists || entry.Key != tt.key {
+				t.Errorf("Find(%q) = %+v, %v, want %s, %v", tt.ref, entry, exists, tt.key, tt.exists)
+			}
+		})
+	}
+}
+
+func TestExample(t *testing.T) {
+	// A host is required in a zone and a zone in a site
+	tp := templatetest.NewProcessor(t, map[string]string{
+		"net/site.yaml": `name: site
+version: "1.0"
+description: A site
+parameters:
+  - name: city
+    type: string
+    default: Zurich
+group:
+  properties:
+    label: "{{.city}}"
+`,
+		"net/zone.yaml": `name: zone
+version: "2.1"
+description: A network zone | DMZ or internal
+dependencies:
+  - name: site
+    type: site
+    required: true
+    relationship: parent
+    description: Site of the zone
+parameters:
+  - name: cidr
+    type: string
+    required: true
+    description: Address range
+  - name: color
+    type: color
+    default: "#FFFFFF"
+group:
+  properties:
+    label: "{{.cidr}}"
+`,
+		"net/host.yaml": `name: host
+dependencies:
+  - name: zone
+    type: zone
+    required: true
+    relationship: parent
+  - name: rack
+    type: rack
+    relationship: parent
+parameters:
+  - name: cores
+    type: number
+    required: true
+group:
+  properties:
+    label: host
+`,
+	})
+	entry, _ := Find(tp, "net/host")
+	var parents []string
+	for _, parent := range Parents(tp, entry) {
+		parents = append(parents, parent.Key)
+	}
+	if got := strings.Join(parents, ","); got != "net/site,net/zone" {
+		t.Errorf("Parents() = %s, want net/site,net/zone", got)
+	}
+
+	want := `- id: my-site
+  name: site
+  template: net/site
+  properties:
+    custom:
+      city: Zurich
+  children:
+    - id: my-zone
+      name: zone
+      template: net/zone
+      properties:
+        custom:
+          cidr: cidr
+          color: '#FFFFFF'
+      children:
+        - id: my-host
+          name: host
+          template: net/host
+          properties:
+            custom:
+              cores: 1`
+	if got := Example(tp, entry).YAML(); got != want {
+		t.Errorf("Example() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestWriteList(t *testing.T) {
+	tp := templatetest.NewProcessor(t, map[string]string{
+		"net/site.yaml": `name: site
+version: "1.0"
+description: A site
+parameters:
+  - name: city
+    type: string
+    default: Zurich
+group:
+  properties:
+    label: "{{.city}}"
+`,
+		"net/zone.yaml": `name: zone
+version: "2.1"
+description: A network zone | DMZ or internal
+dependencies:
+  - name: site
+    type: site
+    required: true
+    relationship: parent
+    description: Site of the zone
+parameters:
+  - name: cidr
+    type: string
+    required: true
+    description: Address range
+  - name: color
+    type: color
+    default: "#FFFFFF"
+group:
+  properties:
+    label: "{{.cidr}}"
+`,
+		"net/host.yaml": `name: host
+dependencies:
+  - name: zone
+    type: zone
+    required: true
+    relationship: parent
+  - name: rack
+    type: rack
+    relationship: parent
+parameters:
+  - name: cores
+    type: number
+    required: true
+group:
+  properties:
+    label: host
+`,
+		"note.yaml": `name: note
+parameters:
+  - name: text
+    type: string
+    default: Hello
+group:
+  properties:
+    label: "{{.text}}"
+`,
+	})
+
+	var out bytes.Buffer
+	if err := WriteList(&out, tp); err != nil {
+		t.Fatalf("WriteList() error = %v", err)
+	}
+
+	want := `(root) (1 templates)
+  note  -
+
+net (3 templates)
+  net/host  -
+  net/site  v1.0  A site
+  net/zone  v2.1  A network zone | DMZ or internal
+`
+	if out.String() != want {
+		t.Errorf("WriteList() =\n%q\nwant\n%q", out.String(), want)
+	}
+}
+
+func TestWriteTemplate(t *testing.T) {
+	tp := templatetest.NewProcessor(t, map[string]string{
+		"net/site.yaml": `name: site
+version: "1.0"
+description: A site
+parameters:
+  - name: city
+    type: string
+    default: Zurich
+group:
+  properties:
+    label: "{{.city}}"
+`,
+		"net/zone.yaml": `name: zone
+version: "2.1"
+description: A network zone | DMZ or internal
+dependencies:
+  - name: site
+    type: site
+    required: true
+    relationship: parent
+    description: Site of the zone
+parameters:
+  - name: cidr
+    type: string
+    required: true
+    description: Address range
+  - name: color
+    type: color
+    default: "#FFFFFF"
+group:
+  properties:
+    label: "{{.cidr}}"
+`,
+	})
+	entry, _ := Find(tp, "zone")
+
+	var out bytes.Buffer
+	if err := WriteTemplate(&out, tp, entry); err != nil {
+		t.Fatalf("WriteTemplate() error = %v", err)
+	}
+
+	for _, want := range []string{
+		"Template: net/zone\nVersion: 2.1\n",
+		"  cidr   string           yes       Address range\n",
+		"  color  color   #FFFFFF  no",
+		"  site  site      parent        yes       Site of the zone\n",
+		"Example usage:\n  - id: my-site\n",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("WriteTemplate() does not contain %q:\n%s", want, out.String())
+		}
+	}
+}
+
+func TestWriteDocs(t *testing.T) {
+	tp := templatetest.NewProcessor(t, map[string]string{
+		"net/site.yaml": `name: site
+version: "1.0"
+description: A site
+parameters:
+  - name: city
+    type: string
+    default: Zurich
+group:
+  properties:
+    label: "{{.city}}"
+`,
+		"net/zone.yaml": `name: zone
+version: "2.1"
+description: A network zone | DMZ or internal
+dependencies:
+  - name: site
+    type: site
+    required: true
+    relationship: parent
+    description: Site of the zone
+parameters:
+  - name: cidr
+    type: string
+    required: true
+    description: Address range
+  - name: color
+    type: color
+    default: "#FFFFFF"
+group:
+  properties:
+    label: "{{.cidr}}"
+`,
+		"note.yaml": `name: note
+parameters:
+  - name: text
+    type: string
+    default: Hello
+group:
+  properties:
+    label: "{{.text}}"
+`,
+	})
+	dir := t.TempDir()
+
+	files, err := WriteDocs(tp, dir)
+	if err != nil {
+		t.Fatalf("WriteDocs() error = %v", err)
+	}
+	want := []string{filepath.Join(dir, "README.md"), filepath.Join(dir, "net", "README.md")}
+	if strings.Join(files, ",") != strings.Join(want, ",") {
+		t.Fatalf("WriteDocs() = %v, want %v", files, want)
+	}
+
+	data, err := os.ReadFile(files[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"# net Templates\n",
+		"| [`net/zone`](#zone) | 2.1 | A network zone \\| DMZ or internal |\n",
+		"## zone\n\nA network zone | DMZ or internal\n\nKey: `net/zone`, version 2.1\n",
+		"| `cidr` | string |  | yes | Address range |\n",
+		"| `color` | color | `#FFFFFF` | no |  |\n",
+		"| site | `site` | parent | yes | Site of the zone |\n",
+		"### Usage\n\n```yaml\n- id: my-site\n",
+	} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("Reference page does not contain %q:\n%s", want, data)
+		}
+	}
+}
+
+// TestHiveReadmes checks that the README.md of every hive of the repository templates
+// directory matches its templates. Regenerate them with:
+//
+//	hippodamus templates docs -t templates -o templates
+func TestHiveReadmes(t *testing.T) {
+	dir := filepath.Join("..", "..", "templates")
+	tp := templates.NewTemplateProcessor(dir)
+	if err := tp.LoadTemplates(); err != nil {
+		t.Fatalf("LoadTemplates() error = %v", err)
+	}
+
+	for _, hive := range Hives(tp) {
+		path := filepath.Join(dir, hive.Name, DocsFile)
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Errorf("Missing reference page of hive %s: %v", hive.Title(), err)
+			continue
+		}
+		if string(data) != Markdown(tp, hive) {
+			t.Errorf("%s is out of date, regenerate it with hippodamus templates docs -t templates -o templates", path)
+		}
+	}
+}
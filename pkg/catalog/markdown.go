@@ -0,0 +1,125 @@
+package catalog
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/LederWorks/hippodamus/pkg/templates"
+)
+
+// DocsFile is the name of the reference page generated in the directory of each hive
+const DocsFile = "README.md"
+
+// Markdown returns the reference page of a hive: an index of its templates followed by
+// the parameters, dependencies and example usage of each one
+func Markdown(tp *templates.TemplateProcessor, hive Hive) string {
+	var b strings.Builder
+
+	if hive.Name == "" {
+		b.WriteString("# Templates\n\n")
+	} else {
+		fmt.Fprintf(&b, "# %s Templates\n\n", hive.Name)
+	}
+	b.WriteString("<!-- Generated by `hippodamus templates docs`, do not edit. -->\n\n")
+
+	if hive.Name == "" {
+		fmt.Fprintf(&b, "Reference of the %d templates at the root of the templates directory.\n\n", len(hive.Templates))
+	} else {
+		fmt.Fprintf(&b, "Reference of the %d templates of the `%s` hive. Elements refer to them as `template: %s/<name>`,\n", len(hive.Templates), hive.Name, hive.Name)
+		b.WriteString("or by name alone from other templates of the hive.\n\n")
+	}
+
+	b.WriteString("| Template | Version | Description |\n|---|---|---|\n")
+	for _, entry := range hive.Templates {
+		fmt.Fprintf(&b, "| [`%s`](#%s) | %s | %s |\n", entry.Key, anchor(entry.Template.Name), cell(entry.Template.Version), cell(entry.Template.Description))
+	}
+
+	for _, entry := range hive.Templates {
+		b.WriteString("\n")
+		writeTemplateMarkdown(&b, tp, entry)
+	}
+	return b.String()
+}
+
+// writeTemplateMarkdown writes the section of a template
+func writeTemplateMarkdown(b *strings.Builder, tp *templates.TemplateProcessor, entry Entry) {
+	template := entry.Template
+
+	fmt.Fprintf(b, "## %s\n\n", template.Name)
+	if template.Description != "" {
+		fmt.Fprintf(b, "%s\n\n", template.Description)
+	}
+	fmt.Fprintf(b, "Key: `%s`", entry.Key)
+	if template.Version != "" {
+		fmt.Fprintf(b, ", version %s", template.Version)
+	}
+	b.WriteString("\n\n")
+
+	b.WriteString("### Parameters\n\n")
+	if len(template.Parameters) == 0 {
+		b.WriteString("None.\n\n")
+	} else {
+		b.WriteString("| Name | Type | Default | Required | Description |\n|---|---|---|---|---|\n")
+		for _, param := range template.Parameters {
+			defaultValue := ""
+			if param.Default != nil {
+				defaultValue = "`" + cell(formatValue(param.Default)) + "`"
+			}
+			fmt.Fprintf(b, "| `%s` | %s | %s | %s | %s |\n", param.Name, cell(param.Type), defaultValue, yesNo(param.Required), cell(param.Description))
+		}
+		b.WriteString("\n")
+	}
+
+	if len(template.Dependencies) > 0 {
+		b.WriteString("### Dependencies\n\n")
+		b.WriteString("| Name | Template | Relationship | Required | Description |\n|---|---|---|---|---|\n")
+		for _, dependency := range template.Dependencies {
+			fmt.Fprintf(b, "| %s | `%s` | %s | %s | %s |\n", cell(dependency.Name), dependency.Type, cell(dependency.Relationship), yesNo(dependency.Required), cell(dependency.Description))
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("### Usage\n\n```yaml\n")
+	b.WriteString(Example(tp, entry).YAML())
+	b.WriteString("\n```\n")
+}
+
+// WriteDocs generates the reference page of every hive loaded by tp into a directory of
+// dir named after the hive, and of the root templates into dir itself, and returns the
+// files written
+func WriteDocs(tp *templates.TemplateProcessor, dir string) ([]string, error) {
+	var files []string
+	for _, hive := range Hives(tp) {
+		path := filepath.Join(dir, hive.Name, DocsFile)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return files, err
+		}
+		if err := os.WriteFile(path, []byte(Markdown(tp, hive)), 0644); err != nil {
+			return files, err
+		}
+		files = append(files, path)
+	}
+	return files, nil
+}
+
+// anchor returns the GitHub anchor of a heading
+func anchor(heading string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(heading) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '-', r == '_':
+			b.WriteRune(r)
+		case r == ' ':
+			b.WriteRune('-')
+		}
+	}
+	return b.String()
+}
+
+// cell escapes text for a Markdown table cell
+func cell(text string) string {
+	text = strings.ReplaceAll(text, "|", `\|`)
+	return strings.ReplaceAll(text, "\n", " ")
+}
@@ -0,0 +1,102 @@
+package catalog
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/LederWorks/hippodamus/pkg/templates"
+)
+
+// WriteList writes the templates loaded by tp grouped by hive, with their versions and
+// descriptions
+func WriteList(w io.Writer, tp *templates.TemplateProcessor) error {
+	hives := Hives(tp)
+	if len(hives) == 0 {
+		_, err := fmt.Fprintln(w, "No templates loaded.")
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	for i, hive := range hives {
+		if i > 0 {
+			fmt.Fprintln(tw)
+		}
+		fmt.Fprintf(tw, "%s (%d templates)\n", hive.Title(), len(hive.Templates))
+		for _, entry := range hive.Templates {
+			writeRow(tw, entry.Key, version(entry.Template.Version), entry.Template.Description)
+		}
+	}
+	return tw.Flush()
+}
+
+// WriteTemplate writes the parameters, dependencies and example usage of a template
+func WriteTemplate(w io.Writer, tp *templates.TemplateProcessor, entry Entry) error {
+	template := entry.Template
+
+	fmt.Fprintf(w, "Template: %s\n", entry.Key)
+	if template.Version != "" {
+		fmt.Fprintf(w, "Version: %s\n", template.Version)
+	}
+	if template.Description != "" {
+		fmt.Fprintf(w, "Description: %s\n", template.Description)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "\nParameters:")
+	if len(template.Parameters) == 0 {
+		fmt.Fprintln(tw, "  none")
+	} else {
+		writeRow(tw, "NAME", "TYPE", "DEFAULT", "REQUIRED", "DESCRIPTION")
+		for _, param := range template.Parameters {
+			writeRow(tw, param.Name, param.Type, formatValue(param.Default), yesNo(param.Required), param.Description)
+		}
+	}
+
+	fmt.Fprintln(tw, "\nDependencies:")
+	if len(template.Dependencies) == 0 {
+		fmt.Fprintln(tw, "  none")
+	} else {
+		writeRow(tw, "NAME", "TEMPLATE", "RELATIONSHIP", "REQUIRED", "DESCRIPTION")
+		for _, dependency := range template.Dependencies {
+			writeRow(tw, dependency.Name, dependency.Type, dependency.Relationship, yesNo(dependency.Required), dependency.Description)
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(w, "\nExample usage:")
+	_, err := fmt.Fprintln(w, indent(Example(tp, entry).YAML(), "  "))
+	return err
+}
+
+// writeRow writes an indented table row, leaving out empty cells at its end so the
+// tabwriter does not pad the line with trailing spaces
+func writeRow(w io.Writer, cells ...string) {
+	for len(cells) > 1 && cells[len(cells)-1] == "" {
+		cells = cells[:len(cells)-1]
+	}
+	fmt.Fprintf(w, "  %s\n", strings.Join(cells, "\t"))
+}
+
+// version returns a template version for display
+func version(v string) string {
+	if v == "" {
+		return "-"
+	}
+	return "v" + v
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
+
+// indent prefixes every line of s
+func indent(s, prefix string) string {
+	return prefix + strings.ReplaceAll(s, "\n", "\n"+prefix)
+}
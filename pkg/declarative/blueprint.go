@@ -0,0 +1,149 @@
+package declarative
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/LederWorks/hippodamus/pkg/providers"
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// blueprintFuncs are the functions available to expressions in element blueprints
+var blueprintFuncs = template.FuncMap{
+	"default": func(fallback, value interface{}) interface{} {
+		if value == nil || fmt.Sprint(value) == "" {
+			return fallback
+		}
+		return value
+	},
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	"join": func(separator string, values []interface{}) string {
+		parts := make([]string, len(values))
+		for i, value := range values {
+			parts[i] = fmt.Sprint(value)
+		}
+		return strings.Join(parts, separator)
+	},
+}
+
+// isExpression reports whether a blueprint value contains template expressions
+func isExpression(value string) bool {
+	return strings.Contains(value, "{{")
+}
+
+// checkBlueprint parses every expression of a blueprint so syntax errors surface early
+func checkBlueprint(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		if !isExpression(node.Value) {
+			return nil
+		}
+		if _, err := template.New("").Funcs(blueprintFuncs).Parse(node.Value); err != nil {
+			return fmt.Errorf("line %d: %w", node.Line, err)
+		}
+		return nil
+	}
+
+	for _, child := range node.Content {
+		if err := checkBlueprint(child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// generate builds the element of the resource from its blueprint and parameters
+func (r *resource) generate(params map[string]interface{}) (*schema.Element, error) {
+	node, err := renderNode(r.blueprint, r.templateData(params))
+	if err != nil {
+		return nil, err
+	}
+
+	var element schema.Element
+	if err := node.Decode(&element); err != nil {
+		return nil, err
+	}
+	return &element, nil
+}
+
+// templateData returns the values expressions are evaluated against: the parameters,
+// completed with the schema defaults and the zero value of the remaining declared properties
+func (r *resource) templateData(params map[string]interface{}) map[string]interface{} {
+	data := providers.ApplyDefaults(r.definition.Schema, params)
+	for name, property := range schemaProperties(r.definition.Schema) {
+		if _, exists := data[name]; !exists {
+			data[name] = zeroValue(property["type"])
+		}
+	}
+	return data
+}
+
+// zeroValue returns the value expressions see for an optional parameter without a default
+func zeroValue(propertyType interface{}) interface{} {
+	switch propertyType {
+	case "number", "integer":
+		return 0
+	case "boolean":
+		return false
+	case "array":
+		return []interface{}{}
+	case "object":
+		return map[string]interface{}{}
+	default:
+		return ""
+	}
+}
+
+// renderNode returns a copy of a blueprint node with its expressions evaluated. Evaluated
+// values are retyped as plain YAML scalars, so "{{ .width }}" yields a number.
+func renderNode(node *yaml.Node, data map[string]interface{}) (*yaml.Node, error) {
+	rendered := *node
+	if node.Kind == yaml.ScalarNode {
+		if !isExpression(node.Value) {
+			return &rendered, nil
+		}
+
+		tmpl, err := template.New("").Funcs(blueprintFuncs).Parse(node.Value)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", node.Line, err)
+		}
+		var value strings.Builder
+		if err := tmpl.Execute(&value, data); err != nil {
+			return nil, fmt.Errorf("line %d: %w", node.Line, err)
+		}
+		rendered.Value = value.String()
+		rendered.Tag = ""
+		rendered.Style = 0
+		return &rendered, nil
+	}
+
+	rendered.Content = make([]*yaml.Node, len(node.Content))
+	for i, child := range node.Content {
+		// Mapping keys are kept as written
+		if node.Kind == yaml.MappingNode && i%2 == 0 {
+			rendered.Content[i] = child
+			continue
+		}
+		renderedChild, err := renderNode(child, data)
+		if err != nil {
+			return nil, err
+		}
+		rendered.Content[i] = renderedChild
+	}
+	return &rendered, nil
+}
+
+// schemaProperties returns the property schemas of an object schema
+func schemaProperties(objectSchema map[string]interface{}) map[string]map[string]interface{} {
+	properties := make(map[string]map[string]interface{})
+	declared, _ := objectSchema["properties"].(map[string]interface{})
+	for name, property := range declared {
+		if propertySchema, ok := property.(map[string]interface{}); ok {
+			properties[name] = propertySchema
+		}
+	}
+	return properties
+}
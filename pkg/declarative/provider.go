@@ -0,0 +1,243 @@
+package declarative
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/LederWorks/hippodamus/pkg/providers"
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// ManifestFile is the file that makes a directory a declarative provider
+const ManifestFile = "provider.yaml"
+
+// manifest is the content of a provider's provider.yaml
+type manifest struct {
+	Name        string   `yaml:"name"`
+	Version     string   `yaml:"version"`
+	Description string   `yaml:"description,omitempty"`
+	Resources   []string `yaml:"resources"` // Resource files, relative to the provider directory
+}
+
+// resourceFile is the content of a resource file of a declarative provider
+type resourceFile struct {
+	Type        string                      `yaml:"type"`
+	Name        string                      `yaml:"name"`
+	Description string                      `yaml:"description,omitempty"`
+	Category    string                      `yaml:"category,omitempty"`
+	Parameters  map[string]interface{}      `yaml:"parameters"` // JSON schema of the resource parameters
+	Examples    []providers.ResourceExample `yaml:"examples,omitempty"`
+	Element     yaml.Node                   `yaml:"element"` // Element blueprint with template expressions
+
+	AllowedParents    []string `yaml:"allowedParents,omitempty"`
+	AllowedChildren   []string `yaml:"allowedChildren,omitempty"` // An empty list allows no children
+	RequiredAncestors []string `yaml:"requiredAncestors,omitempty"`
+	DisallowedSources []string `yaml:"disallowedSources,omitempty"`
+	DisallowedTargets []string `yaml:"disallowedTargets,omitempty"`
+	DisjointCIDRs     []string `yaml:"disjointCIDRs,omitempty"`
+}
+
+// resource is a loaded resource of a declarative provider
+type resource struct {
+	definition providers.ResourceDefinition
+	blueprint  *yaml.Node
+}
+
+// Provider is a provider defined by YAML files instead of Go code. Each resource has
+// a JSON schema for its parameters and an element blueprint whose string values are
+// Go templates evaluated against the parameters.
+type Provider struct {
+	name        string
+	version     string
+	description string
+	resources   []*resource
+	byType      map[string]*resource
+}
+
+// IsProvider reports whether path is a directory containing a declarative provider manifest
+func IsProvider(path string) bool {
+	info, err := os.Stat(filepath.Join(path, ManifestFile))
+	return err == nil && !info.IsDir()
+}
+
+// Load loads the declarative provider in the directory dir
+func Load(dir string) (*Provider, error) {
+	manifestPath := filepath.Join(dir, ManifestFile)
+	data, err := os.ReadFile(manifestPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read provider manifest: %w", err)
+	}
+
+	var m manifest
+	if err := yaml.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("failed to parse provider manifest %s: %w", manifestPath, err)
+	}
+	if m.Name == "" {
+		return nil, fmt.Errorf("provider manifest %s has no name", manifestPath)
+	}
+	if m.Version == "" {
+		m.Version = "dev"
+	}
+	if len(m.Resources) == 0 {
+		return nil, fmt.Errorf("provider manifest %s declares no resources", manifestPath)
+	}
+
+	p := &Provider{
+		name:        m.Name,
+		version:     m.Version,
+		description: m.Description,
+		byType:      make(map[string]*resource),
+	}
+	for _, file := range m.Resources {
+		path := file
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(dir, path)
+		}
+
+		r, err := loadResource(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load resource %s of provider %s: %w", file, m.Name, err)
+		}
+		if _, exists := p.byType[r.definition.Type]; exists {
+			return nil, fmt.Errorf("provider %s defines resource type %s more than once", m.Name, r.definition.Type)
+		}
+		p.resources = append(p.resources, r)
+		p.byType[r.definition.Type] = r
+	}
+
+	return p, nil
+}
+
+// loadResource loads and checks a resource file
+func loadResource(path string) (*resource, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file resourceFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, err
+	}
+	if file.Type == "" {
+		return nil, fmt.Errorf("resource has no type")
+	}
+	if file.Element.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("resource %s has no element blueprint", file.Type)
+	}
+	if file.Parameters == nil {
+		file.Parameters = map[string]interface{}{"type": "object", "properties": map[string]interface{}{}}
+	}
+	if file.Name == "" {
+		file.Name = file.Type
+	}
+
+	r := &resource{
+		definition: providers.ResourceDefinition{
+			Type:        file.Type,
+			Name:        file.Name,
+			Description: file.Description,
+			Category:    file.Category,
+			Schema:      file.Parameters,
+			Examples:    file.Examples,
+
+			AllowedParents:    file.AllowedParents,
+			AllowedChildren:   file.AllowedChildren,
+			RequiredAncestors: file.RequiredAncestors,
+			DisallowedSources: file.DisallowedSources,
+			DisallowedTargets: file.DisallowedTargets,
+			DisjointCIDRs:     file.DisjointCIDRs,
+		},
+		blueprint: &file.Element,
+	}
+
+	// Report mistakes in the resource when the provider is loaded rather than when it is used
+	if err := checkBlueprint(r.blueprint); err != nil {
+		return nil, fmt.Errorf("invalid element blueprint of resource %s: %w", file.Type, err)
+	}
+	for _, example := range file.Examples {
+		if err := providers.ValidateParams(r.definition.Schema, example.Config); err != nil {
+			return nil, fmt.Errorf("invalid example %q of resource %s: %w", example.Name, file.Type, err)
+		}
+	}
+
+	return r, nil
+}
+
+// Name returns the provider name
+func (p *Provider) Name() string {
+	return p.name
+}
+
+// Version returns the provider version
+func (p *Provider) Version() string {
+	return p.version
+}
+
+// Description returns the provider description from its manifest
+func (p *Provider) Description() string {
+	return p.description
+}
+
+// Resources returns the list of resources defined by the provider
+func (p *Provider) Resources() []providers.ResourceDefinition {
+	definitions := make([]providers.ResourceDefinition, 0, len(p.resources))
+	for _, r := range p.resources {
+		definitions = append(definitions, r.definition)
+	}
+	return definitions
+}
+
+// Validate validates resource parameters against the resource's parameter schema
+func (p *Provider) Validate(resourceType string, params map[string]interface{}) error {
+	r, exists := p.byType[resourceType]
+	if !exists {
+		return p.unsupportedResource(resourceType)
+	}
+	return providers.ValidateParams(r.definition.Schema, params)
+}
+
+// GenerateTemplate generates an element from the resource's blueprint
+func (p *Provider) GenerateTemplate(resourceType string, params map[string]interface{}) (*schema.Element, error) {
+	if err := p.Validate(resourceType, params); err != nil {
+		return nil, err
+	}
+
+	element, err := p.byType[resourceType].generate(params)
+	if err != nil {
+		return nil, &providers.ProviderError{
+			Provider: p.Name(),
+			Resource: resourceType,
+			Message:  fmt.Sprintf("failed to generate %s: %v", resourceType, err),
+			Code:     "GENERATION_FAILED",
+		}
+	}
+	return element, nil
+}
+
+// GetSchema returns the JSON schema for a resource type
+func (p *Provider) GetSchema(resourceType string) (map[string]interface{}, error) {
+	if r, exists := p.byType[resourceType]; exists {
+		return r.definition.Schema, nil
+	}
+
+	return nil, &providers.ProviderError{
+		Provider: p.Name(),
+		Resource: resourceType,
+		Message:  fmt.Sprintf("schema not found for resource type: %s", resourceType),
+		Code:     "SCHEMA_NOT_FOUND",
+	}
+}
+
+// unsupportedResource returns the error for resource types the provider does not define
+func (p *Provider) unsupportedResource(resourceType string) error {
+	return &providers.ProviderError{
+		Provider: p.Name(),
+		Resource: resourceType,
+		Message:  fmt.Sprintf("unsupported resource type: %s", resourceType),
+		Code:     "UNSUPPORTED_RESOURCE",
+	}
+}
@@ -0,0 +1,322 @@
+package declarative
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers"
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+const testManifest = `
+name: "acme"
+version: "1.2.0"
+resources:
+  - resources/service.yaml
+  - resources/flow.yaml
+`
+
+const testService = `
+type: "service"
+name: "Service"
+category: "compute"
+parameters:
+  type: "object"
+  properties:
+    label:
+      type: "string"
+    team:
+      type: "string"
+    tier:
+      type: "string"
+      enum: ["gold", "silver"]
+      default: "silver"
+    width:
+      type: "number"
+      default: 140
+      minimum: 40
+  required: ["label"]
+examples:
+  - name: "Service"
+    config:
+      label: "Checkout"
+allowedChildren: []
+element:
+  type: "shape"
+  properties:
+    label: "{{ .label }}{{ with .team }} ({{ . }}){{ end }}"
+    width: "{{ .width }}"
+    height: 70
+  style:
+    fillColor: "{{ if eq .tier \"gold\" }}#FFF2CC{{ else }}#F5F5F5{{ end }}"
+    rounded: true
+    custom:
+      html: "1"
+`
+
+const testFlow = `
+type: "flow"
+parameters:
+  type: "object"
+  properties:
+    source:
+      type: "string"
+    target:
+      type: "string"
+    async:
+      type: "boolean"
+  required: ["source", "target"]
+  additionalProperties: false
+element:
+  type: "connector"
+  properties:
+    source: "{{ .source }}"
+    target: "{{ .target }}"
+  style:
+    strokeDashArray: "{{ if .async }}6 4{{ end }}"
+`
+
+// writeProvider writes a declarative provider with the given files to a temporary directory
+func writeProvider(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("Failed to create directory: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+	return dir
+}
+
+func loadTestProvider(t *testing.T) *Provider {
+	t.Helper()
+	dir := writeProvider(t, map[string]string{
+		"provider.yaml":          testManifest,
+		"resources/service.yaml": testService,
+		"resources/flow.yaml":    testFlow,
+	})
+	if !IsProvider(dir) {
+		t.Fatalf("Expected %s to be a declarative provider", dir)
+	}
+
+	p, err := Load(dir)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	return p
+}
+
+func TestLoad(t *testing.T) {
+	var p providers.Provider = loadTestProvider(t)
+
+	if p.Name() != "acme" || p.Version() != "1.2.0" {
+		t.Errorf("Expected acme 1.2.0, got %s %s", p.Name(), p.Version())
+	}
+
+	resources := p.Resources()
+	if len(resources) != 2 || resources[0].Type != "service" || resources[1].Type != "flow" {
+		t.Fatalf("Expected resources service and flow, got %+v", resources)
+	}
+	if resources[0].Category != "compute" || len(resources[0].Examples) != 1 {
+		t.Errorf("Expected the definition of the resource file, got %+v", resources[0])
+	}
+	if resources[1].Name != "flow" {
+		t.Errorf("Expected the name to default to the type, got %s", resources[1].Name)
+	}
+	if resources[0].AllowedChildren == nil || len(resources[0].AllowedChildren) != 0 {
+		t.Errorf("Expected service to allow no children, got %#v", resources[0].AllowedChildren)
+	}
+	if resources[1].AllowedChildren != nil {
+		t.Errorf("Expected flow to allow any children, got %#v", resources[1].AllowedChildren)
+	}
+
+	resourceSchema, err := p.GetSchema("service")
+	if err != nil {
+		t.Fatalf("GetSchema() error = %v", err)
+	}
+	if _, ok := resourceSchema["properties"].(map[string]interface{})["tier"]; !ok {
+		t.Errorf("Expected the parameter schema, got %v", resourceSchema)
+	}
+}
+
+func TestProvider_Conformance(t *testing.T) {
+	providertest.Run(t, loadTestProvider(t))
+
+	// The example provider shipped with the repository
+	p, err := Load(filepath.Join("..", "..", "examples", "providers", "acme"))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	providertest.Run(t, p)
+}
+
+func TestLoad_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+		want  string
+	}{
+		{
+			name:  "missing manifest",
+			files: map[string]string{"resources/service.yaml": testService},
+			want:  "failed to read provider manifest",
+		},
+		{
+			name:  "no name",
+			files: map[string]string{"provider.yaml": "resources: [service.yaml]"},
+			want:  "has no name",
+		},
+		{
+			name:  "missing resource file",
+			files: map[string]string{"provider.yaml": testManifest, "resources/service.yaml": testService},
+			want:  "failed to load resource resources/flow.yaml",
+		},
+		{
+			name: "duplicate type",
+			files: map[string]string{
+				"provider.yaml": "name: acme\nresources: [a.yaml, b.yaml]",
+				"a.yaml":        testService,
+				"b.yaml":        testService,
+			},
+			want: "defines resource type service more than once",
+		},
+		{
+			name: "invalid expression",
+			files: map[string]string{
+				"provider.yaml": "name: acme\nresources: [a.yaml]",
+				"a.yaml":        "type: a\nelement:\n  properties:\n    label: \"{{ .label \"\n",
+			},
+			want: "invalid element blueprint of resource a",
+		},
+		{
+			name: "invalid example",
+			files: map[string]string{
+				"provider.yaml": "name: acme\nresources: [a.yaml]",
+				"a.yaml":        strings.Replace(testService, `label: "Checkout"`, `team: "Payments"`, 1),
+			},
+			want: `invalid example "Service" of resource service: label: label is required`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Load(writeProvider(t, tt.files))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	p := loadTestProvider(t)
+
+	tests := []struct {
+		name         string
+		resourceType string
+		params       map[string]interface{}
+		wantField    string
+		wantCode     string
+	}{
+		{"valid", "service", map[string]interface{}{"label": "Checkout", "tier": "gold", "width": 200}, "", ""},
+		{"required", "service", map[string]interface{}{"team": "Payments"}, "label", "REQUIRED"},
+		{"type", "service", map[string]interface{}{"label": 42}, "label", "INVALID_TYPE"},
+		{"enum", "service", map[string]interface{}{"label": "Checkout", "tier": "platinum"}, "tier", "INVALID_ENUM"},
+		{"minimum", "service", map[string]interface{}{"label": "Checkout", "width": 10.5}, "width", "OUT_OF_RANGE"},
+		{"undeclared allowed", "service", map[string]interface{}{"label": "Checkout", "owner": "me"}, "", ""},
+		{"additional properties", "flow", map[string]interface{}{"source": "a", "target": "b", "weight": 3}, "weight", "NOT_SUPPORTED"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := p.Validate(tt.resourceType, tt.params)
+			if tt.wantCode == "" {
+				if err != nil {
+					t.Errorf("Validate() error = %v", err)
+				}
+				return
+			}
+
+			var validationErr *providers.ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("Expected a ValidationError, got %v", err)
+			}
+			if validationErr.Field != tt.wantField || validationErr.Code != tt.wantCode {
+				t.Errorf("Expected %s %s, got %s %s", tt.wantField, tt.wantCode, validationErr.Field, validationErr.Code)
+			}
+		})
+	}
+
+	var providerErr *providers.ProviderError
+	if err := p.Validate("queue", nil); !errors.As(err, &providerErr) || providerErr.Code != "UNSUPPORTED_RESOURCE" {
+		t.Errorf("Expected UNSUPPORTED_RESOURCE, got %v", err)
+	}
+}
+
+func TestGenerateTemplate(t *testing.T) {
+	p := loadTestProvider(t)
+
+	element, err := p.GenerateTemplate("service", map[string]interface{}{"label": "Checkout", "team": "Payments", "tier": "gold"})
+	if err != nil {
+		t.Fatalf("GenerateTemplate() error = %v", err)
+	}
+	if element.Type != schema.ElementTypeShape {
+		t.Errorf("Expected a shape, got %s", element.Type)
+	}
+	if element.Properties.Label != "Checkout (Payments)" {
+		t.Errorf("Expected label from the parameters, got %q", element.Properties.Label)
+	}
+	if element.Properties.Width != 140 || element.Properties.Height != 70 {
+		t.Errorf("Expected the default width as a number, got %vx%v", element.Properties.Width, element.Properties.Height)
+	}
+	if element.Style.FillColor != "#FFF2CC" || !element.Style.Rounded || element.Style.Custom["html"] != "1" {
+		t.Errorf("Expected the blueprint style, got %+v", element.Style)
+	}
+
+	// Optional parameters without a default evaluate to their zero value
+	element, err = p.GenerateTemplate("service", map[string]interface{}{"label": "Catalog"})
+	if err != nil {
+		t.Fatalf("GenerateTemplate() error = %v", err)
+	}
+	if element.Properties.Label != "Catalog" || element.Style.FillColor != "#F5F5F5" {
+		t.Errorf("Expected the defaults, got %q %s", element.Properties.Label, element.Style.FillColor)
+	}
+
+	element, err = p.GenerateTemplate("flow", map[string]interface{}{"source": "a", "target": "b", "async": true})
+	if err != nil {
+		t.Fatalf("GenerateTemplate() error = %v", err)
+	}
+	if element.Properties.Source != "a" || element.Properties.Target != "b" || element.Style.StrokeDashArray != "6 4" {
+		t.Errorf("Expected a dashed connector from a to b, got %+v %+v", element.Properties, element.Style)
+	}
+
+	// Generating validates the parameters like compiled providers do
+	if _, err := p.GenerateTemplate("flow", map[string]interface{}{"source": "a"}); err == nil {
+		t.Error("Expected an error for a missing target")
+	}
+}
+
+func TestGenerateTemplate_ExpressionError(t *testing.T) {
+	dir := writeProvider(t, map[string]string{
+		"provider.yaml": "name: acme\nresources: [a.yaml]",
+		"a.yaml":        "type: a\nelement:\n  properties:\n    label: \"{{ index .items 3 }}\"\n",
+	})
+	p, err := Load(dir)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	var providerErr *providers.ProviderError
+	_, err = p.GenerateTemplate("a", map[string]interface{}{"items": []interface{}{"x"}})
+	if !errors.As(err, &providerErr) || providerErr.Code != "GENERATION_FAILED" {
+		t.Errorf("Expected GENERATION_FAILED, got %v", err)
+	}
+}
@@ -9,6 +9,13 @@ import (
 	"github.com/LederWorks/hippodamus/pkg/schema"
 )
 
+// Badges drawn at the top-right corner of elements
+const (
+	badgeSize   = 22.0     // Height and minimum width of a badge
+	badgeSuffix = "#badge" // Suffix of the cell ID of the element the badge belongs to
+	badgeStyle  = "ellipse;whiteSpace=wrap;html=1;fillColor=#455A64;strokeColor=#FFFFFF;fontColor=#FFFFFF;fontSize=10;fontStyle=1;resizable=0;"
+)
+
 // DrawioDocument represents the root draw.io XML document
 type DrawioDocument struct {
 	XMLName  xml.Name        `xml:"mxfile"`
@@ -57,37 +64,77 @@ type DrawioRoot struct {
 
 // DrawioCell represents a cell (shape, connector, etc.)
 type DrawioCell struct {
-	XMLName  xml.Name        `xml:"mxCell"`
-	ID       string          `xml:"id,attr"`
-	Value    string          `xml:"value,attr,omitempty"`
-	Style    string          `xml:"style,attr,omitempty"`
-	Parent   string          `xml:"parent,attr,omitempty"`
-	Source   string          `xml:"source,attr,omitempty"`
-	Target   string          `xml:"target,attr,omitempty"`
-	Edge     string          `xml:"edge,attr,omitempty"`
-	Vertex   string          `xml:"vertex,attr,omitempty"`
-	Geometry *DrawioGeometry `xml:"mxGeometry,omitempty"`
+	XMLName     xml.Name        `xml:"mxCell"`
+	ID          string          `xml:"id,attr,omitempty"`
+	Value       string          `xml:"value,attr,omitempty"`
+	Style       string          `xml:"style,attr,omitempty"`
+	Parent      string          `xml:"parent,attr,omitempty"`
+	Source      string          `xml:"source,attr,omitempty"`
+	Target      string          `xml:"target,attr,omitempty"`
+	Edge        string          `xml:"edge,attr,omitempty"`
+	Vertex      string          `xml:"vertex,attr,omitempty"`
+	Collapsed   string          `xml:"collapsed,attr,omitempty"`
+	Connectable string          `xml:"connectable,attr,omitempty"`
+	Geometry    *DrawioGeometry `xml:"mxGeometry,omitempty"`
+	Link        string          `xml:"-"`
+}
+
+// DrawioUserObject wraps a cell that carries additional attributes such as a link
+type DrawioUserObject struct {
+	XMLName xml.Name      `xml:"UserObject"`
+	ID      string        `xml:"id,attr"`
+	Label   string        `xml:"label,attr"`
+	Link    string        `xml:"link,attr,omitempty"`
+	Cell    drawioCellXML `xml:"mxCell"`
+}
+
+// drawioCellXML is the plain XML encoding of a cell
+type drawioCellXML DrawioCell
+
+// MarshalXML encodes linked cells the way draw.io stores them, as a UserObject
+// holding the ID, label and link around the mxCell
+func (c DrawioCell) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if c.Link == "" {
+		return e.EncodeElement(drawioCellXML(c), start)
+	}
+
+	object := DrawioUserObject{
+		ID:    c.ID,
+		Label: c.Value,
+		Link:  c.Link,
+	}
+
+	cell := c
+	cell.ID = ""
+	cell.Value = ""
+	cell.Link = ""
+	object.Cell = drawioCellXML(cell)
+
+	return e.Encode(object)
 }
 
 // DrawioGeometry represents geometry information
 type DrawioGeometry struct {
-	XMLName xml.Name `xml:"mxGeometry"`
-	X       float64  `xml:"x,attr,omitempty"`
-	Y       float64  `xml:"y,attr,omitempty"`
-	Width   float64  `xml:"width,attr,omitempty"`
-	Height  float64  `xml:"height,attr,omitempty"`
-	As      string   `xml:"as,attr"`
+	XMLName  xml.Name `xml:"mxGeometry"`
+	X        float64  `xml:"x,attr,omitempty"`
+	Y        float64  `xml:"y,attr,omitempty"`
+	Width    float64  `xml:"width,attr,omitempty"`
+	Height   float64  `xml:"height,attr,omitempty"`
+	Relative string   `xml:"relative,attr,omitempty"`
+	As       string   `xml:"as,attr"`
 }
 
 // Generator handles the conversion from schema to draw.io XML
 type Generator struct {
 	cellIDCounter int
+	cellIndex     map[string]string // Maps element IDs and names to cell IDs on the current page
 }
 
 // NewGenerator creates a new draw.io XML generator
 func NewGenerator() *Generator {
 	return &Generator{
 		cellIDCounter: 0,
+		cellIndex:     make(map[string]string),
 	}
 }
 
@@ -235,6 +282,9 @@ func (g *Generator) generatePage(page *schema.Page, diagramProps *schema.Diagram
 		diagram.GraphModel.Background = page.Properties.Background
 	}
 
+	// Connector endpoints are resolved per page
+	g.cellIndex = make(map[string]string)
+
 	// Add default root cells
 	diagram.GraphModel.Root.Cells = append(diagram.GraphModel.Root.Cells,
 		DrawioCell{ID: "0"},
@@ -271,15 +321,80 @@ func (g *Generator) generatePage(page *schema.Page, diagramProps *schema.Diagram
 		diagram.GraphModel.Root.Cells = append(diagram.GraphModel.Root.Cells, cells...)
 	}
 
+	// Point connectors at the page-local cells of their endpoints
+	g.resolveConnectorEndpoints(diagram.GraphModel.Root.Cells, page.ID)
+
 	return diagram, nil
 }
 
+// indexElement records the cell ID generated for an element under its ID and name
+func (g *Generator) indexElement(element *schema.Element, cellID string) {
+	for _, key := range []string{element.ID, element.Name} {
+		if key == "" {
+			continue
+		}
+		// The first element wins when an identifier is used more than once on a page
+		if _, exists := g.cellIndex[key]; !exists {
+			g.cellIndex[key] = cellID
+		}
+	}
+}
+
+// resolveConnectorEndpoints maps connector sources and targets given as element IDs,
+// names or paths to the hierarchical cell IDs of the current page. Paths are resolved
+// relative to the containers of the connector first, from the innermost one, so the
+// connectors inside a container can refer to its elements even when their IDs are used
+// elsewhere on the page, and then relative to the page.
+func (g *Generator) resolveConnectorEndpoints(cells []DrawioCell, pageID string) {
+	cellIDs := make(map[string]bool, len(cells))
+	for _, cell := range cells {
+		cellIDs[cell.ID] = true
+	}
+
+	resolve := func(connectorID, endpoint string) string {
+		if endpoint == "" || cellIDs[endpoint] {
+			return endpoint
+		}
+		for scope := parentPath(connectorID); scope != "" && scope != pageID; scope = parentPath(scope) {
+			if path := scope + "/" + endpoint; cellIDs[path] {
+				return path
+			}
+		}
+		if cellID, exists := g.cellIndex[endpoint]; exists {
+			return cellID
+		}
+		if path := pageID + "/" + endpoint; cellIDs[path] {
+			return path
+		}
+		return endpoint
+	}
+
+	for i := range cells {
+		if cells[i].Edge != "1" {
+			continue
+		}
+		cells[i].Source = resolve(cells[i].ID, cells[i].Source)
+		cells[i].Target = resolve(cells[i].ID, cells[i].Target)
+	}
+}
+
+// parentPath returns the path of the container of a hierarchical cell ID, or "" at the top
+func parentPath(path string) string {
+	if i := strings.LastIndex(path, "/"); i >= 0 {
+		return path[:i]
+	}
+	return ""
+}
+
 // generateElement converts an Element to DrawioCells
 func (g *Generator) generateElement(element *schema.Element, parentID string) ([]DrawioCell, error) {
 	var cells []DrawioCell
 
+	g.indexElement(element, element.ID)
+
 	// Apply automatic positioning if the element has children and nesting configuration
-	if len(element.Children) > 0 {
+	// (children of connectors are labels positioned along the connector)
+	if len(element.Children) > 0 && element.Type != schema.ElementTypeConnector {
 		g.applyAutomaticNesting(element)
 	}
 
@@ -301,6 +416,11 @@ func (g *Generator) generateElement(element *schema.Element, parentID string) ([
 		cell := g.generateConnectorCell(element, parentID)
 		cells = append(cells, cell)
 
+		// Process connector labels
+		for _, child := range element.Children {
+			cells = append(cells, g.generateEdgeLabelCell(&child, element.ID))
+		}
+
 	case schema.ElementTypeText:
 		cell := g.generateTextCell(element, parentID)
 		cells = append(cells, cell)
@@ -335,6 +455,10 @@ func (g *Generator) generateElement(element *schema.Element, parentID string) ([
 		return nil, fmt.Errorf("unsupported element type: %s", element.Type)
 	}
 
+	if element.Properties.Badge != "" {
+		cells = append(cells, g.generateBadgeCell(element, parentID))
+	}
+
 	return cells, nil
 }
 
@@ -345,12 +469,15 @@ func (g *Generator) generateElementWithPath(element *schema.Element, parentID st
 	// Generate hierarchical ID for this element
 	elementPath := g.generateHierarchicalID(element, parentPath)
 
+	g.indexElement(element, elementPath)
+
 	// Temporarily update the element's ID to use the hierarchical path
 	originalID := element.ID
 	element.ID = elementPath
 
 	// Apply automatic positioning if the element has children and nesting configuration
-	if len(element.Children) > 0 {
+	// (children of connectors are labels positioned along the connector)
+	if len(element.Children) > 0 && element.Type != schema.ElementTypeConnector {
 		g.applyAutomaticNesting(element)
 	}
 
@@ -372,6 +499,13 @@ func (g *Generator) generateElementWithPath(element *schema.Element, parentID st
 		cell := g.generateConnectorCell(element, parentID)
 		cells = append(cells, cell)
 
+		// Process connector labels
+		for _, child := range element.Children {
+			label := child
+			label.ID = g.generateHierarchicalID(&child, elementPath)
+			cells = append(cells, g.generateEdgeLabelCell(&label, element.ID))
+		}
+
 	case schema.ElementTypeText:
 		cell := g.generateTextCell(element, parentID)
 		cells = append(cells, cell)
@@ -406,6 +540,10 @@ func (g *Generator) generateElementWithPath(element *schema.Element, parentID st
 		return nil, fmt.Errorf("unsupported element type: %s", element.Type)
 	}
 
+	if element.Properties.Badge != "" {
+		cells = append(cells, g.generateBadgeCell(element, parentID))
+	}
+
 	// Restore original ID
 	element.ID = originalID
 
@@ -425,6 +563,7 @@ func (g *Generator) generateShapeCell(element *schema.Element, parentID string)
 		Value:  displayText,
 		Style:  g.generateElementStyle(element),
 		Parent: parentID,
+		Link:   element.Properties.Link,
 		Vertex: "1",
 		Geometry: &DrawioGeometry{
 			X:      element.Properties.X,
@@ -435,6 +574,8 @@ func (g *Generator) generateShapeCell(element *schema.Element, parentID string)
 		},
 	}
 
+	g.applyCollapsedState(&cell, element)
+
 	return cell
 }
 
@@ -445,6 +586,7 @@ func (g *Generator) generateConnectorCell(element *schema.Element, parentID stri
 		Value:  element.Properties.Label,
 		Style:  g.generateElementStyle(element),
 		Parent: parentID,
+		Link:   element.Properties.Link,
 		Source: element.Properties.Source,
 		Target: element.Properties.Target,
 		Edge:   "1",
@@ -456,6 +598,49 @@ func (g *Generator) generateConnectorCell(element *schema.Element, parentID stri
 	return cell
 }
 
+// generateEdgeLabelCell creates a label attached to a connector, such as a multiplicity at
+// one of its ends. The label's X is its position along the connector, from -1 at the source
+// to 1 at the target, and its Y the offset from the connector.
+func (g *Generator) generateEdgeLabelCell(element *schema.Element, parentID string) DrawioCell {
+	return DrawioCell{
+		ID:          element.ID,
+		Value:       element.Properties.Label,
+		Style:       "edgeLabel;resizable=0;" + g.generateElementStyle(element),
+		Parent:      parentID,
+		Vertex:      "1",
+		Connectable: "0",
+		Geometry: &DrawioGeometry{
+			X:        element.Properties.X,
+			Y:        element.Properties.Y,
+			Relative: "1",
+			As:       "geometry",
+		},
+	}
+}
+
+// generateBadgeCell creates the badge of an element, a marker centered on its top-right
+// corner. The badge is a sibling of the element rather than a child, so it stays visible
+// while the element is collapsed.
+func (g *Generator) generateBadgeCell(element *schema.Element, parentID string) DrawioCell {
+	width := max(badgeSize, float64(len(element.Properties.Badge))*7+10)
+
+	return DrawioCell{
+		ID:          element.ID + badgeSuffix,
+		Value:       element.Properties.Badge,
+		Style:       badgeStyle,
+		Parent:      parentID,
+		Vertex:      "1",
+		Connectable: "0",
+		Geometry: &DrawioGeometry{
+			X:      element.Properties.X + element.Properties.Width - width/2,
+			Y:      element.Properties.Y - badgeSize/2,
+			Width:  width,
+			Height: badgeSize,
+			As:     "geometry",
+		},
+	}
+}
+
 // generateTextCell creates a text cell
 func (g *Generator) generateTextCell(element *schema.Element, parentID string) DrawioCell {
 	cell := DrawioCell{
@@ -463,6 +648,7 @@ func (g *Generator) generateTextCell(element *schema.Element, parentID string) D
 		Value:  element.Properties.Label,
 		Style:  g.generateElementStyle(element),
 		Parent: parentID,
+		Link:   element.Properties.Link,
 		Vertex: "1",
 		Geometry: &DrawioGeometry{
 			X:      element.Properties.X,
@@ -489,6 +675,7 @@ func (g *Generator) generateGroupCell(element *schema.Element, parentID string)
 		Value:  displayText,
 		Style:  g.generateElementStyle(element),
 		Parent: parentID,
+		Link:   element.Properties.Link,
 		Vertex: "1",
 		Geometry: &DrawioGeometry{
 			X:      element.Properties.X,
@@ -499,6 +686,8 @@ func (g *Generator) generateGroupCell(element *schema.Element, parentID string)
 		},
 	}
 
+	g.applyCollapsedState(&cell, element)
+
 	return cell
 }
 
@@ -520,6 +709,7 @@ func (g *Generator) generateSwimLaneCell(element *schema.Element, parentID strin
 		Value:  displayText,
 		Style:  style,
 		Parent: parentID,
+		Link:   element.Properties.Link,
 		Vertex: "1",
 		Geometry: &DrawioGeometry{
 			X:      element.Properties.X,
@@ -530,9 +720,34 @@ func (g *Generator) generateSwimLaneCell(element *schema.Element, parentID strin
 		},
 	}
 
+	g.applyCollapsedState(&cell, element)
+
 	return cell
 }
 
+// applyCollapsedState marks containers as collapsible and collapsed in draw.io
+func (g *Generator) applyCollapsedState(cell *DrawioCell, element *schema.Element) {
+	if !element.Properties.Collapsible && !element.Properties.Collapsed {
+		return
+	}
+
+	var styles []string
+	if cell.Style != "" {
+		styles = strings.Split(strings.TrimSuffix(cell.Style, ";"), ";")
+	}
+	if !containsStyle(styles, "container") {
+		styles = append(styles, "container=1")
+	}
+	if !containsStyle(styles, "collapsible") {
+		styles = append(styles, "collapsible=1")
+	}
+	cell.Style = strings.Join(styles, ";")
+
+	if element.Properties.Collapsed {
+		cell.Collapsed = "1"
+	}
+}
+
 // generateLayerStyle generates style for a layer
 func (g *Generator) generateLayerStyle(layer *schema.Layer) string {
 	var styles []string
@@ -682,6 +897,15 @@ func (g *Generator) applyAutomaticNesting(element *schema.Element) {
 		return
 	}
 
+	// Size nested containers first, so containers that grow to fit their own
+	// children are positioned with their final size. Children of connectors are
+	// labels positioned along the connector.
+	for i := range element.Children {
+		if len(element.Children[i].Children) > 0 && element.Children[i].Type != schema.ElementTypeConnector {
+			g.applyAutomaticNesting(&element.Children[i])
+		}
+	}
+
 	nesting := element.Nesting
 
 	// Set default nesting mode based on element type if not specified
@@ -730,9 +954,18 @@ func (g *Generator) applyAutomaticNesting(element *schema.Element) {
 	}
 }
 
+// Layout positions the children of an element and resizes auto-resizing containers the way
+// Generate does, so callers can arrange elements by their final size before generating them
+func (g *Generator) Layout(element *schema.Element) {
+	if len(element.Children) > 0 && element.Type != schema.ElementTypeConnector {
+		g.applyAutomaticNesting(element)
+	}
+}
+
 // calculateChildPositions calculates automatic positions for child elements
 func (g *Generator) calculateChildPositions(parent *schema.Element, nesting *schema.NestingConfig) {
-	if len(parent.Children) == 0 {
+	children := layoutChildren(parent)
+	if len(children) == 0 {
 		return
 	}
 
@@ -742,8 +975,7 @@ func (g *Generator) calculateChildPositions(parent *schema.Element, nesting *sch
 	switch nesting.Arrangement {
 	case schema.ArrangementVertical:
 		currentY := contentY
-		for i := range parent.Children {
-			child := &parent.Children[i]
+		for _, child := range children {
 			if child.Properties.Width == 0 {
 				child.Properties.Width = 140 // Default width
 			}
@@ -758,8 +990,7 @@ func (g *Generator) calculateChildPositions(parent *schema.Element, nesting *sch
 
 	case schema.ArrangementHorizontal:
 		currentX := contentX
-		for i := range parent.Children {
-			child := &parent.Children[i]
+		for _, child := range children {
 			if child.Properties.Width == 0 {
 				child.Properties.Width = 140
 			}
@@ -774,7 +1005,7 @@ func (g *Generator) calculateChildPositions(parent *schema.Element, nesting *sch
 
 	case schema.ArrangementGrid:
 		// Calculate grid dimensions
-		childCount := len(parent.Children)
+		childCount := len(children)
 		cols := int(math.Ceil(math.Sqrt(float64(childCount))))
 		if cols > 4 {
 			cols = 4 // Maximum 4 columns
@@ -784,8 +1015,7 @@ func (g *Generator) calculateChildPositions(parent *schema.Element, nesting *sch
 		currentY := contentY
 		col := 0
 
-		for i := range parent.Children {
-			child := &parent.Children[i]
+		for _, child := range children {
 			if child.Properties.Width == 0 {
 				child.Properties.Width = 140
 			}
@@ -812,6 +1042,18 @@ func (g *Generator) calculateChildPositions(parent *schema.Element, nesting *sch
 	}
 }
 
+// layoutChildren returns the children of a container that take up space in its layout.
+// Connectors between children are drawn over the layout and do not.
+func layoutChildren(parent *schema.Element) []*schema.Element {
+	var children []*schema.Element
+	for i := range parent.Children {
+		if parent.Children[i].Type != schema.ElementTypeConnector {
+			children = append(children, &parent.Children[i])
+		}
+	}
+	return children
+}
+
 // autoResizeParent automatically resizes the parent to fit all children
 func (g *Generator) autoResizeParent(parent *schema.Element, nesting *schema.NestingConfig) {
 	if len(parent.Children) == 0 {
@@ -821,7 +1063,7 @@ func (g *Generator) autoResizeParent(parent *schema.Element, nesting *schema.Nes
 	var maxX, maxY float64
 
 	// Find the bottom-right bounds of all children
-	for _, child := range parent.Children {
+	for _, child := range layoutChildren(parent) {
 		childRight := child.Properties.X + child.Properties.Width
 		childBottom := child.Properties.Y + child.Properties.Height
 
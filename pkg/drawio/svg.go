@@ -0,0 +1,303 @@
+package drawio
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"html"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// SVG layout of the pages of a document
+const (
+	svgMargin        = 20.0 // Space around the pages
+	svgHeadingHeight = 40.0 // Height of the heading of a page
+	svgPageGap       = 40.0 // Space between pages
+)
+
+// htmlTag matches the tags of HTML labels
+var htmlTag = regexp.MustCompile(`<[^>]*>`)
+
+// svgBox is the absolute position and size of a vertex
+type svgBox struct {
+	x, y, width, height float64
+}
+
+// WriteSVG renders the pages of a document one below the other as an SVG image. It is a
+// preview rather than a faithful rendering: vertices are drawn as rectangles, ellipses or
+// cylinders from their style, edges as straight lines between the centers of their
+// endpoints, and HTML labels are embedded as XHTML when they are well-formed XML.
+func WriteSVG(w io.Writer, document *DrawioDocument) error {
+	var body bytes.Buffer
+	var width float64
+	y := svgMargin
+
+	for _, diagram := range document.Diagram {
+		cells := svgVisibleCells(diagram.GraphModel.Root.Cells)
+		boxes := svgBoxes(cells)
+
+		var pageWidth, pageHeight float64
+		for _, box := range boxes {
+			pageWidth = max(pageWidth, box.x+box.width)
+			pageHeight = max(pageHeight, box.y+box.height)
+		}
+		width = max(width, pageWidth+2*svgMargin)
+
+		fmt.Fprintf(&body, `<g id="%s">`+"\n", svgEscape(diagram.ID))
+		fmt.Fprintf(&body, `<text x="%g" y="%g" font-family="Helvetica" font-size="18" font-weight="bold">%s</text>`+"\n",
+			svgMargin, y+24, svgEscape(diagram.Name))
+		fmt.Fprintf(&body, `<g transform="translate(%g,%g)">`+"\n", svgMargin, y+svgHeadingHeight)
+		for _, cell := range cells {
+			writeSVGCell(&body, cell, boxes)
+		}
+		body.WriteString("</g>\n</g>\n")
+
+		y += svgHeadingHeight + pageHeight + svgPageGap
+	}
+
+	height := y - svgPageGap + svgMargin
+	_, err := fmt.Fprintf(w, `<?xml version="1.0" encoding="UTF-8"?>
+<svg xmlns="http://www.w3.org/2000/svg" width="%g" height="%g" viewBox="0 0 %g %g">
+<rect width="100%%" height="100%%" fill="#FFFFFF"/>
+%s</svg>
+`, width, height, width, height, body.String())
+	return err
+}
+
+// svgVisibleCells returns the cells draw.io shows: the cells inside collapsed containers
+// are left out and edges to them end at the outermost collapsed container instead
+func svgVisibleCells(cells []DrawioCell) []DrawioCell {
+	byID := make(map[string]DrawioCell, len(cells))
+	for _, cell := range cells {
+		byID[cell.ID] = cell
+	}
+
+	// collapsedAncestor returns the outermost collapsed ancestor of a cell, or ""
+	collapsedAncestor := func(id string) string {
+		ancestor := ""
+		cell, exists := byID[id]
+		for depth := 0; exists && depth <= len(cells); depth++ {
+			parent, parentExists := byID[cell.Parent]
+			if !parentExists {
+				break
+			}
+			if parent.Collapsed == "1" {
+				ancestor = parent.ID
+			}
+			cell, exists = parent, parentExists
+		}
+		return ancestor
+	}
+
+	visible := make([]DrawioCell, 0, len(cells))
+	for _, cell := range cells {
+		if collapsedAncestor(cell.ID) != "" {
+			continue
+		}
+		if cell.Edge == "1" {
+			if ancestor := collapsedAncestor(cell.Source); ancestor != "" {
+				cell.Source = ancestor
+			}
+			if ancestor := collapsedAncestor(cell.Target); ancestor != "" {
+				cell.Target = ancestor
+			}
+		}
+		visible = append(visible, cell)
+	}
+	return visible
+}
+
+// svgBoxes returns the absolute boxes of the vertices of a page, by cell ID. Vertex
+// geometry is relative to the parent vertex.
+func svgBoxes(cells []DrawioCell) map[string]svgBox {
+	byID := make(map[string]DrawioCell, len(cells))
+	for _, cell := range cells {
+		byID[cell.ID] = cell
+	}
+
+	boxes := make(map[string]svgBox)
+	var resolve func(id string, depth int) (float64, float64)
+	resolve = func(id string, depth int) (float64, float64) {
+		if box, done := boxes[id]; done {
+			return box.x, box.y
+		}
+		cell, exists := byID[id]
+		if !exists || depth > len(cells) || !isSVGVertex(cell) {
+			return 0, 0
+		}
+		x, y := resolve(cell.Parent, depth+1)
+		box := svgBox{x: x + cell.Geometry.X, y: y + cell.Geometry.Y, width: cell.Geometry.Width, height: cell.Geometry.Height}
+		boxes[id] = box
+		return box.x, box.y
+	}
+
+	for _, cell := range cells {
+		if isSVGVertex(cell) {
+			resolve(cell.ID, 0)
+		}
+	}
+	return boxes
+}
+
+// isSVGVertex reports whether a cell is a vertex with its own geometry, which excludes
+// edge labels positioned along their edge
+func isSVGVertex(cell DrawioCell) bool {
+	return cell.Vertex == "1" && cell.Geometry != nil && cell.Geometry.Relative == ""
+}
+
+// writeSVGCell writes a vertex or an edge
+func writeSVGCell(w *bytes.Buffer, cell DrawioCell, boxes map[string]svgBox) {
+	style := parseSVGStyle(cell.Style)
+
+	if cell.Edge == "1" {
+		source, sourceExists := boxes[cell.Source]
+		target, targetExists := boxes[cell.Target]
+		if !sourceExists || !targetExists {
+			return
+		}
+		fmt.Fprintf(w, `<line x1="%g" y1="%g" x2="%g" y2="%g" stroke="%s"%s/>`+"\n",
+			source.x+source.width/2, source.y+source.height/2, target.x+target.width/2, target.y+target.height/2,
+			svgColor(style["strokeColor"], "#000000"), svgDash(style))
+		return
+	}
+
+	box, exists := boxes[cell.ID]
+	if !exists {
+		return
+	}
+
+	if _, text := style["text"]; !text {
+		fill := svgColor(style["fillColor"], "#FFFFFF")
+		stroke := svgColor(style["strokeColor"], "#000000")
+		dash := svgDash(style)
+		switch {
+		case style["shape"] == "ellipse" || strings.HasPrefix(cell.Style, "ellipse"):
+			fmt.Fprintf(w, `<ellipse cx="%g" cy="%g" rx="%g" ry="%g" fill="%s" stroke="%s"%s/>`+"\n",
+				box.x+box.width/2, box.y+box.height/2, box.width/2, box.height/2, fill, stroke, dash)
+		case style["shape"] == "cylinder" || style["shape"] == "cylinder3":
+			ry := min(box.height/8, 10)
+			fmt.Fprintf(w, `<path d="M%g,%g a%g,%g 0 0,0 %g,0 v%g a%g,%g 0 0,1 %g,0 z" fill="%s" stroke="%s"%s/>`+"\n",
+				box.x, box.y+ry, box.width/2, ry, box.width, box.height-2*ry, box.width/2, ry, -box.width, fill, stroke, dash)
+			fmt.Fprintf(w, `<path d="M%g,%g a%g,%g 0 0,0 %g,0" fill="none" stroke="%s"/>`+"\n",
+				box.x, box.y+ry, box.width/2, ry, box.width, stroke)
+		default:
+			radius := 0.0
+			if style["rounded"] == "1" {
+				radius = min(box.width, box.height) / 10
+			}
+			fmt.Fprintf(w, `<rect x="%g" y="%g" width="%g" height="%g" rx="%g" fill="%s" stroke="%s"%s/>`+"\n",
+				box.x, box.y, box.width, box.height, radius, fill, stroke, dash)
+		}
+	}
+
+	writeSVGLabel(w, cell.Value, box, style)
+}
+
+// writeSVGLabel writes the label of a vertex, as XHTML when it is well-formed HTML and
+// as plain text otherwise
+func writeSVGLabel(w *bytes.Buffer, label string, box svgBox, style map[string]string) {
+	if label == "" {
+		return
+	}
+
+	fontSize := style["fontSize"]
+	if fontSize == "" {
+		fontSize = "12"
+	}
+	color := svgColor(style["fontColor"], "#000000")
+	align := style["align"]
+	if align == "" {
+		align = "center"
+	}
+
+	if style["html"] == "1" && strings.Contains(label, "<") && isWellFormed(label) {
+		justify := "center"
+		if style["verticalAlign"] == "top" {
+			justify = "flex-start"
+		}
+		fmt.Fprintf(w, `<foreignObject x="%g" y="%g" width="%g" height="%g">`, box.x, box.y, box.width, box.height)
+		fmt.Fprintf(w, `<div xmlns="http://www.w3.org/1999/xhtml" style="display:flex;flex-direction:column;justify-content:%s;box-sizing:border-box;width:100%%;height:100%%;padding:2px;overflow:hidden;font-family:Helvetica;font-size:%spx;color:%s;text-align:%s;">`,
+			justify, fontSize, color, align)
+		fmt.Fprintf(w, "<div>%s</div></div></foreignObject>\n", label)
+		return
+	}
+
+	text := label
+	if style["html"] == "1" {
+		text = html.UnescapeString(htmlTag.ReplaceAllString(strings.ReplaceAll(label, "<br/>", " "), ""))
+	}
+
+	x, anchor := box.x+box.width/2, "middle"
+	switch align {
+	case "left":
+		x, anchor = box.x+4, "start"
+	case "right":
+		x, anchor = box.x+box.width-4, "end"
+	}
+	y := box.y + box.height/2
+	if style["verticalAlign"] == "top" {
+		y = box.y + 16
+	}
+	fmt.Fprintf(w, `<text x="%g" y="%g" text-anchor="%s" dominant-baseline="middle" font-family="Helvetica" font-size="%s" fill="%s">%s</text>`+"\n",
+		x, y, anchor, fontSize, color, svgEscape(text))
+}
+
+// parseSVGStyle splits a draw.io style into its properties, with an empty value for
+// flags such as text
+func parseSVGStyle(style string) map[string]string {
+	properties := make(map[string]string)
+	for _, property := range strings.Split(style, ";") {
+		if property == "" {
+			continue
+		}
+		key, value, _ := strings.Cut(property, "=")
+		properties[key] = value
+	}
+	return properties
+}
+
+// svgColor returns a draw.io color for SVG, with none for draw.io's none
+func svgColor(color, fallback string) string {
+	switch color {
+	case "":
+		return fallback
+	case "none":
+		return "none"
+	default:
+		return svgEscape(color)
+	}
+}
+
+// svgDash returns the dash attribute of dashed cells
+func svgDash(style map[string]string) string {
+	if style["dashed"] != "1" {
+		return ""
+	}
+	pattern := style["strokeDashArray"]
+	if pattern == "" {
+		pattern = "4 4"
+	}
+	return fmt.Sprintf(` stroke-dasharray="%s"`, svgEscape(pattern))
+}
+
+// isWellFormed reports whether an HTML fragment is well-formed XML
+func isWellFormed(fragment string) bool {
+	decoder := xml.NewDecoder(strings.NewReader("<div>" + fragment + "</div>"))
+	for {
+		_, err := decoder.Token()
+		if err == io.EOF {
+			return true
+		}
+		if err != nil {
+			return false
+		}
+	}
+}
+
+func svgEscape(s string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
@@ -0,0 +1,124 @@
+package gallery
+
+import (
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// parameter is a row of the parameter table of a caption
+type parameter struct {
+	Name        string
+	Type        string
+	Default     interface{}
+	Required    bool
+	Description string
+}
+
+// templateParameters returns the parameters of a template
+func templateParameters(template *schema.Template) []parameter {
+	params := make([]parameter, len(template.Parameters))
+	for i, param := range template.Parameters {
+		params[i] = parameter{
+			Name:        param.Name,
+			Type:        param.Type,
+			Default:     param.Default,
+			Required:    param.Required,
+			Description: param.Description,
+		}
+	}
+	return params
+}
+
+// schemaParameters returns the properties of the JSON schema of a resource, by name
+func schemaParameters(resourceSchema map[string]interface{}) []parameter {
+	properties, _ := resourceSchema["properties"].(map[string]interface{})
+	required := make(map[string]bool)
+	switch names := resourceSchema["required"].(type) {
+	case []string:
+		for _, name := range names {
+			required[name] = true
+		}
+	case []interface{}:
+		for _, name := range names {
+			required[fmt.Sprint(name)] = true
+		}
+	}
+
+	params := make([]parameter, 0, len(properties))
+	for name, property := range properties {
+		propertySchema, _ := property.(map[string]interface{})
+		param := parameter{Name: name, Default: propertySchema["default"], Required: required[name]}
+		if propertyType, ok := propertySchema["type"]; ok {
+			param.Type = fmt.Sprint(propertyType)
+		}
+		param.Description, _ = propertySchema["description"].(string)
+		params = append(params, param)
+	}
+	sort.Slice(params, func(i, j int) bool { return params[i].Name < params[j].Name })
+	return params
+}
+
+// snippet returns the YAML of the usage of an item
+func (entry *item) snippet() string {
+	return entry.Usage.YAML()
+}
+
+// caption returns the HTML label describing an item. The markup is well-formed XML, so
+// the SVG output can embed it.
+func (entry *item) caption() string {
+	var b strings.Builder
+	b.WriteString("<b>" + html.EscapeString(entry.Title) + "</b>")
+	if entry.Subtitle != "" {
+		b.WriteString(" <i>" + html.EscapeString(entry.Subtitle) + "</i>")
+	}
+	if entry.Description != "" {
+		b.WriteString("<br/>" + html.EscapeString(entry.Description))
+	}
+	if len(entry.Requires) > 0 {
+		b.WriteString("<br/>Shown in: " + html.EscapeString(strings.Join(entry.Requires, " > ")))
+	}
+
+	if len(entry.Parameters) > 0 {
+		b.WriteString(`<table border="1" cellspacing="0" cellpadding="2" style="border-collapse:collapse;margin-top:4px;">`)
+		b.WriteString("<tr><th>Parameter</th><th>Type</th><th>Default</th><th>Description</th></tr>")
+		for _, param := range entry.Parameters {
+			name := html.EscapeString(param.Name)
+			if param.Required {
+				name += "*"
+			}
+			defaultValue := ""
+			if param.Default != nil {
+				defaultValue = fmt.Sprint(param.Default)
+			}
+			fmt.Fprintf(&b, "<tr><td>%s</td><td>%s</td><td>%s</td><td>%s</td></tr>",
+				name, html.EscapeString(param.Type), html.EscapeString(defaultValue), html.EscapeString(param.Description))
+		}
+		b.WriteString("</table>")
+	}
+
+	b.WriteString(`<pre style="margin:4px 0 0 0;">` + html.EscapeString(entry.snippet()) + "</pre>")
+	return b.String()
+}
+
+// captionHeight estimates the height of the caption of an item
+func (entry *item) captionHeight() float64 {
+	lines := 1 + strings.Count(entry.snippet(), "\n") + 1
+	if entry.Description != "" {
+		// Long descriptions wrap
+		lines += 1 + len(entry.Description)/60
+	}
+	if len(entry.Requires) > 0 {
+		lines++
+	}
+	if len(entry.Parameters) > 0 {
+		lines += 1 + len(entry.Parameters)
+		for _, param := range entry.Parameters {
+			lines += len(param.Description) / 40
+		}
+	}
+	return float64(lines)*lineHeight + 10
+}
@@ -0,0 +1,420 @@
+// Package gallery renders the loaded templates and the resource examples of the registered
+// providers into a catalog diagram, with one page per template hive and per provider. Every
+// item is drawn the way a diagram would draw it, captioned with its key, its parameters and
+// the YAML that uses it.
+package gallery
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/LederWorks/hippodamus/pkg/catalog"
+	"github.com/LederWorks/hippodamus/pkg/drawio"
+	"github.com/LederWorks/hippodamus/pkg/providers"
+	"github.com/LederWorks/hippodamus/pkg/schema"
+	"github.com/LederWorks/hippodamus/pkg/templates"
+)
+
+// DefaultColumns is the number of items per row when Options.Columns is not set
+const DefaultColumns = 3
+
+// maxWrapping limits how many required parents are rendered around a resource example
+const maxWrapping = 5
+
+// Layout of the pages
+const (
+	margin        = 40.0  // Space around the items of a page
+	headingHeight = 40.0  // Height of the page heading
+	gap           = 40.0  // Space between items
+	captionGap    = 10.0  // Space between an item and its caption
+	captionWidth  = 360.0 // Minimum width of a caption
+	lineHeight    = 16.0  // Estimated height of a caption line
+)
+
+// Options controls what a gallery shows and how
+type Options struct {
+	Columns   int  // Items per row, DefaultColumns when zero
+	Templates bool // Include the loaded templates
+	Providers bool // Include the resource examples of the registered providers
+}
+
+// item is an entry of a gallery page
+type item struct {
+	Title       string            // Template key or resource in the provider.resource form
+	Subtitle    string            // Template version or example name
+	Description string            // Template or example description
+	Requires    []string          // Elements the item is rendered in, outermost first
+	Parameters  []parameter       // Parameters of the template or resource
+	Usage       catalog.Usage     // Element that uses the item
+	Element     schema.Element    // Rendered element
+	Failure     string            // Why the item could not be rendered
+	context     []*schema.Element // Required parents, outermost first
+}
+
+// Build renders the templates loaded by tp, per hive, and the resource examples of the
+// providers of its registry into a diagram. Templates are rendered with the defaults of
+// their parameters. Items that require a parent template or resource are rendered inside
+// one, and items that cannot be rendered are shown with the error.
+func Build(tp *templates.TemplateProcessor, options Options) (*schema.DiagramConfig, error) {
+	if options.Columns <= 0 {
+		options.Columns = DefaultColumns
+	}
+
+	config := &schema.DiagramConfig{
+		Version:  "1.0",
+		Metadata: schema.Metadata{Title: "Hippodamus Gallery"},
+	}
+
+	if options.Templates {
+		for _, hive := range catalog.Hives(tp) {
+			items := templateItems(tp, hive)
+			config.Diagram.Pages = append(config.Diagram.Pages, newPage(hivePageID(hive), hivePageName(hive), items, options.Columns))
+		}
+	}
+
+	if options.Providers {
+		registry := tp.Registry()
+		for _, name := range registry.List() {
+			provider, err := registry.Get(name)
+			if err != nil {
+				return nil, err
+			}
+			items := resourceItems(tp, provider)
+			if len(items) == 0 {
+				continue
+			}
+			config.Diagram.Pages = append(config.Diagram.Pages, newPage("provider-"+name, name+" provider", items, options.Columns))
+		}
+	}
+
+	if len(config.Diagram.Pages) == 0 {
+		return nil, fmt.Errorf("nothing to show: no templates are loaded and no provider has resource examples")
+	}
+	return config, nil
+}
+
+func hivePageID(hive catalog.Hive) string {
+	if hive.Name == "" {
+		return "templates"
+	}
+	return "hive-" + hive.Name
+}
+
+func hivePageName(hive catalog.Hive) string {
+	if hive.Name == "" {
+		return "Templates"
+	}
+	return hive.Name + " templates"
+}
+
+// templateItems renders the templates of a hive
+func templateItems(tp *templates.TemplateProcessor, hive catalog.Hive) []*item {
+	items := make([]*item, 0, len(hive.Templates))
+	for _, template := range hive.Templates {
+		entry := &item{
+			Title:       template.Key,
+			Subtitle:    version(template.Template.Version),
+			Description: template.Template.Description,
+			Parameters:  templateParameters(template.Template),
+			Usage:       catalog.Usage{ID: "example", Name: template.Template.Name, Template: template.Key, Properties: catalog.TemplateProperties(catalog.Defaults(template.Template))},
+		}
+		for i, parent := range catalog.Parents(tp, template) {
+			entry.context = append(entry.context, &schema.Element{
+				ID:         fmt.Sprintf("requires-%d", i+1),
+				Name:       parent.Template.Name,
+				Template:   parent.Key,
+				Properties: schema.ElementProperties{Custom: catalog.Defaults(parent.Template)},
+			})
+			entry.Requires = append(entry.Requires, parent.Key)
+		}
+		render(tp, entry)
+		items = append(items, entry)
+	}
+	return items
+}
+
+// resourceItems renders the resource examples of a provider
+func resourceItems(tp *templates.TemplateProcessor, provider providers.Provider) []*item {
+	definitions := make(map[string]providers.ResourceDefinition)
+	for _, definition := range provider.Resources() {
+		definitions[definition.Type] = definition
+	}
+
+	var items []*item
+	for _, definition := range provider.Resources() {
+		resource := provider.Name() + "." + definition.Type
+		for _, example := range definition.Examples {
+			entry := &item{
+				Title:       resource,
+				Subtitle:    example.Name,
+				Description: example.Description,
+				Parameters:  schemaParameters(definition.Schema),
+				Usage:       catalog.Usage{ID: "example", Name: example.Name, Resource: resource, Parameters: example.Config},
+			}
+			entry.context = requiredResources(provider.Name(), definition, definitions)
+			for _, parent := range entry.context {
+				entry.Requires = append(entry.Requires, parent.Resource)
+			}
+			render(tp, entry)
+			if entry.Failure != "" && len(entry.context) == 0 {
+				placeInProvider(tp, provider, entry)
+			}
+			items = append(items, entry)
+		}
+	}
+	return items
+}
+
+// placeInProvider renders an example that failed on its own inside the examples of the
+// other resources of its provider, for providers that check parents without declaring
+// containment rules. It tries every resource, then every pair of resources, and keeps the
+// first placement that renders.
+func placeInProvider(tp *templates.TemplateProcessor, provider providers.Provider, entry *item) {
+	var parents []*schema.Element
+	for _, definition := range provider.Resources() {
+		if len(definition.Examples) == 0 || provider.Name()+"."+definition.Type == entry.Title {
+			continue
+		}
+		parents = append(parents, &schema.Element{
+			Name:       definition.Name,
+			Resource:   provider.Name() + "." + definition.Type,
+			Parameters: definition.Examples[0].Config,
+		})
+	}
+
+	var candidates [][]*schema.Element
+	for _, parent := range parents {
+		candidates = append(candidates, []*schema.Element{parent})
+	}
+	for _, outer := range parents {
+		for _, parent := range parents {
+			if outer != parent {
+				candidates = append(candidates, []*schema.Element{outer, parent})
+			}
+		}
+	}
+
+	failure := entry.Failure
+	for _, context := range candidates {
+		attempt := *entry
+		attempt.context = nil
+		attempt.Requires = nil
+		for i, parent := range context {
+			wrapper := *parent
+			wrapper.ID = fmt.Sprintf("requires-%d", i+1)
+			attempt.context = append(attempt.context, &wrapper)
+			attempt.Requires = append(attempt.Requires, wrapper.Resource)
+		}
+		render(tp, &attempt)
+		if attempt.Failure == "" {
+			*entry = attempt
+			return
+		}
+	}
+	entry.Failure = failure
+}
+
+// requiredResources returns the resources an example of a resource must be placed in, for
+// the required ancestors or else the allowed parents declared on its definition, outermost first
+func requiredResources(providerName string, definition providers.ResourceDefinition, definitions map[string]providers.ResourceDefinition) []*schema.Element {
+	var context []*schema.Element
+	for len(context) < maxWrapping {
+		var required string
+		switch {
+		case len(definition.RequiredAncestors) > 0:
+			required = definition.RequiredAncestors[0]
+		case len(definition.AllowedParents) > 0:
+			required = definition.AllowedParents[0]
+		default:
+			return context
+		}
+
+		parent, exists := definitions[required]
+		if !exists {
+			// Resources of other providers are not rendered around examples
+			return context
+		}
+		params := map[string]interface{}{}
+		if len(parent.Examples) > 0 {
+			params = parent.Examples[0].Config
+		}
+		context = append([]*schema.Element{{
+			ID:         fmt.Sprintf("requires-%d", len(context)+1),
+			Name:       parent.Name,
+			Resource:   providerName + "." + parent.Type,
+			Parameters: params,
+		}}, context...)
+		definition = parent
+	}
+	return context
+}
+
+// render renders the element of an item inside its required parents
+func render(tp *templates.TemplateProcessor, entry *item) {
+	element := schema.Element{
+		ID:         "example",
+		Name:       entry.Usage.Name,
+		Template:   entry.Usage.Template,
+		Resource:   entry.Usage.Resource,
+		Parameters: copyParams(entry.Usage.Parameters),
+	}
+	if entry.Usage.Properties != nil {
+		element.Properties.Custom = copyParams(entry.Usage.Properties.Custom)
+	}
+	if element.Name == "" {
+		element.Name = entry.Title
+	}
+
+	// Nest the element in copies of its required parents
+	for i := len(entry.context) - 1; i >= 0; i-- {
+		parent := *entry.context[i]
+		parent.Parameters = copyParams(parent.Parameters)
+		parent.Properties.Custom = copyParams(parent.Properties.Custom)
+		parent.Children = []schema.Element{element}
+		element = parent
+	}
+
+	config := &schema.DiagramConfig{
+		Diagram: schema.Diagram{Pages: []schema.Page{{ID: "gallery", Name: "Gallery", Elements: []schema.Element{element}}}},
+	}
+	entry.Failure = ""
+	if err := tp.ProcessDiagram(config); err != nil {
+		entry.Failure = err.Error()
+		entry.Element = failureElement(entry.Failure)
+		return
+	}
+
+	entry.Element = config.Diagram.Pages[0].Elements[0]
+	if entry.Element.Type == schema.ElementTypeConnector {
+		entry.Element = connectorDemo(entry.Element)
+	}
+}
+
+// connectorDemo places a connector between two shapes named after its endpoints, so it
+// has something to connect
+func connectorDemo(connector schema.Element) schema.Element {
+	source, target := connector.Properties.Source, connector.Properties.Target
+	if source == "" || target == "" || source == target || strings.Contains(source+target, "/") {
+		return connector
+	}
+
+	endpoint := func(id string) schema.Element {
+		return schema.Element{
+			ID:         id,
+			Type:       schema.ElementTypeShape,
+			Properties: schema.ElementProperties{Label: id, Width: 100, Height: 50},
+			Style:      schema.Style{FillColor: "#F5F5F5", StrokeColor: "#9E9E9E", Rounded: true},
+		}
+	}
+
+	connector.ID = "connector"
+	return schema.Element{
+		ID:       "example",
+		Name:     connector.Name,
+		Type:     schema.ElementTypeGroup,
+		Children: []schema.Element{endpoint(source), endpoint(target), connector},
+		Nesting: schema.NestingConfig{
+			Arrangement: schema.ArrangementHorizontal,
+			Spacing:     120,
+			AutoResize:  true,
+			Padding:     schema.Padding{Top: 10, Right: 10, Bottom: 10, Left: 10},
+		},
+	}
+}
+
+// failureElement returns the element shown for an item that could not be rendered
+func failureElement(message string) schema.Element {
+	return schema.Element{
+		ID:         "example",
+		Name:       "failure",
+		Type:       schema.ElementTypeShape,
+		Properties: schema.ElementProperties{Label: "Cannot render: " + message, Width: captionWidth, Height: 80},
+		Style: schema.Style{
+			FillColor:       "#FFEBEE",
+			StrokeColor:     "#C62828",
+			StrokeDashArray: "4 4",
+			FontColor:       "#C62828",
+			Custom:          map[string]string{"whiteSpace": "wrap"},
+		},
+	}
+}
+
+// newPage arranges items in a grid with a caption below each item
+func newPage(id, name string, items []*item, columns int) schema.Page {
+	page := schema.Page{
+		ID:   id,
+		Name: name,
+		Elements: []schema.Element{{
+			ID:         "heading",
+			Name:       "Heading",
+			Type:       schema.ElementTypeText,
+			Properties: schema.ElementProperties{Label: fmt.Sprintf("%s (%d)", name, len(items)), X: margin, Y: margin / 2, Width: 600, Height: 30},
+			Style:      schema.Style{FontSize: 20, FontStyle: "1", TextAlign: "left"},
+		}},
+	}
+
+	generator := drawio.NewGenerator()
+	y := margin + headingHeight
+	for start := 0; start < len(items); start += columns {
+		row := items[start:min(start+columns, len(items))]
+
+		// Captions of a row are aligned below its tallest item
+		var elementHeight, captionHeight float64
+		for _, entry := range row {
+			generator.Layout(&entry.Element)
+			if entry.Element.Properties.Width == 0 && entry.Element.Properties.Height == 0 {
+				entry.Element.Properties.Width, entry.Element.Properties.Height = 140, 60
+			}
+			elementHeight = max(elementHeight, entry.Element.Properties.Height)
+			captionHeight = max(captionHeight, entry.captionHeight())
+		}
+
+		x := margin
+		for i, entry := range row {
+			number := start + i + 1
+			width := max(entry.Element.Properties.Width, captionWidth)
+
+			element := entry.Element
+			element.ID = fmt.Sprintf("item-%d", number)
+			element.Name = entry.Title
+			element.Properties.X = x
+			element.Properties.Y = y
+			page.Elements = append(page.Elements, element, schema.Element{
+				ID:         fmt.Sprintf("item-%d-caption", number),
+				Name:       entry.Title + " caption",
+				Type:       schema.ElementTypeText,
+				Properties: schema.ElementProperties{Label: entry.caption(), X: x, Y: y + elementHeight + captionGap, Width: width, Height: captionHeight},
+				Style: schema.Style{
+					FontSize:      11,
+					TextAlign:     "left",
+					VerticalAlign: "top",
+					Custom:        map[string]string{"whiteSpace": "wrap", "overflow": "hidden"},
+				},
+			})
+			x += width + gap
+		}
+
+		y += elementHeight + captionGap + captionHeight + gap
+	}
+
+	return page
+}
+
+// version returns the subtitle of a template version
+func version(v string) string {
+	if v == "" {
+		return ""
+	}
+	return "v" + v
+}
+
+func copyParams(params map[string]interface{}) map[string]interface{} {
+	if params == nil {
+		return nil
+	}
+	copied := make(map[string]interface{}, len(params))
+	for name, value := range params {
+		copied[name] = value
+	}
+	return copied
+}
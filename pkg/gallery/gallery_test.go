@@ -0,0 +1,250 @@
+package gallery
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/catalog"
+	"github.com/LederWorks/hippodamus/pkg/drawio"
+	"github.com/LederWorks/hippodamus/pkg/schema"
+	"github.com/LederWorks/hippodamus/pkg/templates/templatetest"
+	"github.com/LederWorks/hippodamus/providers/core"
+)
+
+// findElement returns the page element with the given name
+func findElement(page schema.Page, name string) (schema.Element, bool) {
+	for _, element := range page.Elements {
+		if element.Name == name {
+			return element, true
+		}
+	}
+	return schema.Element{}, false
+}
+
+func TestBuild(t *testing.T) {
+	// A hive with a zone and a box that must be placed in a zone, and a root note
+	tp := templatetest.NewProcessor(t, map[string]string{
+		"demo/zone.yaml": `name: demo-zone
+parameters:
+  - name: label
+    type: string
+    default: Zone
+group:
+  properties:
+    label: "{{.label}}"
+    width: 300
+    height: 200
+`,
+		"demo/demo-box.yaml": `name: demo-box
+description: A box in a zone
+version: "2.0"
+dependencies:
+  - name: zone
+    type: demo-zone
+    required: true
+    relationship: parent
+parameters:
+  - name: label
+    type: string
+    default: Box
+    description: Label of the box
+  - name: color
+    type: color
+    required: true
+group:
+  properties:
+    label: "{{.label}}"
+    width: 120
+    height: 60
+`,
+		"note.yaml": `name: note
+parameters:
+  - name: text
+    type: string
+    default: "Read <me> & weep"
+group:
+  properties:
+    label: "{{.text}}"
+`,
+		"demo/example.yaml": `version: "1.0"
+diagram:
+  pages: []
+`,
+	}, core.NewCoreProvider())
+
+	config, err := Build(tp, Options{Columns: 2, Templates: true, Providers: true})
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+
+	var pageIDs []string
+	for _, page := range config.Diagram.Pages {
+		pageIDs = append(pageIDs, page.ID)
+	}
+	if got, want := strings.Join(pageIDs, ","), "templates,hive-demo,provider-core"; got != want {
+		t.Fatalf("Pages = %s, want %s", got, want)
+	}
+
+	demo := config.Diagram.Pages[1]
+	box, exists := findElement(demo, "demo/demo-box")
+	if !exists {
+		t.Fatal("Expected an item for demo/demo-box")
+	}
+	if box.Template != "demo/demo-zone" || len(box.Children) != 1 || box.Children[0].Template != "demo/demo-box" {
+		t.Errorf("Expected demo/demo-box to be rendered in a demo-zone, got %+v", box)
+	}
+
+	caption, exists := findElement(demo, "demo/demo-box caption")
+	if !exists {
+		t.Fatal("Expected a caption for demo/demo-box")
+	}
+	for _, want := range []string{
+		"<b>demo/demo-box</b> <i>v2.0</i>",
+		"Shown in: demo/demo-zone",
+		"<tr><td>label</td><td>string</td><td>Box</td><td>Label of the box</td></tr>",
+		"<tr><td>color*</td><td>color</td><td></td><td></td></tr>",
+		"template: demo/demo-box",
+	} {
+		if !strings.Contains(caption.Properties.Label, want) {
+			t.Errorf("Caption does not contain %q:\n%s", want, caption.Properties.Label)
+		}
+	}
+	if caption.Properties.Y <= box.Properties.Y+box.Properties.Height {
+		t.Errorf("Caption at y=%v overlaps its item at y=%v with height %v", caption.Properties.Y, box.Properties.Y, box.Properties.Height)
+	}
+
+	// Items of a row are side by side, the next row is below the captions
+	zone, _ := findElement(demo, "demo/demo-zone")
+	if box.Properties.Y != zone.Properties.Y || zone.Properties.X <= box.Properties.X {
+		t.Errorf("Expected demo/demo-zone right of demo/demo-box, got %+v and %+v", zone.Properties, box.Properties)
+	}
+
+	note, _ := findElement(config.Diagram.Pages[0], "note caption")
+	if !strings.Contains(note.Properties.Label, "Read &lt;me&gt; &amp; weep") {
+		t.Errorf("Expected the default of the note to be escaped, got:\n%s", note.Properties.Label)
+	}
+
+	core := config.Diagram.Pages[2]
+	if len(core.Elements) < 3 {
+		t.Fatalf("Expected the core resource examples, got %d elements", len(core.Elements))
+	}
+	for _, element := range core.Elements {
+		if strings.HasPrefix(element.Properties.Label, "Cannot render") {
+			t.Errorf("Example %s cannot be rendered: %s", element.Name, element.Properties.Label)
+		}
+	}
+	text, exists := findElement(core, "core.text caption")
+	if !exists || !strings.Contains(text.Properties.Label, "resource: core.text") {
+		t.Errorf("Expected the core.text example with its usage, got %+v", text)
+	}
+}
+
+func TestBuild_Options(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   map[string]string
+		options Options
+		pageIDs string
+		wantErr bool
+	}{
+		{
+			name:    "providers",
+			options: Options{Providers: true},
+			pageIDs: "provider-core",
+		},
+		{
+			name:    "templates",
+			files:   map[string]string{"note.yaml": "name: note\ngroup:\n  properties:\n    label: Note\n"},
+			options: Options{Templates: true},
+			pageIDs: "templates",
+		},
+		{
+			name:    "no items",
+			options: Options{Templates: true},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, err := Build(templatetest.NewProcessor(t, tt.files, core.NewCoreProvider()), tt.options)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Build() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			var pageIDs []string
+			for _, page := range config.Diagram.Pages {
+				pageIDs = append(pageIDs, page.ID)
+			}
+			if got := strings.Join(pageIDs, ","); got != tt.pageIDs {
+				t.Errorf("Pages = %s, want %s", got, tt.pageIDs)
+			}
+		})
+	}
+}
+
+func TestBuild_Failure(t *testing.T) {
+	tp := templatetest.NewProcessor(t, nil)
+
+	entry := &item{Title: "missing", Usage: catalog.Usage{ID: "example", Template: "missing"}}
+	render(tp, entry)
+	if entry.Failure == "" {
+		t.Fatal("Expected a failure for a missing template")
+	}
+	if !strings.HasPrefix(entry.Element.Properties.Label, "Cannot render: ") || entry.Element.Style.StrokeDashArray == "" {
+		t.Errorf("Expected a dashed placeholder with the error, got %+v", entry.Element)
+	}
+}
+
+func TestBuild_SVG(t *testing.T) {
+	tp := templatetest.NewProcessor(t, map[string]string{
+		"note.yaml": `name: note
+parameters:
+  - name: text
+    type: string
+    default: "Read <me> & weep"
+group:
+  properties:
+    label: "{{.text}}"
+`,
+	}, core.NewCoreProvider())
+
+	config, err := Build(tp, Options{Templates: true, Providers: true})
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+	document, err := drawio.NewGenerator().Generate(config)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	var out bytes.Buffer
+	if err := drawio.WriteSVG(&out, document); err != nil {
+		t.Fatalf("WriteSVG() error = %v", err)
+	}
+
+	// Captions are embedded as XHTML, so the image must be well-formed
+	decoder := xml.NewDecoder(&out)
+	foreignObjects := 0
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("SVG is not well-formed: %v", err)
+		}
+		if start, ok := token.(xml.StartElement); ok && start.Name.Local == "foreignObject" {
+			foreignObjects++
+		}
+	}
+	if foreignObjects == 0 {
+		t.Error("Expected the captions as foreignObject elements")
+	}
+}
@@ -0,0 +1,209 @@
+package hippotest
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// node is an XML element
+type node struct {
+	name     string
+	attrs    map[string]string
+	children []*node
+}
+
+// parseXML parses an XML document into its root element
+func parseXML(data []byte) (*node, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	var stack []*node
+	var root *node
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			n := &node{name: t.Name.Local, attrs: make(map[string]string)}
+			for _, attr := range t.Attr {
+				n.attrs[attr.Name.Local] = attr.Value
+			}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, n)
+			} else if root == nil {
+				root = n
+			}
+			stack = append(stack, n)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		}
+	}
+	if root == nil {
+		return nil, fmt.Errorf("no XML element")
+	}
+	return root, nil
+}
+
+// Diff compares two draw.io XML documents structurally and returns their differences, one
+// per line: added and removed cells and pages, and changed attributes and style
+// properties. Elements with an id are matched by id, others by position.
+func Diff(want, got []byte) ([]string, error) {
+	wantRoot, err := parseXML(want)
+	if err != nil {
+		return nil, fmt.Errorf("invalid golden XML: %w", err)
+	}
+	gotRoot, err := parseXML(got)
+	if err != nil {
+		return nil, fmt.Errorf("invalid output XML: %w", err)
+	}
+
+	var diff []string
+	diffNode(&diff, wantRoot.name, wantRoot, gotRoot)
+	return diff, nil
+}
+
+// transparent are the elements left out of paths, which only wrap the cells of a page
+var transparent = map[string]bool{"mxGraphModel": true, "root": true}
+
+// diffNode appends the differences of two elements at a path
+func diffNode(diff *[]string, path string, want, got *node) {
+	for _, name := range attrNames(want, got) {
+		wantValue, inWant := want.attrs[name]
+		gotValue, inGot := got.attrs[name]
+		switch {
+		case name == "style":
+			diffStyle(diff, path, wantValue, gotValue)
+		case !inGot:
+			*diff = append(*diff, fmt.Sprintf("%s: %s %q removed", path, name, wantValue))
+		case !inWant:
+			*diff = append(*diff, fmt.Sprintf("%s: %s %q added", path, name, gotValue))
+		case wantValue != gotValue:
+			*diff = append(*diff, fmt.Sprintf("%s: %s %q, want %q", path, name, gotValue, wantValue))
+		}
+	}
+
+	wantChildren, wantKeys := keyChildren(want)
+	gotChildren, gotKeys := keyChildren(got)
+	for _, key := range wantKeys {
+		if child, exists := gotChildren[key]; exists {
+			childPath := path + "/" + key
+			if transparent[child.name] {
+				childPath = path
+			}
+			diffNode(diff, childPath, wantChildren[key], child)
+		} else {
+			*diff = append(*diff, fmt.Sprintf("- %s/%s%s", path, key, summary(wantChildren[key])))
+		}
+	}
+	for _, key := range gotKeys {
+		if _, exists := wantChildren[key]; !exists {
+			*diff = append(*diff, fmt.Sprintf("+ %s/%s%s", path, key, summary(gotChildren[key])))
+		}
+	}
+}
+
+// diffStyle appends the differences of two draw.io styles
+func diffStyle(diff *[]string, path, want, got string) {
+	wantProperties, gotProperties := styleProperties(want), styleProperties(got)
+	names := make(map[string]bool)
+	for name := range wantProperties {
+		names[name] = true
+	}
+	for name := range gotProperties {
+		names[name] = true
+	}
+
+	for _, name := range sortedNames(names) {
+		wantValue, inWant := wantProperties[name]
+		gotValue, inGot := gotProperties[name]
+		switch {
+		case !inGot:
+			*diff = append(*diff, fmt.Sprintf("%s: style %s=%s removed", path, name, wantValue))
+		case !inWant:
+			*diff = append(*diff, fmt.Sprintf("%s: style %s=%s added", path, name, gotValue))
+		case wantValue != gotValue:
+			*diff = append(*diff, fmt.Sprintf("%s: style %s=%s, want %s", path, name, gotValue, wantValue))
+		}
+	}
+}
+
+// styleProperties returns the properties of a draw.io style, with shape names such as
+// ellipse as properties without value
+func styleProperties(style string) map[string]string {
+	properties := make(map[string]string)
+	for _, property := range strings.Split(style, ";") {
+		if property == "" {
+			continue
+		}
+		name, value, _ := strings.Cut(property, "=")
+		properties[name] = value
+	}
+	return properties
+}
+
+// keyChildren returns the children of an element by key and the keys in document order.
+// Children with an id are keyed name[id], the others by name alone when it is unique
+// among them and name[index] otherwise.
+func keyChildren(n *node) (map[string]*node, []string) {
+	children := make(map[string]*node, len(n.children))
+	keys := make([]string, 0, len(n.children))
+	total := make(map[string]int)
+	for _, child := range n.children {
+		total[child.name]++
+	}
+	counts := make(map[string]int)
+	for _, child := range n.children {
+		var key string
+		if id, exists := child.attrs["id"]; exists {
+			key = fmt.Sprintf("%s[%s]", child.name, id)
+		} else if total[child.name] == 1 {
+			key = child.name
+		} else {
+			key = fmt.Sprintf("%s[%d]", child.name, counts[child.name])
+			counts[child.name]++
+		}
+		children[key] = child
+		keys = append(keys, key)
+	}
+	return children, keys
+}
+
+// summary describes an added or removed element by its value or name
+func summary(n *node) string {
+	for _, attr := range []string{"value", "label", "name"} {
+		if value := n.attrs[attr]; value != "" {
+			return fmt.Sprintf(" (%s %q)", attr, value)
+		}
+	}
+	return ""
+}
+
+// attrNames returns the attribute names of two elements, sorted
+func attrNames(a, b *node) []string {
+	names := make(map[string]bool)
+	for name := range a.attrs {
+		names[name] = true
+	}
+	for name := range b.attrs {
+		names[name] = true
+	}
+	return sortedNames(names)
+}
+
+func sortedNames(set map[string]bool) []string {
+	names := make([]string, 0, len(set))
+	for name := range set {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
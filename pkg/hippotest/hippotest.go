@@ -0,0 +1,265 @@
+// Package hippotest renders the example configs of template hives and compares them with
+// checked-in golden files, so template changes that alter the generated diagrams show up
+// as readable structural diffs instead of going unnoticed.
+//
+// Each hive keeps its configs in templates/<hive>/configs and their golden files, the
+// normalized draw.io XML they render to, in templates/<hive>/golden.
+package hippotest
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/drawio"
+	"github.com/LederWorks/hippodamus/pkg/loader"
+	"github.com/LederWorks/hippodamus/pkg/templates"
+	"github.com/LederWorks/hippodamus/pkg/views"
+)
+
+// Directories of a hive holding the configs and their golden files
+const (
+	ConfigsDir = "configs"
+	GoldenDir  = "golden"
+)
+
+// GoldenExt is the extension of golden files
+const GoldenExt = ".drawio"
+
+// MaxDiffLines is the number of differences Result.Report shows
+const MaxDiffLines = 20
+
+// Case is a config rendered and compared with its golden file
+type Case struct {
+	Name   string // hive/config, such as generic/server
+	Hive   string
+	Config string // Path of the config
+	Golden string // Path of the golden file
+}
+
+// Status is the outcome of running a case
+type Status string
+
+// Status constants
+const (
+	StatusPass    Status = "ok"      // Output matches the golden file
+	StatusFail    Status = "FAIL"    // Output differs from the golden file, or the golden file is missing
+	StatusError   Status = "ERROR"   // Config does not render
+	StatusUpdated Status = "updated" // Golden file was written
+)
+
+// Result is the outcome of running a case
+type Result struct {
+	Case   Case
+	Status Status
+	Diff   []string // Differences between the golden file and the output
+	Err    error    // Render error or why the case failed
+}
+
+// Options controls how cases run
+type Options struct {
+	TemplatesDir string
+	Update       bool // Write the golden files instead of comparing with them
+}
+
+// Discover returns the cases of the configs of every hive of a templates directory,
+// sorted by name
+func Discover(templatesDir string) ([]Case, error) {
+	files, err := filepath.Glob(filepath.Join(templatesDir, "*", ConfigsDir, "*.yaml"))
+	if err != nil {
+		return nil, err
+	}
+
+	cases := make([]Case, 0, len(files))
+	for _, file := range files {
+		hiveDir := filepath.Dir(filepath.Dir(file))
+		name := strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+		cases = append(cases, Case{
+			Name:   filepath.Base(hiveDir) + "/" + name,
+			Hive:   filepath.Base(hiveDir),
+			Config: file,
+			Golden: filepath.Join(hiveDir, GoldenDir, name+GoldenExt),
+		})
+	}
+	sort.Slice(cases, func(i, j int) bool { return cases[i].Name < cases[j].Name })
+	return cases, nil
+}
+
+// Render renders a config with the templates of a directory the way the CLI does without
+// options, and returns its normalized draw.io XML
+func Render(configFile, templatesDir string) ([]byte, error) {
+	config, err := loader.LoadDiagramConfig(configFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load diagram configuration: %w", err)
+	}
+
+	templateProcessor := templates.NewTemplateProcessor(templatesDir)
+	defer templateProcessor.Close()
+	if err := templateProcessor.LoadTemplates(); err != nil {
+		return nil, fmt.Errorf("failed to load templates: %w", err)
+	}
+	if err := templateProcessor.ProcessDiagram(config); err != nil {
+		return nil, fmt.Errorf("failed to process diagram templates: %w", err)
+	}
+	views.ApplyDetail(config, 0)
+
+	document, err := drawio.NewGenerator().Generate(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate draw.io XML: %w", err)
+	}
+	return Normalize(document)
+}
+
+// Normalize returns the draw.io XML of a document in a canonical form: indented, without
+// modification time and with the style properties of every cell sorted, so only changes
+// of the diagram change it
+func Normalize(document *drawio.DrawioDocument) ([]byte, error) {
+	normalized := *document
+	normalized.Modified = ""
+	normalized.ETag = ""
+	normalized.Diagram = make([]drawio.DrawioDiagram, len(document.Diagram))
+	for i, diagram := range document.Diagram {
+		cells := make([]drawio.DrawioCell, len(diagram.GraphModel.Root.Cells))
+		for j, cell := range diagram.GraphModel.Root.Cells {
+			cell.Style = sortStyle(cell.Style)
+			cells[j] = cell
+		}
+		diagram.GraphModel.Root.Cells = cells
+		normalized.Diagram[i] = diagram
+	}
+
+	var b bytes.Buffer
+	b.WriteString(xml.Header)
+	encoder := xml.NewEncoder(&b)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(&normalized); err != nil {
+		return nil, err
+	}
+	b.WriteString("\n")
+	return b.Bytes(), nil
+}
+
+// sortStyle returns a draw.io style with its properties sorted
+func sortStyle(style string) string {
+	var properties []string
+	for _, property := range strings.Split(style, ";") {
+		if property != "" {
+			properties = append(properties, property)
+		}
+	}
+	sort.Strings(properties)
+	if len(properties) == 0 {
+		return ""
+	}
+	return strings.Join(properties, ";") + ";"
+}
+
+// Run renders the config of a case and compares it with its golden file, or writes the
+// golden file when updating
+func Run(c Case, options Options) Result {
+	result := Result{Case: c}
+
+	got, err := Render(c.Config, options.TemplatesDir)
+	if err != nil {
+		result.Status, result.Err = StatusError, err
+		return result
+	}
+
+	if options.Update {
+		want, err := os.ReadFile(c.Golden)
+		if err == nil && bytes.Equal(want, got) {
+			result.Status = StatusPass
+			return result
+		}
+		if err := os.MkdirAll(filepath.Dir(c.Golden), 0755); err != nil {
+			result.Status, result.Err = StatusError, err
+			return result
+		}
+		if err := os.WriteFile(c.Golden, got, 0644); err != nil {
+			result.Status, result.Err = StatusError, err
+			return result
+		}
+		result.Status = StatusUpdated
+		return result
+	}
+
+	want, err := os.ReadFile(c.Golden)
+	if errors.Is(err, os.ErrNotExist) {
+		result.Status, result.Err = StatusFail, fmt.Errorf("golden file %s does not exist, create it with -update", c.Golden)
+		return result
+	} else if err != nil {
+		result.Status, result.Err = StatusError, err
+		return result
+	}
+	if bytes.Equal(want, got) {
+		result.Status = StatusPass
+		return result
+	}
+
+	result.Status = StatusFail
+	result.Diff, err = Diff(want, got)
+	if err != nil {
+		result.Err = fmt.Errorf("golden file %s: %w", c.Golden, err)
+	} else if len(result.Diff) == 0 {
+		// Same structure, different formatting
+		result.Err = fmt.Errorf("output differs from %s in formatting only, rewrite it with -update", c.Golden)
+	}
+	return result
+}
+
+// Report returns the details of a result that did not pass: its error and up to
+// MaxDiffLines differences, one per line
+func (r Result) Report() string {
+	var lines []string
+	if r.Err != nil {
+		lines = append(lines, r.Err.Error())
+	}
+	for i, line := range r.Diff {
+		if i == MaxDiffLines {
+			lines = append(lines, fmt.Sprintf("... and %d more differences", len(r.Diff)-MaxDiffLines))
+			break
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// CheckDir runs the cases of a templates directory as subtests of t, failing those whose
+// output differs from their golden file
+func CheckDir(t *testing.T, options Options) {
+	t.Helper()
+
+	cases, err := Discover(options.TemplatesDir)
+	if err != nil {
+		t.Fatalf("Discover() error = %v", err)
+	}
+	if len(cases) == 0 {
+		t.Fatalf("No configs found in %s", filepath.Join(options.TemplatesDir, "*", ConfigsDir))
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			Check(t, c, options)
+		})
+	}
+}
+
+// Check runs a case, failing t when its output differs from its golden file
+func Check(t testing.TB, c Case, options Options) {
+	t.Helper()
+
+	result := Run(c, options)
+	switch result.Status {
+	case StatusUpdated:
+		t.Logf("Updated %s", c.Golden)
+	case StatusFail, StatusError:
+		t.Errorf("%s: %s\n%s", c.Config, result.Status, result.Report())
+	}
+}
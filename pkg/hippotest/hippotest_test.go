@@ -0,0 +1,182 @@
+package hippotest
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/drawio"
+)
+
+var update = flag.Bool("update", false, "Rewrite the golden files of the repository templates")
+
+// TestTemplateConfigs checks that the configs of the repository template hives render to
+// their golden files. Rewrite the golden files after an intended change with:
+//
+//	go test ./pkg/hippotest -run TestTemplateConfigs -update
+func TestTemplateConfigs(t *testing.T) {
+	CheckDir(t, Options{TemplatesDir: filepath.Join("..", "..", "templates"), Update: *update})
+}
+
+const boxTemplate = `name: box
+parameters:
+  - name: color
+    type: color
+    default: "#FFFFFF"
+group:
+  properties:
+    label: "{{.name}}"
+  style:
+    fillColor: "{{.color}}"
+`
+
+const boxConfig = `version: "1.0"
+diagram:
+  pages:
+    - id: page1
+      name: Page
+      elements:
+        - id: box1
+          name: First
+          template: box
+          properties:
+            custom:
+              color: "#FF0000"
+`
+
+// writeFiles writes files into a temporary directory and returns it
+func writeFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestRun(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"shapes/templates/box.yaml": boxTemplate,
+		"shapes/configs/box.yaml":   boxConfig,
+	})
+
+	cases, err := Discover(dir)
+	if err != nil {
+		t.Fatalf("Discover() error = %v", err)
+	}
+	if len(cases) != 1 || cases[0].Name != "shapes/box" || cases[0].Golden != filepath.Join(dir, "shapes", "golden", "box.drawio") {
+		t.Fatalf("Discover() = %+v, want shapes/box", cases)
+	}
+	options := Options{TemplatesDir: dir}
+
+	if result := Run(cases[0], options); result.Status != StatusFail || !strings.Contains(result.Report(), "-update") {
+		t.Errorf("Run() without golden file = %s %q, want FAIL asking for -update", result.Status, result.Report())
+	}
+
+	options.Update = true
+	if result := Run(cases[0], options); result.Status != StatusUpdated {
+		t.Fatalf("Run() with update = %s %v, want updated", result.Status, result.Err)
+	}
+	if result := Run(cases[0], options); result.Status != StatusPass {
+		t.Errorf("Run() with update of an unchanged config = %s, want ok", result.Status)
+	}
+
+	options.Update = false
+	if result := Run(cases[0], options); result.Status != StatusPass {
+		t.Errorf("Run() = %s %q, want ok", result.Status, result.Report())
+	}
+
+	changed := strings.ReplaceAll(strings.ReplaceAll(boxConfig, "#FF0000", "#00FF00"), "First", "Second")
+	if err := os.WriteFile(cases[0].Config, []byte(changed), 0644); err != nil {
+		t.Fatal(err)
+	}
+	result := Run(cases[0], options)
+	want := []string{
+		"mxfile/diagram[page1]/mxCell[page1/box1]: style fillColor=#00FF00, want #FF0000",
+		`mxfile/diagram[page1]/mxCell[page1/box1]: value "Second", want "First"`,
+	}
+	if result.Status != StatusFail || strings.Join(result.Diff, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Run() of a changed config = %s %q, want FAIL %q", result.Status, result.Diff, want)
+	}
+
+	if err := os.WriteFile(cases[0].Config, []byte("version: [\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if result := Run(cases[0], options); result.Status != StatusError || result.Err == nil {
+		t.Errorf("Run() of an invalid config = %s, want ERROR", result.Status)
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	document := &drawio.DrawioDocument{
+		Modified: "2024-01-01T00:00:00Z",
+		Diagram: []drawio.DrawioDiagram{{
+			ID: "page1",
+			GraphModel: drawio.DrawioGraphModel{Root: drawio.DrawioRoot{Cells: []drawio.DrawioCell{
+				{ID: "a", Style: "rounded=1;fillColor=#FFFFFF;ellipse"},
+			}}},
+		}},
+	}
+
+	data, err := Normalize(document)
+	if err != nil {
+		t.Fatalf("Normalize() error = %v", err)
+	}
+	for _, want := range []string{`modified=""`, `style="ellipse;fillColor=#FFFFFF;rounded=1;"`} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("Normalize() does not contain %s:\n%s", want, data)
+		}
+	}
+	if document.Diagram[0].GraphModel.Root.Cells[0].Style != "rounded=1;fillColor=#FFFFFF;ellipse" {
+		t.Error("Normalize() changed the document")
+	}
+}
+
+func TestDiff(t *testing.T) {
+	want := `<mxfile version="1">
+  <diagram id="p1" name="Page">
+    <mxGraphModel><root>
+      <mxCell id="a" value="A" style="fillColor=#FFFFFF;rounded=1;"><mxGeometry x="10" width="80"></mxGeometry></mxCell>
+      <mxCell id="b" value="B"></mxCell>
+    </root></mxGraphModel>
+  </diagram>
+  <diagram id="p2" name="Removed"></diagram>
+</mxfile>`
+	got := `<mxfile version="1">
+  <diagram id="p1" name="Page">
+    <mxGraphModel><root>
+      <mxCell id="a" value="A" style="fillColor=#000000;shadow=1;"><mxGeometry x="20" width="80" height="40"></mxGeometry></mxCell>
+      <mxCell id="c" value="C"></mxCell>
+    </root></mxGraphModel>
+  </diagram>
+</mxfile>`
+
+	diff, err := Diff([]byte(want), []byte(got))
+	if err != nil {
+		t.Fatalf("Diff() error = %v", err)
+	}
+	wantDiff := `mxfile/diagram[p1]/mxCell[a]: style fillColor=#000000, want #FFFFFF
+mxfile/diagram[p1]/mxCell[a]: style rounded=1 removed
+mxfile/diagram[p1]/mxCell[a]: style shadow=1 added
+mxfile/diagram[p1]/mxCell[a]/mxGeometry: height "40" added
+mxfile/diagram[p1]/mxCell[a]/mxGeometry: x "20", want "10"
+- mxfile/diagram[p1]/mxCell[b] (value "B")
++ mxfile/diagram[p1]/mxCell[c] (value "C")
+- mxfile/diagram[p2] (name "Removed")`
+	if strings.Join(diff, "\n") != wantDiff {
+		t.Errorf("Diff() =\n%s\nwant\n%s", strings.Join(diff, "\n"), wantDiff)
+	}
+
+	if _, err := Diff([]byte(want), []byte("<mxfile>")); err == nil {
+		t.Error("Expected an error for invalid XML")
+	}
+}
@@ -0,0 +1,229 @@
+package lint
+
+import (
+	"regexp"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/LederWorks/hippodamus/pkg/templates"
+)
+
+// colorPattern matches the hex colors draw.io accepts
+var colorPattern = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})$`)
+
+// expressionFuncs are the functions available to template expressions, only their names
+// matter for parsing
+var expressionFuncs = template.FuncMap{
+	"eq":  func() bool { return false },
+	"ne":  func() bool { return false },
+	"and": func() bool { return false },
+	"or":  func() bool { return false },
+	"not": func() bool { return false },
+}
+
+// parseErrorPrefix matches the template name and line text/template prefixes parse
+// errors with, as in template: :1: unexpected "}" in operand
+var parseErrorPrefix = regexp.MustCompile(`^template: [^:]*:\d+: `)
+
+// variableUse is a variable used by an expression, at an offset of the scalar it is in
+type variableUse struct {
+	name   string
+	offset int
+}
+
+// checkParameters checks the parameter declarations of a template against the variables
+// its expressions use
+func (l *linter) checkParameters(file *templateFile) {
+	declared := make(map[string]bool)
+	nodes := mappingValue(file.root, "parameters")
+	for i, param := range file.template.Parameters {
+		var node *yaml.Node
+		if nodes != nil && i < len(nodes.Content) {
+			node = nodes.Content[i]
+		}
+
+		if declared[param.Name] {
+			l.reportAt(file, mappingValue(node, "name"), SeverityError, CodeDuplicateParameter, "parameter %s is declared more than once", param.Name)
+		}
+		declared[param.Name] = true
+
+		if param.Type == "color" && param.Default != nil && !validColor(param.Default) {
+			l.reportAt(file, mappingValue(node, "default"), SeverityError, CodeInvalidColor,
+				"default %v of color parameter %s is not a color, use #RGB, #RRGGBB or none", param.Default, param.Name)
+		}
+	}
+
+	builtins := make(map[string]bool)
+	for _, name := range templates.BuiltinVariables {
+		builtins[name] = true
+	}
+
+	used := make(map[string]bool)
+	l.walkScalars(mappingValue(file.root, "group"), func(node *yaml.Node) {
+		for _, use := range l.parseExpressions(file, node) {
+			used[use.name] = true
+			if !declared[use.name] && !builtins[use.name] {
+				line, column := position(file, node, use.offset)
+				l.report(file.path, line, column, SeverityError, CodeUndefinedVariable,
+					"variable %s is neither a parameter nor a built-in variable", use.name)
+			}
+		}
+	})
+
+	for i, param := range file.template.Parameters {
+		if used[param.Name] {
+			continue
+		}
+		var node *yaml.Node
+		if nodes != nil && i < len(nodes.Content) {
+			node = mappingValue(nodes.Content[i], "name")
+		}
+		l.reportAt(file, node, SeverityWarning, CodeUnusedParameter, "parameter %s is not used by any expression", param.Name)
+	}
+}
+
+// walkScalars calls fn for every string scalar below node that contains an expression
+func (l *linter) walkScalars(node *yaml.Node, fn func(node *yaml.Node)) {
+	if node == nil {
+		return
+	}
+	switch node.Kind {
+	case yaml.ScalarNode:
+		if strings.Contains(node.Value, "{{") {
+			fn(node)
+		}
+	case yaml.MappingNode:
+		// Only values hold expressions
+		for i := 1; i < len(node.Content); i += 2 {
+			l.walkScalars(node.Content[i], fn)
+		}
+	default:
+		for _, child := range node.Content {
+			l.walkScalars(child, fn)
+		}
+	}
+}
+
+// parseExpressions parses the expressions of a scalar, reporting syntax errors, and returns
+// the variables they use
+func (l *linter) parseExpressions(file *templateFile, node *yaml.Node) []variableUse {
+	tmpl, err := template.New("").Funcs(expressionFuncs).Parse(node.Value)
+	if err != nil {
+		message := parseErrorPrefix.ReplaceAllString(err.Error(), "")
+		l.reportAt(file, node, SeverityError, CodeSyntax, "invalid expression: %s", message)
+		return nil
+	}
+	if tmpl.Tree == nil {
+		return nil
+	}
+
+	var uses []variableUse
+	walkTree(tmpl.Tree.Root, true, func(name string, pos parse.Pos) {
+		uses = append(uses, variableUse{name: name, offset: int(pos)})
+	})
+	return uses
+}
+
+// walkTree calls fn for every template variable used below node. Fields only refer to the
+// variables while dot is the data of the template, range and with change it to something
+// else.
+func walkTree(node parse.Node, root bool, fn func(name string, pos parse.Pos)) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			walkTree(child, root, fn)
+		}
+	case *parse.ActionNode:
+		walkTree(n.Pipe, root, fn)
+	case *parse.IfNode:
+		walkBranch(&n.BranchNode, root, root, fn)
+	case *parse.RangeNode:
+		walkBranch(&n.BranchNode, root, false, fn)
+	case *parse.WithNode:
+		walkBranch(&n.BranchNode, root, false, fn)
+	case *parse.TemplateNode:
+		walkTree(n.Pipe, root, fn)
+	case *parse.PipeNode:
+		if n == nil {
+			return
+		}
+		for _, command := range n.Cmds {
+			walkTree(command, root, fn)
+		}
+	case *parse.CommandNode:
+		for _, arg := range n.Args {
+			walkTree(arg, root, fn)
+		}
+	case *parse.ChainNode:
+		walkTree(n.Node, root, fn)
+	case *parse.FieldNode:
+		if root {
+			fn(n.Ident[0], n.Pos)
+		}
+	case *parse.VariableNode:
+		// $ is the data of the template wherever dot is
+		if len(n.Ident) > 1 && n.Ident[0] == "$" {
+			fn(n.Ident[1], n.Pos)
+		}
+	}
+}
+
+// walkBranch walks an if, range or with node, with bodyRoot telling whether dot is still
+// the data of the template in its body
+func walkBranch(n *parse.BranchNode, root, bodyRoot bool, fn func(name string, pos parse.Pos)) {
+	walkTree(n.Pipe, root, fn)
+	walkTree(n.List, bodyRoot, fn)
+	walkTree(n.ElseList, root, fn)
+}
+
+// position returns the line and column of an offset of the value of a scalar. Positions
+// inside plain and quoted single-line scalars and block scalars are exact, other scalars
+// report the position of the scalar.
+func position(file *templateFile, node *yaml.Node, offset int) (int, int) {
+	value := node.Value
+	if offset > len(value) {
+		offset = len(value)
+	}
+
+	switch node.Style {
+	case yaml.LiteralStyle, yaml.FoldedStyle:
+		line := node.Line + 1 + strings.Count(value[:offset], "\n")
+		start := strings.LastIndex(value[:offset], "\n") + 1
+		return line, indentation(file.data, node.Line+1) + 1 + offset - start
+	case yaml.DoubleQuotedStyle, yaml.SingleQuotedStyle:
+		if !strings.Contains(value, "\n") && !strings.ContainsAny(value, `\'`) {
+			return node.Line, node.Column + 1 + offset
+		}
+	case 0:
+		if !strings.Contains(value, "\n") {
+			return node.Line, node.Column + offset
+		}
+	}
+	return node.Line, node.Column
+}
+
+// indentation returns the number of leading spaces of a 1-based line of data, for the
+// first line of a block scalar the indentation of its content
+func indentation(data []byte, line int) int {
+	lines := strings.Split(string(data), "\n")
+	if line < 1 || line > len(lines) {
+		return 0
+	}
+	return len(lines[line-1]) - len(strings.TrimLeft(lines[line-1], " "))
+}
+
+// validColor reports whether a color parameter default is a hex color or one of the
+// keywords draw.io accepts
+func validColor(value interface{}) bool {
+	s, ok := value.(string)
+	if !ok {
+		return false
+	}
+	return s == "none" || s == "default" || colorPattern.MatchString(s)
+}
@@ -0,0 +1,299 @@
+// Package lint checks template files for authoring mistakes that would otherwise only show
+// up when a diagram uses the template: invalid or undefined template expressions, unused
+// parameters, invalid color defaults, dependencies on unknown templates and names that do
+// not match their file. Every problem is reported as a Diagnostic with its file position.
+package lint
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/LederWorks/hippodamus/pkg/catalog"
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// Severity is the severity of a diagnostic
+type Severity string
+
+// Severity constants
+const (
+	SeverityError   Severity = "error"   // The template fails or renders wrong
+	SeverityWarning Severity = "warning" // The template works but is likely not what was meant
+)
+
+// Diagnostic codes
+const (
+	CodeSyntax             = "syntax"              // Invalid YAML or template expression
+	CodeUndefinedVariable  = "undefined-variable"  // Expression uses a variable that is not defined
+	CodeUnusedParameter    = "unused-parameter"    // Parameter is not used by any expression
+	CodeDuplicateParameter = "duplicate-parameter" // Parameter is declared more than once
+	CodeInvalidColor       = "invalid-color"       // Default of a color parameter is not a color
+	CodeUnknownDependency  = "unknown-dependency"  // Dependency type is neither a template nor a trait
+	CodeNameMismatch       = "name-mismatch"       // Template name differs from its file name
+)
+
+// DefaultTraits are the dependency types that are not templates: the generic containers a
+// template can be placed in
+var DefaultTraits = []string{"container", "group"}
+
+// Diagnostic is a problem found in a template file, at a 1-based line and column
+type Diagnostic struct {
+	File     string   `json:"file"`
+	Line     int      `json:"line"`
+	Column   int      `json:"column"`
+	Severity Severity `json:"severity"`
+	Code     string   `json:"code"`
+	Message  string   `json:"message"`
+}
+
+// String returns the diagnostic in the file:line:column: severity: message (code) form
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("%s:%d:%d: %s: %s (%s)", d.File, d.Line, d.Column, d.Severity, d.Message, d.Code)
+}
+
+// Options controls the checks of the linter
+type Options struct {
+	Traits []string // Dependency types accepted besides templates, DefaultTraits when nil
+}
+
+// HasErrors reports whether any diagnostic is an error
+func HasErrors(diagnostics []Diagnostic) bool {
+	for _, diagnostic := range diagnostics {
+		if diagnostic.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// templateFile is a parsed template file
+type templateFile struct {
+	path     string
+	hive     string
+	data     []byte
+	root     *yaml.Node // Mapping node of the document
+	template schema.Template
+}
+
+// linter collects the diagnostics of the templates of a directory
+type linter struct {
+	traits      map[string]bool
+	keys        map[string]bool // Keys of the templates, as the template processor stores them
+	hives       map[string]bool
+	diagnostics []Diagnostic
+}
+
+// Dir lints the templates of a templates directory, in the hive layout the template
+// processor loads. YAML files that are not templates, such as diagram configs kept next to
+// the templates, are skipped. The diagnostics are sorted by file and position; the error
+// is only set when the directory cannot be read.
+func Dir(dir string, options Options) ([]Diagnostic, error) {
+	traits := options.Traits
+	if traits == nil {
+		traits = DefaultTraits
+	}
+
+	l := &linter{
+		traits: make(map[string]bool),
+		keys:   make(map[string]bool),
+		hives:  make(map[string]bool),
+	}
+	for _, trait := range traits {
+		l.traits[trait] = true
+	}
+
+	var files []*templateFile
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !(strings.HasSuffix(path, ".yaml") || strings.HasSuffix(path, ".yml")) {
+			return nil
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if file := l.parse(dir, path, data); file != nil {
+			files = append(files, file)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, file := range files {
+		l.keys[key(file.hive, file.template.Name)] = true
+		if file.hive != "" {
+			l.hives[file.hive] = true
+		}
+	}
+	for _, file := range files {
+		l.check(file)
+	}
+
+	sort.SliceStable(l.diagnostics, func(i, j int) bool {
+		a, b := l.diagnostics[i], l.diagnostics[j]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Column < b.Column
+	})
+	return l.diagnostics, nil
+}
+
+// yamlErrorLine matches the line of a YAML error
+var yamlErrorLine = regexp.MustCompile(`line (\d+)`)
+
+// parse parses a template file, reporting YAML errors, and returns nil for files that are
+// not templates
+func (l *linter) parse(dir, path string, data []byte) *templateFile {
+	var document yaml.Node
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		line := 1
+		if match := yamlErrorLine.FindStringSubmatch(err.Error()); match != nil {
+			line, _ = strconv.Atoi(match[1])
+		}
+		l.report(path, line, 1, SeverityError, CodeSyntax, "invalid YAML: %s", strings.TrimPrefix(err.Error(), "yaml: "))
+		return nil
+	}
+	if len(document.Content) == 0 || document.Content[0].Kind != yaml.MappingNode {
+		return nil
+	}
+
+	file := &templateFile{path: path, hive: hive(dir, path), data: data, root: document.Content[0]}
+	if err := file.root.Decode(&file.template); err != nil || !catalog.IsTemplate(&file.template) {
+		// Diagram configurations and other YAML files
+		return nil
+	}
+	return file
+}
+
+// hive returns the hive of a template file the way the template processor determines it
+func hive(dir, path string) string {
+	rel, err := filepath.Rel(dir, path)
+	if err != nil {
+		return ""
+	}
+	parts := strings.Split(filepath.ToSlash(rel), "/")
+	if len(parts) > 1 {
+		return parts[0]
+	}
+	return ""
+}
+
+// key returns the key the template processor stores a template under
+func key(hive, name string) string {
+	if hive == "" {
+		return name
+	}
+	return hive + "/" + name
+}
+
+// check runs the checks of a template file
+func (l *linter) check(file *templateFile) {
+	l.checkName(file)
+	l.checkParameters(file)
+	l.checkDependencies(file)
+}
+
+// report adds a diagnostic
+func (l *linter) report(path string, line, column int, severity Severity, code, format string, args ...interface{}) {
+	l.diagnostics = append(l.diagnostics, Diagnostic{
+		File:     path,
+		Line:     line,
+		Column:   column,
+		Severity: severity,
+		Code:     code,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// reportAt adds a diagnostic at the position of a node
+func (l *linter) reportAt(file *templateFile, node *yaml.Node, severity Severity, code, format string, args ...interface{}) {
+	line, column := 1, 1
+	if node != nil {
+		line, column = node.Line, node.Column
+	}
+	l.report(file.path, line, column, severity, code, format, args...)
+}
+
+// checkName checks that the name of a template matches its file name
+func (l *linter) checkName(file *templateFile) {
+	base := strings.TrimSuffix(filepath.Base(file.path), filepath.Ext(file.path))
+	if file.template.Name != base {
+		l.reportAt(file, mappingValue(file.root, "name"), SeverityError, CodeNameMismatch,
+			"template name %s does not match the file name %s", file.template.Name, filepath.Base(file.path))
+	}
+}
+
+// checkDependencies checks that dependency types are templates or traits
+func (l *linter) checkDependencies(file *templateFile) {
+	nodes := mappingValue(file.root, "dependencies")
+	for i, dependency := range file.template.Dependencies {
+		if l.traits[dependency.Type] || l.resolves(file.hive, dependency.Type) {
+			continue
+		}
+
+		var node *yaml.Node
+		if nodes != nil && i < len(nodes.Content) {
+			node = mappingValue(nodes.Content[i], "type")
+		}
+		l.reportAt(file, node, SeverityError, CodeUnknownDependency,
+			"dependency %s refers to %s, which is neither a template nor a trait (%s)", dependency.Name, dependency.Type, strings.Join(sortedKeys(l.traits), ", "))
+	}
+}
+
+// resolves reports whether a template reference resolves from a hive, in the current hive,
+// at the root or in any hive
+func (l *linter) resolves(currentHive, ref string) bool {
+	if strings.Contains(ref, "/") {
+		return l.keys[ref]
+	}
+	if currentHive != "" && l.keys[key(currentHive, ref)] {
+		return true
+	}
+	if l.keys[ref] {
+		return true
+	}
+	for hive := range l.hives {
+		if l.keys[key(hive, ref)] {
+			return true
+		}
+	}
+	return false
+}
+
+// mappingValue returns the value of a key of a mapping node, or nil
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
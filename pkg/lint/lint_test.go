@@ -0,0 +1,138 @@
+package lint
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeTemplates writes template files into a temporary directory and returns it
+func writeTemplates(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+// format returns the diagnostics without their directory, one per line
+func format(dir string, diagnostics []Diagnostic) string {
+	var lines []string
+	for _, diagnostic := range diagnostics {
+		diagnostic.File = filepath.ToSlash(strings.TrimPrefix(diagnostic.File, dir+string(filepath.Separator)))
+		lines = append(lines, diagnostic.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+func TestDir(t *testing.T) {
+	dir := writeTemplates(t, map[string]string{
+		"net/zone.yaml": `name: zone
+parameters:
+  - name: cidr
+    type: string
+group:
+  properties:
+    label: "{{.cidr}}"
+`,
+		"net/host.yaml": `name: server
+dependencies:
+  - name: zone
+    type: zone
+    relationship: parent
+  - name: box
+    type: container
+    relationship: parent
+  - name: rack
+    type: rack
+    relationship: parent
+parameters:
+  - name: cores
+    type: number
+  - name: color
+    type: color
+    default: blue
+  - name: cores
+    type: number
+group:
+  properties:
+    label: x {{.cpus}} of {{.name}}
+  style:
+    fillColor: '{{if .color}}{{.color}}{{end}}'
+  children:
+    - id: nic
+      properties:
+        label: |
+          nic
+            {{range .items}}{{.ignored}}{{$.nics}}{{end}}
+`,
+		"net/broken.yaml": `name: broken
+parameters:
+  - name: size
+    type: number
+group:
+  properties:
+    label: "{{.size"
+`,
+		"net/example.yaml": `version: "1.0"
+diagram:
+  pages: []
+`,
+		"invalid.yaml": "name: [\n",
+	})
+
+	diagnostics, err := Dir(dir, Options{})
+	if err != nil {
+		t.Fatalf("Dir() error = %v", err)
+	}
+
+	want := `invalid.yaml:1:1: error: invalid YAML: line 1: did not find expected node content (syntax)
+net/broken.yaml:3:11: warning: parameter size is not used by any expression (unused-parameter)
+net/broken.yaml:7:12: error: invalid expression: unclosed action (syntax)
+net/host.yaml:1:7: error: template name server does not match the file name host.yaml (name-mismatch)
+net/host.yaml:10:11: error: dependency rack refers to rack, which is neither a template nor a trait (container, group) (unknown-dependency)
+net/host.yaml:13:11: warning: parameter cores is not used by any expression (unused-parameter)
+net/host.yaml:17:14: error: default blue of color parameter color is not a color, use #RGB, #RRGGBB or none (invalid-color)
+net/host.yaml:18:11: error: parameter cores is declared more than once (duplicate-parameter)
+net/host.yaml:18:11: warning: parameter cores is not used by any expression (unused-parameter)
+net/host.yaml:22:16: error: variable cpus is neither a parameter nor a built-in variable (undefined-variable)
+net/host.yaml:30:21: error: variable items is neither a parameter nor a built-in variable (undefined-variable)
+net/host.yaml:30:44: error: variable nics is neither a parameter nor a built-in variable (undefined-variable)`
+	if got := format(dir, diagnostics); got != want {
+		t.Errorf("Dir() =\n%s\nwant\n%s", got, want)
+	}
+	if !HasErrors(diagnostics) {
+		t.Error("Expected HasErrors() to be true")
+	}
+}
+
+func TestDir_Traits(t *testing.T) {
+	dir := writeTemplates(t, map[string]string{
+		"pod.yaml": `name: pod
+dependencies:
+  - name: cluster
+    type: cluster-infrastructure
+    relationship: parent
+group:
+  properties:
+    label: "{{.label}}"
+`,
+	})
+
+	diagnostics, err := Dir(dir, Options{Traits: append(DefaultTraits, "cluster-infrastructure")})
+	if err != nil {
+		t.Fatalf("Dir() error = %v", err)
+	}
+	if len(diagnostics) != 0 {
+		t.Errorf("Dir() = %v, want no diagnostics", diagnostics)
+	}
+}
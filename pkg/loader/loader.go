@@ -0,0 +1,328 @@
+package loader
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// FileError associates an error with the configuration file it originated from
+type FileError struct {
+	File string
+	Err  error
+}
+
+func (e *FileError) Error() string {
+	return fmt.Sprintf("%s: %v", e.File, e.Err)
+}
+
+// Unwrap returns the underlying error
+func (e *FileError) Unwrap() error {
+	return e.Err
+}
+
+// loader resolves include directives while loading a diagram configuration
+type loader struct {
+	stack []string // Files currently being loaded, used for cycle detection
+}
+
+// LoadDiagramConfig loads a diagram configuration and resolves all of its includes.
+// Top-level includes contribute pages, model elements and provider, template and hive declarations,
+// while element includes are replaced by the elements of the included fragment.
+func LoadDiagramConfig(filename string) (*schema.DiagramConfig, error) {
+	l := &loader{}
+	return l.loadConfig(filename)
+}
+
+// loadConfig loads a configuration file and merges everything it includes
+func (l *loader) loadConfig(filename string) (*schema.DiagramConfig, error) {
+	path, err := l.enter(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer l.leave()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var config schema.DiagramConfig
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, &FileError{File: path, Err: err}
+	}
+
+	dir := filepath.Dir(path)
+
+	// Plugin paths of custom providers are relative to the declaring file
+	for i := range config.Providers {
+		if config.Providers[i].Path != "" {
+			config.Providers[i].Path = resolvePath(dir, config.Providers[i].Path)
+		}
+	}
+
+	// Template and hive paths of the root file are relative to the templates directory,
+	// those of included files to the included file
+	if len(l.stack) > 1 {
+		for i := range config.Templates {
+			if config.Templates[i].Path != "" {
+				config.Templates[i].Path = resolvePath(dir, config.Templates[i].Path)
+			}
+		}
+		for i := range config.TemplateHives {
+			if config.TemplateHives[i].Path != "" {
+				config.TemplateHives[i].Path = resolvePath(dir, config.TemplateHives[i].Path)
+			}
+		}
+	}
+
+	// Resolve element includes in model elements
+	model, err := l.resolveElements(config.Model, dir, path)
+	if err != nil {
+		return nil, err
+	}
+	config.Model = model
+
+	// Resolve element includes and record where each page came from
+	for i := range config.Diagram.Pages {
+		page := &config.Diagram.Pages[i]
+		page.SourceFile = path
+
+		for j := range page.Layers {
+			elements, err := l.resolveElements(page.Layers[j].Elements, dir, path)
+			if err != nil {
+				return nil, err
+			}
+			page.Layers[j].Elements = elements
+		}
+
+		elements, err := l.resolveElements(page.Elements, dir, path)
+		if err != nil {
+			return nil, err
+		}
+		page.Elements = elements
+	}
+
+	// Merge included configuration files
+	for _, include := range config.Include {
+		included, err := l.loadConfig(resolvePath(dir, include))
+		if err != nil {
+			return nil, &FileError{File: path, Err: fmt.Errorf("failed to include %s: %w", include, err)}
+		}
+
+		if err := mergeConfig(&config, included); err != nil {
+			return nil, &FileError{File: path, Err: fmt.Errorf("failed to merge %s: %w", include, err)}
+		}
+	}
+	config.Include = nil
+
+	return &config, nil
+}
+
+// loadFragment loads an element fragment file and resolves its nested includes
+func (l *loader) loadFragment(filename string) ([]schema.Element, error) {
+	path, err := l.enter(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer l.leave()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var fragment schema.ElementFragment
+	if err := yaml.Unmarshal(data, &fragment); err != nil {
+		return nil, &FileError{File: path, Err: err}
+	}
+
+	return l.resolveElements(fragment.Elements, filepath.Dir(path), path)
+}
+
+// resolveElements replaces include elements with the elements of their fragments
+func (l *loader) resolveElements(elements []schema.Element, dir, file string) ([]schema.Element, error) {
+	if len(elements) == 0 {
+		return elements, nil
+	}
+
+	resolved := make([]schema.Element, 0, len(elements))
+	for _, element := range elements {
+		if element.Include == "" {
+			element.SourceFile = file
+
+			children, err := l.resolveElements(element.Children, dir, file)
+			if err != nil {
+				return nil, err
+			}
+			element.Children = children
+
+			resolved = append(resolved, element)
+			continue
+		}
+
+		// An include element only places its fragment, it is not an element of its own
+		if element.ID != "" || len(element.Tags) > 0 || len(element.Children) > 0 {
+			return nil, &FileError{File: file, Err: fmt.Errorf("include element for %s cannot declare an id, tags or children, declare them in the included file", element.Include)}
+		}
+
+		included, err := l.loadFragment(resolvePath(dir, element.Include))
+		if err != nil {
+			return nil, &FileError{File: file, Err: fmt.Errorf("failed to include %s: %w", element.Include, err)}
+		}
+
+		// Place the included subtree relative to the include element's position
+		for _, child := range included {
+			if err := offsetElement(&child, element.Properties.X, element.Properties.Y); err != nil {
+				return nil, &FileError{File: child.SourceFile, Err: fmt.Errorf("failed to place %s: %w", element.Include, err)}
+			}
+			resolved = append(resolved, child)
+		}
+	}
+
+	return resolved, nil
+}
+
+// offsetElement moves an element by the given offset. Provider resources that take their
+// position from x and y parameters have those parameters shifted instead.
+func offsetElement(element *schema.Element, dx, dy float64) error {
+	shifted, err := offsetParameter(element, "x", dx)
+	if err != nil {
+		return err
+	}
+	if !shifted {
+		element.Properties.X += dx
+	}
+
+	shifted, err = offsetParameter(element, "y", dy)
+	if err != nil {
+		return err
+	}
+	if !shifted {
+		element.Properties.Y += dy
+	}
+	return nil
+}
+
+// offsetParameter shifts a position parameter of a provider resource, and reports whether
+// the element has the parameter. Parameters the element does not declare are not added,
+// as the schema of the resource may not allow them.
+func offsetParameter(element *schema.Element, name string, delta float64) (bool, error) {
+	if element.Resource == "" {
+		return false, nil
+	}
+	value, exists := element.Parameters[name]
+	if !exists {
+		return false, nil
+	}
+
+	var position float64
+	switch v := value.(type) {
+	case float64:
+		position = v
+	case int:
+		position = float64(v)
+	default:
+		id := element.ID
+		if id == "" {
+			id = element.Name
+		}
+		return false, fmt.Errorf("parameter %s of element %s must be a number, got %v", name, id, value)
+	}
+
+	params := make(map[string]interface{}, len(element.Parameters))
+	for key, value := range element.Parameters {
+		params[key] = value
+	}
+	params[name] = position + delta
+	element.Parameters = params
+	return true, nil
+}
+
+// enter pushes a file onto the include stack, rejecting include cycles
+func (l *loader) enter(filename string) (string, error) {
+	path, err := filepath.Abs(filename)
+	if err != nil {
+		return "", err
+	}
+
+	for i, active := range l.stack {
+		if active == path {
+			cycle := append(append([]string{}, l.stack[i:]...), path)
+			return "", fmt.Errorf("include cycle detected: %s", strings.Join(cycle, " -> "))
+		}
+	}
+
+	l.stack = append(l.stack, path)
+	return path, nil
+}
+
+// leave pops the most recently entered file from the include stack
+func (l *loader) leave() {
+	l.stack = l.stack[:len(l.stack)-1]
+}
+
+// mergeConfig merges pages, model elements and shared declarations of an included configuration
+func mergeConfig(target, included *schema.DiagramConfig) error {
+	var err error
+
+	target.Providers, err = mergeDeclarations("provider", target.Providers, included.Providers, func(ref schema.ProviderRef) string { return ref.Name })
+	if err != nil {
+		return err
+	}
+
+	target.Templates, err = mergeDeclarations("template", target.Templates, included.Templates, func(ref schema.TemplateRef) string { return ref.Name })
+	if err != nil {
+		return err
+	}
+
+	target.TemplateHives, err = mergeDeclarations("template hive", target.TemplateHives, included.TemplateHives, func(ref schema.TemplateHiveRef) string { return ref.Name })
+	if err != nil {
+		return err
+	}
+
+	target.Model, err = mergeDeclarations("model element", target.Model, included.Model, func(element schema.Element) string { return element.ID })
+	if err != nil {
+		return err
+	}
+
+	target.Diagram.Pages = append(target.Diagram.Pages, included.Diagram.Pages...)
+	return nil
+}
+
+// mergeDeclarations appends declarations that are not yet present, rejecting conflicting redeclarations
+func mergeDeclarations[T any](kind string, target, included []T, name func(T) string) ([]T, error) {
+	for _, declaration := range included {
+		duplicate := false
+		for _, existing := range target {
+			if name(existing) != name(declaration) {
+				continue
+			}
+			if !reflect.DeepEqual(existing, declaration) {
+				return nil, fmt.Errorf("conflicting declarations for %s %s", kind, name(declaration))
+			}
+			duplicate = true
+			break
+		}
+
+		if !duplicate {
+			target = append(target, declaration)
+		}
+	}
+
+	return target, nil
+}
+
+// resolvePath resolves an include or plugin path relative to the including file's directory
+func resolvePath(dir, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(dir, path)
+}
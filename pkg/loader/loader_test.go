@@ -0,0 +1,417 @@
+package loader
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// writeFile writes a test configuration file below dir
+func writeFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(dir, name)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("failed to create directory: %v", err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write %s: %v", name, err)
+	}
+	return path
+}
+
+func TestLoadDiagramConfig_Includes(t *testing.T) {
+	dir := t.TempDir()
+
+	writeFile(t, dir, "shared/providers.yaml", `
+providers:
+  - name: "core"
+    type: "builtin"
+`)
+	writeFile(t, dir, "pages/network.yaml", `
+include:
+  - ../shared/providers.yaml
+diagram:
+  pages:
+    - id: "network"
+      name: "Network"
+`)
+	writeFile(t, dir, "fragments/web-tier.yaml", `
+elements:
+  - id: "web"
+    type: "shape"
+    properties:
+      x: 10
+      y: 20
+`)
+	root := writeFile(t, dir, "diagram.yaml", `
+version: "1.0"
+include:
+  - shared/providers.yaml
+  - pages/network.yaml
+diagram:
+  pages:
+    - id: "main"
+      name: "Main"
+      elements:
+        - include: fragments/web-tier.yaml
+          properties:
+            x: 100
+            y: 200
+`)
+
+	config, err := LoadDiagramConfig(root)
+	if err != nil {
+		t.Fatalf("LoadDiagramConfig() error = %v", err)
+	}
+
+	if len(config.Providers) != 1 {
+		t.Errorf("Expected duplicate provider declarations to be merged, got %d providers", len(config.Providers))
+	}
+
+	if len(config.Diagram.Pages) != 2 {
+		t.Fatalf("Expected 2 pages, got %d", len(config.Diagram.Pages))
+	}
+
+	if config.Diagram.Pages[1].ID != "network" {
+		t.Errorf("Expected included page 'network', got '%s'", config.Diagram.Pages[1].ID)
+	}
+
+	if !strings.HasSuffix(config.Diagram.Pages[1].SourceFile, filepath.Join("pages", "network.yaml")) {
+		t.Errorf("Expected included page source to be pages/network.yaml, got '%s'", config.Diagram.Pages[1].SourceFile)
+	}
+
+	elements := config.Diagram.Pages[0].Elements
+	if len(elements) != 1 || elements[0].ID != "web" {
+		t.Fatalf("Expected include element to be replaced by 'web', got %+v", elements)
+	}
+
+	if elements[0].Properties.X != 110 || elements[0].Properties.Y != 220 {
+		t.Errorf("Expected included element at (110, 220), got (%v, %v)", elements[0].Properties.X, elements[0].Properties.Y)
+	}
+
+	if !strings.HasSuffix(elements[0].SourceFile, filepath.Join("fragments", "web-tier.yaml")) {
+		t.Errorf("Expected included element source to be fragments/web-tier.yaml, got '%s'", elements[0].SourceFile)
+	}
+}
+
+func TestLoadDiagramConfig_IncludeResourcePositions(t *testing.T) {
+	dir := t.TempDir()
+
+	writeFile(t, dir, "fragments/network.yaml", `
+elements:
+  - id: "vpc"
+    resource: "aws-vpc"
+    parameters:
+      cidr: "10.0.0.0/16"
+      x: 10
+  - id: "peering"
+    resource: "aws-vpc-peering"
+    parameters:
+      source: "vpc"
+      target: "other"
+`)
+	root := writeFile(t, dir, "diagram.yaml", `
+version: "1.0"
+diagram:
+  pages:
+    - id: "main"
+      name: "Main"
+      elements:
+        - include: fragments/network.yaml
+          properties:
+            x: 100
+            y: 200
+`)
+
+	config, err := LoadDiagramConfig(root)
+	if err != nil {
+		t.Fatalf("LoadDiagramConfig() error = %v", err)
+	}
+
+	tests := []struct {
+		id         string
+		parameters map[string]interface{}
+		x, y       float64
+	}{
+		// The x parameter is shifted, the missing y parameter is not added
+		{id: "vpc", parameters: map[string]interface{}{"cidr": "10.0.0.0/16", "x": 110.0}, y: 200},
+		// Connectors get no position parameters
+		{id: "peering", parameters: map[string]interface{}{"source": "vpc", "target": "other"}, x: 100, y: 200},
+	}
+
+	elements := config.Diagram.Pages[0].Elements
+	if len(elements) != len(tests) {
+		t.Fatalf("Expected %d included elements, got %d", len(tests), len(elements))
+	}
+	for i, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			element := elements[i]
+			if !reflect.DeepEqual(element.Parameters, tt.parameters) {
+				t.Errorf("Expected parameters %v, got %v", tt.parameters, element.Parameters)
+			}
+			if element.Properties.X != tt.x || element.Properties.Y != tt.y {
+				t.Errorf("Expected properties at (%v, %v), got (%v, %v)", tt.x, tt.y, element.Properties.X, element.Properties.Y)
+			}
+		})
+	}
+}
+
+func TestLoadDiagramConfig_IncludeResourcePositionErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+	}{
+		{name: "string", value: `"left"`},
+		{name: "numeric string", value: `"10"`},
+		{name: "list", value: `[10]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+
+			fragment := writeFile(t, dir, "fragments/network.yaml", `
+elements:
+  - id: "vpc"
+    resource: "aws-vpc"
+    parameters:
+      x: `+tt.value+`
+`)
+			root := writeFile(t, dir, "diagram.yaml", `
+version: "1.0"
+diagram:
+  pages:
+    - id: "main"
+      name: "Main"
+      elements:
+        - include: fragments/network.yaml
+          properties:
+            x: 100
+`)
+
+			_, err := LoadDiagramConfig(root)
+			if err == nil {
+				t.Fatalf("Expected x parameter %s to cause an error", tt.value)
+			}
+			if !strings.Contains(err.Error(), fragment) || !strings.Contains(err.Error(), "parameter x of element vpc must be a number") {
+				t.Errorf("Expected error about the x parameter in %s, got: %v", fragment, err)
+			}
+		})
+	}
+}
+
+func TestLoadDiagramConfig_IncludeCycle(t *testing.T) {
+	dir := t.TempDir()
+
+	writeFile(t, dir, "a.yaml", `
+elements:
+  - include: b.yaml
+`)
+	writeFile(t, dir, "b.yaml", `
+elements:
+  - include: a.yaml
+`)
+	root := writeFile(t, dir, "diagram.yaml", `
+version: "1.0"
+diagram:
+  pages:
+    - id: "main"
+      name: "Main"
+      elements:
+        - include: a.yaml
+`)
+
+	_, err := LoadDiagramConfig(root)
+	if err == nil {
+		t.Fatal("Expected include cycle to cause an error")
+	}
+
+	if !strings.Contains(err.Error(), "include cycle detected") {
+		t.Errorf("Expected include cycle error, got: %v", err)
+	}
+}
+
+func TestLoadDiagramConfig_ConflictingDeclarations(t *testing.T) {
+	dir := t.TempDir()
+
+	writeFile(t, dir, "shared.yaml", `
+providers:
+  - name: "core"
+    type: "custom"
+`)
+	root := writeFile(t, dir, "diagram.yaml", `
+version: "1.0"
+include:
+  - shared.yaml
+providers:
+  - name: "core"
+    type: "builtin"
+diagram:
+  pages:
+    - id: "main"
+      name: "Main"
+`)
+
+	_, err := LoadDiagramConfig(root)
+	if err == nil {
+		t.Fatal("Expected conflicting provider declarations to cause an error")
+	}
+
+	if !strings.Contains(err.Error(), "conflicting declarations for provider core") {
+		t.Errorf("Expected conflict error, got: %v", err)
+	}
+}
+
+func TestLoadDiagramConfig_DiagnosticsPointToFile(t *testing.T) {
+	dir := t.TempDir()
+
+	broken := writeFile(t, dir, "broken.yaml", "elements: [\n")
+	root := writeFile(t, dir, "diagram.yaml", `
+version: "1.0"
+diagram:
+  pages:
+    - id: "main"
+      name: "Main"
+      elements:
+        - include: broken.yaml
+`)
+
+	_, err := LoadDiagramConfig(root)
+	if err == nil {
+		t.Fatal("Expected invalid fragment to cause an error")
+	}
+
+	var fileErr *FileError
+	if !errors.As(err, &fileErr) {
+		t.Fatalf("Expected FileError, got %T", err)
+	}
+
+	if !strings.Contains(err.Error(), broken) {
+		t.Errorf("Expected error to mention %s, got: %v", broken, err)
+	}
+}
+
+func TestLoadDiagramConfig_PluginPaths(t *testing.T) {
+	dir := t.TempDir()
+
+	writeFile(t, dir, "shared/providers.yaml", `
+providers:
+  - name: "badge"
+    type: "custom"
+    path: "plugins/badge-plugin"
+`)
+	root := writeFile(t, dir, "diagram.yaml", `
+version: "1.0"
+include:
+  - shared/providers.yaml
+providers:
+  - name: "tools"
+    type: "custom"
+    path: "/opt/hippodamus/tools-plugin"
+diagram:
+  pages: []
+`)
+
+	config, err := LoadDiagramConfig(root)
+	if err != nil {
+		t.Fatalf("LoadDiagramConfig() error = %v", err)
+	}
+
+	want := map[string]string{
+		"tools": "/opt/hippodamus/tools-plugin",
+		"badge": filepath.Join(dir, "shared", "plugins", "badge-plugin"),
+	}
+	for _, provider := range config.Providers {
+		if provider.Path != want[provider.Name] {
+			t.Errorf("Expected path %s for provider %s, got %s", want[provider.Name], provider.Name, provider.Path)
+		}
+	}
+}
+
+func TestLoadDiagramConfig_IncludeElementDeclarations(t *testing.T) {
+	tests := []struct {
+		name    string
+		element string
+	}{
+		{"id", `id: "web-tier"`},
+		{"tags", `tags: ["web"]`},
+		{"children", `children: [{id: "extra", type: "shape"}]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+
+			writeFile(t, dir, "fragments/web-tier.yaml", `
+elements:
+  - id: "web"
+    type: "shape"
+`)
+			root := writeFile(t, dir, "diagram.yaml", `
+version: "1.0"
+diagram:
+  pages:
+    - id: "main"
+      name: "Main"
+      elements:
+        - include: fragments/web-tier.yaml
+          `+tt.element+`
+`)
+
+			_, err := LoadDiagramConfig(root)
+			if err == nil {
+				t.Fatalf("Expected include element with %s to cause an error", tt.name)
+			}
+			if !strings.Contains(err.Error(), "cannot declare an id, tags or children") {
+				t.Errorf("Expected error about include element declarations, got: %v", err)
+			}
+		})
+	}
+}
+
+func TestLoadDiagramConfig_TemplatePaths(t *testing.T) {
+	dir := t.TempDir()
+
+	writeFile(t, dir, "shared/templates.yaml", `
+templates:
+  - name: "badge"
+    path: "templates/badge.yaml"
+templateHives:
+  - name: "shared"
+    path: "hive"
+`)
+	root := writeFile(t, dir, "diagram.yaml", `
+version: "1.0"
+include:
+  - shared/templates.yaml
+templateHives:
+  - name: "builtin"
+    path: "generic"
+diagram:
+  pages: []
+`)
+
+	config, err := LoadDiagramConfig(root)
+	if err != nil {
+		t.Fatalf("LoadDiagramConfig() error = %v", err)
+	}
+
+	// Paths of the root file stay relative to the templates directory
+	want := map[string]string{
+		"builtin": "generic",
+		"shared":  filepath.Join(dir, "shared", "hive"),
+	}
+	for _, hive := range config.TemplateHives {
+		if hive.Path != want[hive.Name] {
+			t.Errorf("Expected path %s for hive %s, got %s", want[hive.Name], hive.Name, hive.Path)
+		}
+	}
+
+	if len(config.Templates) != 1 || config.Templates[0].Path != filepath.Join(dir, "shared", "templates", "badge.yaml") {
+		t.Errorf("Expected template path relative to shared/templates.yaml, got %+v", config.Templates)
+	}
+}
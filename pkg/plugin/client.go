@@ -0,0 +1,322 @@
+package plugin
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"sync"
+	"time"
+
+	"github.com/LederWorks/hippodamus/pkg/providers"
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// Default timeouts of a plugin
+const (
+	DefaultStartTimeout    = 10 * time.Second
+	DefaultCallTimeout     = 10 * time.Second
+	DefaultShutdownTimeout = 2 * time.Second
+)
+
+// maxMessageSize is the largest response line accepted from a plugin
+const maxMessageSize = 16 * 1024 * 1024
+
+// Options configure how plugins are launched. Zero values use the defaults.
+type Options struct {
+	HostVersion     string        // Hippodamus version sent in the handshake
+	StartTimeout    time.Duration // Time allowed for the plugin to start and complete the handshake
+	CallTimeout     time.Duration // Time allowed for each call
+	ShutdownTimeout time.Duration // Time allowed for the plugin to exit before it is killed
+	Stderr          io.Writer     // Destination of the plugin's standard error, os.Stderr when nil
+}
+
+func (o Options) withDefaults() Options {
+	if o.StartTimeout <= 0 {
+		o.StartTimeout = DefaultStartTimeout
+	}
+	if o.CallTimeout <= 0 {
+		o.CallTimeout = DefaultCallTimeout
+	}
+	if o.ShutdownTimeout <= 0 {
+		o.ShutdownTimeout = DefaultShutdownTimeout
+	}
+	if o.Stderr == nil {
+		o.Stderr = os.Stderr
+	}
+	return o
+}
+
+// Client runs a provider plugin and implements providers.Provider by calling it.
+// Calls are serialized, and a plugin that does not answer in time is killed.
+type Client struct {
+	path    string
+	options Options
+	cmd     *exec.Cmd
+	stdin   io.WriteCloser
+
+	responses chan *Response
+	exited    chan struct{} // Closed once the plugin process has exited
+	readErr   error         // Why the plugin stopped answering, set before responses is closed
+
+	mutex  sync.Mutex
+	nextID int64
+	closed bool
+
+	name      string
+	version   string
+	resources []providers.ResourceDefinition
+}
+
+// Launch starts the plugin executable at path and performs the handshake
+func Launch(path string, options Options) (*Client, error) {
+	options = options.withDefaults()
+
+	cmd := exec.Command(path)
+	cmd.Stderr = options.Stderr
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to start plugin %s: %w", path, err)
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to start plugin %s: %w", path, err)
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start plugin %s: %w", path, err)
+	}
+
+	c := &Client{
+		path:      path,
+		options:   options,
+		cmd:       cmd,
+		stdin:     stdin,
+		responses: make(chan *Response, 1),
+		exited:    make(chan struct{}),
+	}
+	go c.read(stdout)
+
+	if err := c.handshake(); err != nil {
+		c.kill()
+		return nil, err
+	}
+	return c, nil
+}
+
+// read delivers the plugin's responses until its output is closed, then waits for the process
+func (c *Client) read(stdout io.Reader) {
+	scanner := bufio.NewScanner(stdout)
+	scanner.Buffer(make([]byte, 64*1024), maxMessageSize)
+
+	for scanner.Scan() {
+		var response Response
+		if err := json.Unmarshal(scanner.Bytes(), &response); err != nil {
+			c.readErr = fmt.Errorf("invalid response: %w", err)
+			break
+		}
+		c.responses <- &response
+	}
+	if c.readErr == nil {
+		c.readErr = scanner.Err()
+	}
+	close(c.responses)
+
+	// Kill a plugin that writes invalid output instead of exiting, so Wait returns
+	_ = c.cmd.Process.Kill()
+	_ = c.cmd.Wait()
+	close(c.exited)
+}
+
+// handshake exchanges protocol versions and loads the provider's resources
+func (c *Client) handshake() error {
+	var result HandshakeResult
+	params := HandshakeParams{ProtocolVersion: ProtocolVersion, HostVersion: c.options.HostVersion}
+	if err := c.call(MethodHandshake, params, &result, c.options.StartTimeout); err != nil {
+		return fmt.Errorf("handshake with plugin %s failed: %w", c.path, err)
+	}
+
+	if result.ProtocolVersion != ProtocolVersion {
+		return fmt.Errorf("plugin %s speaks protocol version %d, expected %d", c.path, result.ProtocolVersion, ProtocolVersion)
+	}
+	if result.Name == "" {
+		return fmt.Errorf("plugin %s did not report a provider name", c.path)
+	}
+	c.name = result.Name
+	c.version = result.Version
+
+	if err := c.call(MethodResources, nil, &c.resources, c.options.CallTimeout); err != nil {
+		return fmt.Errorf("failed to list resources of plugin %s: %w", c.path, err)
+	}
+	return nil
+}
+
+// call sends a request and decodes the result, killing the plugin when it does not answer in time
+func (c *Client) call(method string, params, result interface{}, timeout time.Duration) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.closed {
+		return fmt.Errorf("plugin %s is not running", c.path)
+	}
+
+	c.nextID++
+	request := Request{JSONRPC: JSONRPCVersion, ID: c.nextID, Method: method}
+	if params != nil {
+		data, err := json.Marshal(params)
+		if err != nil {
+			return fmt.Errorf("failed to encode %s request: %w", method, err)
+		}
+		request.Params = data
+	}
+
+	data, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s request: %w", method, err)
+	}
+	if _, err := c.stdin.Write(append(data, '\n')); err != nil {
+		return c.failed(method, err)
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		select {
+		case response, ok := <-c.responses:
+			if !ok {
+				return c.failed(method, c.readErr)
+			}
+			if response.ID != request.ID {
+				// Responses to other requests cannot occur while calls are serialized
+				continue
+			}
+			if response.Error != nil {
+				return DecodeError(response.Error)
+			}
+			if result != nil && len(response.Result) > 0 {
+				if err := json.Unmarshal(response.Result, result); err != nil {
+					return fmt.Errorf("invalid %s result from plugin %s: %w", method, c.path, err)
+				}
+			}
+			return nil
+
+		case <-timer.C:
+			c.closed = true
+			c.kill()
+			return fmt.Errorf("plugin %s did not answer %s within %s", c.path, method, timeout)
+		}
+	}
+}
+
+// failed marks the plugin as stopped and describes why a call failed
+func (c *Client) failed(method string, err error) error {
+	c.closed = true
+	c.kill()
+	if err == nil {
+		err = fmt.Errorf("plugin exited")
+	}
+	return fmt.Errorf("plugin %s failed during %s: %w", c.path, method, err)
+}
+
+// kill terminates the plugin process and waits for it to exit
+func (c *Client) kill() {
+	_ = c.stdin.Close()
+	_ = c.cmd.Process.Kill()
+	c.waitExit(0)
+}
+
+// waitExit waits for the plugin process to exit, discarding unread responses so the reader
+// is not blocked. A zero timeout waits indefinitely. It reports whether the process exited.
+func (c *Client) waitExit(timeout time.Duration) bool {
+	var deadline <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		deadline = timer.C
+	}
+
+	responses := c.responses
+	for {
+		select {
+		case <-c.exited:
+			return true
+		case _, ok := <-responses:
+			if !ok {
+				responses = nil
+			}
+		case <-deadline:
+			return false
+		}
+	}
+}
+
+// Close asks the plugin to shut down and kills it if it does not exit in time
+func (c *Client) Close() error {
+	c.mutex.Lock()
+	closed := c.closed
+	c.mutex.Unlock()
+	if closed {
+		return nil
+	}
+
+	err := c.call(MethodShutdown, nil, nil, c.options.ShutdownTimeout)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.closed {
+		// The shutdown call timed out and the plugin was killed
+		return err
+	}
+	c.closed = true
+
+	_ = c.stdin.Close()
+	if !c.waitExit(c.options.ShutdownTimeout) {
+		c.kill()
+	}
+	return err
+}
+
+// Name returns the provider name reported by the plugin
+func (c *Client) Name() string {
+	return c.name
+}
+
+// Version returns the provider version reported by the plugin
+func (c *Client) Version() string {
+	return c.version
+}
+
+// Resources returns the resources the plugin listed during the handshake
+func (c *Client) Resources() []providers.ResourceDefinition {
+	return c.resources
+}
+
+// Validate validates resource parameters in the plugin
+func (c *Client) Validate(resourceType string, params map[string]interface{}) error {
+	return c.call(MethodValidate, ResourceParams{ResourceType: resourceType, Params: params}, nil, c.options.CallTimeout)
+}
+
+// GenerateTemplate generates a resource element in the plugin
+func (c *Client) GenerateTemplate(resourceType string, params map[string]interface{}) (*schema.Element, error) {
+	var element schema.Element
+	if err := c.call(MethodGenerateTemplate, ResourceParams{ResourceType: resourceType, Params: params}, &element, c.options.CallTimeout); err != nil {
+		return nil, err
+	}
+	return &element, nil
+}
+
+// GetSchema returns the JSON schema of a resource type from the plugin
+func (c *Client) GetSchema(resourceType string) (map[string]interface{}, error) {
+	var resourceSchema map[string]interface{}
+	if err := c.call(MethodGetSchema, ResourceParams{ResourceType: resourceType}, &resourceSchema, c.options.CallTimeout); err != nil {
+		return nil, err
+	}
+	return resourceSchema, nil
+}
+
+// Configure passes the settings of the provider declaration to the plugin
+func (c *Client) Configure(config providers.ProviderConfig) error {
+	return c.call(MethodConfigure, config, nil, c.options.CallTimeout)
+}
@@ -0,0 +1,228 @@
+package plugin_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/LederWorks/hippodamus/pkg/plugin"
+	"github.com/LederWorks/hippodamus/pkg/plugin/sdk"
+	"github.com/LederWorks/hippodamus/pkg/providers"
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// pluginModeEnv makes the test binary run as a plugin instead of running the tests
+const pluginModeEnv = "HIPPODAMUS_TEST_PLUGIN_MODE"
+
+func TestMain(m *testing.M) {
+	switch os.Getenv(pluginModeEnv) {
+	case "":
+		os.Exit(m.Run())
+	case "serve":
+		if err := sdk.Serve(&testProvider{}); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	case "protocol-mismatch":
+		// Answer the handshake with an unsupported protocol version
+		var request plugin.Request
+		_ = json.NewDecoder(os.Stdin).Decode(&request)
+		result, _ := json.Marshal(plugin.HandshakeResult{ProtocolVersion: plugin.ProtocolVersion + 1, Name: "test"})
+		_ = json.NewEncoder(os.Stdout).Encode(plugin.Response{JSONRPC: plugin.JSONRPCVersion, ID: request.ID, Result: result})
+		time.Sleep(time.Minute)
+	case "exit":
+		os.Exit(3)
+	}
+	os.Exit(0)
+}
+
+// testProvider is served by the test binary in plugin mode
+type testProvider struct{}
+
+func (p *testProvider) Name() string    { return "test" }
+func (p *testProvider) Version() string { return "1.2.3" }
+
+// boxSchema is the parameter schema of the box resource of testProvider
+var boxSchema = map[string]interface{}{
+	"type": "object",
+	"properties": map[string]interface{}{
+		"label": map[string]interface{}{"type": "string"},
+		"width": map[string]interface{}{"type": "number", "minimum": 20},
+		"delay": map[string]interface{}{"type": "string"},
+	},
+}
+
+func (p *testProvider) Resources() []providers.ResourceDefinition {
+	return []providers.ResourceDefinition{{
+		Type:     "box",
+		Name:     "Box",
+		Category: "shapes",
+		Schema:   boxSchema,
+		Examples: []providers.ResourceExample{{Name: "Box", Config: map[string]interface{}{"label": "Hello"}}},
+	}}
+}
+
+func (p *testProvider) Validate(resourceType string, params map[string]interface{}) error {
+	if resourceType != "box" {
+		return &providers.ProviderError{Provider: "test", Resource: resourceType, Message: "unsupported resource type: " + resourceType, Code: "UNSUPPORTED_RESOURCE"}
+	}
+	if width, ok := params["width"].(float64); ok && width < 20 {
+		return &providers.ValidationError{Field: "width", Message: "width must be at least 20", Code: "OUT_OF_RANGE"}
+	}
+	return nil
+}
+
+func (p *testProvider) GenerateTemplate(resourceType string, params map[string]interface{}) (*schema.Element, error) {
+	if err := p.Validate(resourceType, params); err != nil {
+		return nil, err
+	}
+	if delay, ok := params["delay"].(string); ok {
+		duration, _ := time.ParseDuration(delay)
+		time.Sleep(duration)
+	}
+	label, _ := params["label"].(string)
+	return &schema.Element{
+		Type:       schema.ElementTypeShape,
+		Properties: schema.ElementProperties{Label: label, Width: 120, Height: 60},
+	}, nil
+}
+
+func (p *testProvider) GetSchema(resourceType string) (map[string]interface{}, error) {
+	if resourceType != "box" {
+		return nil, &providers.ProviderError{Provider: "test", Resource: resourceType, Message: "schema not found for resource type: " + resourceType, Code: "SCHEMA_NOT_FOUND"}
+	}
+	return boxSchema, nil
+}
+
+// launch starts the test binary as a plugin in the given mode
+func launch(t *testing.T, mode string, options plugin.Options) (*plugin.Client, error) {
+	t.Helper()
+	t.Setenv(pluginModeEnv, mode)
+	options.Stderr = &bytes.Buffer{}
+	return plugin.Launch(os.Args[0], options)
+}
+
+func TestClient_Provider(t *testing.T) {
+	client, err := launch(t, "serve", plugin.Options{HostVersion: "test"})
+	if err != nil {
+		t.Fatalf("Launch() error = %v", err)
+	}
+	defer client.Close()
+
+	var _ providers.Provider = client
+
+	if client.Name() != "test" || client.Version() != "1.2.3" {
+		t.Errorf("Expected provider test 1.2.3, got %s %s", client.Name(), client.Version())
+	}
+	if resources := client.Resources(); len(resources) != 1 || resources[0].Type != "box" {
+		t.Errorf("Expected the box resource, got %+v", resources)
+	}
+
+	element, err := client.GenerateTemplate("box", map[string]interface{}{"label": "Hello"})
+	if err != nil {
+		t.Fatalf("GenerateTemplate() error = %v", err)
+	}
+	if element.Type != schema.ElementTypeShape || element.Properties.Label != "Hello" || element.Properties.Width != 120 {
+		t.Errorf("Expected labelled shape, got %+v", element)
+	}
+
+	resourceSchema, err := client.GetSchema("box")
+	if err != nil || resourceSchema["type"] != "object" {
+		t.Errorf("Expected object schema, got %v (%v)", resourceSchema, err)
+	}
+
+	if err := client.Close(); err != nil {
+		t.Errorf("Close() error = %v", err)
+	}
+	if err := client.Validate("box", nil); err == nil {
+		t.Error("Expected error after the plugin was closed")
+	}
+}
+
+func TestClient_Conformance(t *testing.T) {
+	client, err := launch(t, "serve", plugin.Options{})
+	if err != nil {
+		t.Fatalf("Launch() error = %v", err)
+	}
+	defer client.Close()
+
+	providertest.Run(t, client)
+}
+
+func TestClient_StructuredErrors(t *testing.T) {
+	client, err := launch(t, "serve", plugin.Options{})
+	if err != nil {
+		t.Fatalf("Launch() error = %v", err)
+	}
+	defer client.Close()
+
+	err = client.Validate("box", map[string]interface{}{"width": 5})
+	validationErr, ok := err.(*providers.ValidationError)
+	if !ok {
+		t.Fatalf("Expected ValidationError, got %T %v", err, err)
+	}
+	if validationErr.Field != "width" || validationErr.Code != "OUT_OF_RANGE" {
+		t.Errorf("Expected width OUT_OF_RANGE, got %+v", validationErr)
+	}
+
+	_, err = client.GenerateTemplate("circle", nil)
+	providerErr, ok := err.(*providers.ProviderError)
+	if !ok || providerErr.Code != "UNSUPPORTED_RESOURCE" || providerErr.Resource != "circle" {
+		t.Errorf("Expected UNSUPPORTED_RESOURCE ProviderError, got %v", err)
+	}
+
+	// The plugin keeps running after provider errors
+	if err := client.Validate("box", nil); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+}
+
+func TestClient_CallTimeout(t *testing.T) {
+	client, err := launch(t, "serve", plugin.Options{CallTimeout: 200 * time.Millisecond})
+	if err != nil {
+		t.Fatalf("Launch() error = %v", err)
+	}
+	defer client.Close()
+
+	_, err = client.GenerateTemplate("box", map[string]interface{}{"delay": "10s"})
+	if err == nil || !strings.Contains(err.Error(), "did not answer generateTemplate within 200ms") {
+		t.Fatalf("Expected timeout error, got %v", err)
+	}
+
+	// The plugin was killed, so later calls fail immediately
+	if err := client.Validate("box", nil); err == nil || !strings.Contains(err.Error(), "not running") {
+		t.Errorf("Expected error for a killed plugin, got %v", err)
+	}
+}
+
+func TestLaunch_Errors(t *testing.T) {
+	tests := []struct {
+		mode string
+		want string
+	}{
+		{mode: "protocol-mismatch", want: fmt.Sprintf("speaks protocol version %d, expected %d", plugin.ProtocolVersion+1, plugin.ProtocolVersion)},
+		{mode: "exit", want: "handshake with plugin"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode, func(t *testing.T) {
+			client, err := launch(t, tt.mode, plugin.Options{StartTimeout: 5 * time.Second})
+			if err == nil {
+				client.Close()
+				t.Fatal("Expected Launch() to fail")
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+
+	if _, err := plugin.Launch("/nonexistent/plugin", plugin.Options{}); err == nil || !strings.Contains(err.Error(), "failed to start plugin") {
+		t.Errorf("Expected start error, got %v", err)
+	}
+}
@@ -0,0 +1,152 @@
+// Package plugin runs providers as separate executables that speak JSON-RPC 2.0 over their
+// standard input and output, one message per line. The host launches a plugin from the path
+// of a custom provider declaration, performs a handshake and then calls the methods of the
+// providers.Provider interface. Plugin authors implement the protocol with the sdk package.
+package plugin
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// ProtocolVersion is the version of the plugin protocol spoken by this build. Hosts and
+// plugins refuse to talk to each other when their versions differ.
+const ProtocolVersion = 1
+
+// JSONRPCVersion is the JSON-RPC version of every message
+const JSONRPCVersion = "2.0"
+
+// Methods of the plugin protocol
+const (
+	MethodHandshake        = "handshake"        // HandshakeParams -> HandshakeResult
+	MethodResources        = "resources"        // no params -> []providers.ResourceDefinition
+	MethodValidate         = "validate"         // ResourceParams -> null
+	MethodGenerateTemplate = "generateTemplate" // ResourceParams -> schema.Element
+	MethodGetSchema        = "getSchema"        // ResourceParams -> JSON schema
+	MethodConfigure        = "configure"        // providers.ProviderConfig -> null, only sent for declarations with settings
+	MethodShutdown         = "shutdown"         // no params -> null, then the plugin exits
+)
+
+// JSON-RPC error codes
+const (
+	CodeParseError     = -32700
+	CodeInvalidRequest = -32600
+	CodeMethodNotFound = -32601
+	CodeInvalidParams  = -32602
+	CodeInternalError  = -32603
+	CodeProviderError  = -32000 // The provider returned an error, described by ErrorData
+)
+
+// Request is a JSON-RPC request sent from the host to a plugin
+type Request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      int64           `json:"id"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+// Response is a JSON-RPC response sent from a plugin to the host
+type Response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      int64           `json:"id"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *Error          `json:"error,omitempty"`
+}
+
+// Error is a JSON-RPC error
+type Error struct {
+	Code    int        `json:"code"`
+	Message string     `json:"message"`
+	Data    *ErrorData `json:"data,omitempty"`
+}
+
+// ErrorData carries the fields of structured provider errors across the process boundary
+type ErrorData struct {
+	Kind     string `json:"kind"` // "validation", "validations" or "provider"
+	Field    string `json:"field,omitempty"`
+	Code     string `json:"code,omitempty"`
+	Provider string `json:"provider,omitempty"`
+	Resource string `json:"resource,omitempty"`
+
+	Errors providers.ValidationErrors `json:"errors,omitempty"` // All errors of kind "validations"
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s (code %d)", e.Message, e.Code)
+}
+
+// HandshakeParams are sent by the host when a plugin starts
+type HandshakeParams struct {
+	ProtocolVersion int    `json:"protocolVersion"`
+	HostVersion     string `json:"hostVersion"`
+}
+
+// HandshakeResult identifies the provider implemented by a plugin
+type HandshakeResult struct {
+	ProtocolVersion int    `json:"protocolVersion"`
+	Name            string `json:"name"`
+	Version         string `json:"version"`
+}
+
+// ResourceParams are the parameters of the resource methods
+type ResourceParams struct {
+	ResourceType string                 `json:"resourceType"`
+	Params       map[string]interface{} `json:"params,omitempty"`
+}
+
+// EncodeError converts a provider error to a JSON-RPC error, keeping the fields of
+// providers.ValidationErrors, providers.ValidationError and providers.ProviderError
+func EncodeError(err error) *Error {
+	var validationErrs providers.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		return &Error{
+			Code:    CodeProviderError,
+			Message: validationErrs.Error(),
+			Data:    &ErrorData{Kind: "validations", Errors: validationErrs},
+		}
+	}
+
+	var validationErr *providers.ValidationError
+	if errors.As(err, &validationErr) {
+		return &Error{
+			Code:    CodeProviderError,
+			Message: validationErr.Message,
+			Data:    &ErrorData{Kind: "validation", Field: validationErr.Field, Code: validationErr.Code},
+		}
+	}
+
+	var providerErr *providers.ProviderError
+	if errors.As(err, &providerErr) {
+		return &Error{
+			Code:    CodeProviderError,
+			Message: providerErr.Message,
+			Data:    &ErrorData{Kind: "provider", Provider: providerErr.Provider, Resource: providerErr.Resource, Code: providerErr.Code},
+		}
+	}
+
+	return &Error{Code: CodeProviderError, Message: err.Error()}
+}
+
+// DecodeError converts a JSON-RPC error back to the provider error it was encoded from
+func DecodeError(rpcErr *Error) error {
+	if rpcErr.Code != CodeProviderError {
+		return rpcErr
+	}
+
+	if rpcErr.Data != nil {
+		switch rpcErr.Data.Kind {
+		case "validations":
+			if len(rpcErr.Data.Errors) > 0 {
+				return rpcErr.Data.Errors
+			}
+		case "validation":
+			return &providers.ValidationError{Field: rpcErr.Data.Field, Message: rpcErr.Message, Code: rpcErr.Data.Code}
+		case "provider":
+			return &providers.ProviderError{Provider: rpcErr.Data.Provider, Resource: rpcErr.Data.Resource, Message: rpcErr.Message, Code: rpcErr.Data.Code}
+		}
+	}
+	return errors.New(rpcErr.Message)
+}
@@ -0,0 +1,154 @@
+// Package sdk serves a providers.Provider as a Hippodamus plugin. A plugin is an executable
+// whose main function passes its provider to Serve:
+//
+//	func main() {
+//		if err := sdk.Serve(myprovider.New()); err != nil {
+//			log.Fatal(err)
+//		}
+//	}
+//
+// Hippodamus talks to the plugin over its standard input and output, so plugins must write
+// logs and diagnostics to standard error.
+package sdk
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/LederWorks/hippodamus/pkg/plugin"
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// maxMessageSize is the largest request line accepted from the host
+const maxMessageSize = 16 * 1024 * 1024
+
+// Serve serves provider over standard input and output until the host shuts the plugin down
+func Serve(provider providers.Provider) error {
+	return ServeIO(provider, os.Stdin, os.Stdout)
+}
+
+// ServeIO serves provider, reading requests from r and writing responses to w, until the host
+// sends a shutdown request or closes r
+func ServeIO(provider providers.Provider, r io.Reader, w io.Writer) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxMessageSize)
+	encoder := json.NewEncoder(w)
+
+	for scanner.Scan() {
+		var request plugin.Request
+		if err := json.Unmarshal(scanner.Bytes(), &request); err != nil {
+			if err := encoder.Encode(errorResponse(0, plugin.CodeParseError, fmt.Sprintf("invalid request: %v", err))); err != nil {
+				return err
+			}
+			continue
+		}
+
+		response := handle(provider, &request)
+		if err := encoder.Encode(response); err != nil {
+			return fmt.Errorf("failed to write response: %w", err)
+		}
+
+		if request.Method == plugin.MethodShutdown {
+			return nil
+		}
+	}
+
+	return scanner.Err()
+}
+
+// handle calls the provider method of a request
+func handle(provider providers.Provider, request *plugin.Request) *plugin.Response {
+	var result interface{}
+	var err error
+
+	switch request.Method {
+	case plugin.MethodHandshake:
+		var params plugin.HandshakeParams
+		if response := decodeParams(request, &params); response != nil {
+			return response
+		}
+		if params.ProtocolVersion != plugin.ProtocolVersion {
+			return errorResponse(request.ID, plugin.CodeInvalidRequest, fmt.Sprintf("unsupported protocol version %d, plugin speaks %d", params.ProtocolVersion, plugin.ProtocolVersion))
+		}
+		result = plugin.HandshakeResult{
+			ProtocolVersion: plugin.ProtocolVersion,
+			Name:            provider.Name(),
+			Version:         provider.Version(),
+		}
+
+	case plugin.MethodResources:
+		result = provider.Resources()
+
+	case plugin.MethodValidate:
+		var params plugin.ResourceParams
+		if response := decodeParams(request, &params); response != nil {
+			return response
+		}
+		err = provider.Validate(params.ResourceType, params.Params)
+
+	case plugin.MethodGenerateTemplate:
+		var params plugin.ResourceParams
+		if response := decodeParams(request, &params); response != nil {
+			return response
+		}
+		result, err = provider.GenerateTemplate(params.ResourceType, params.Params)
+
+	case plugin.MethodGetSchema:
+		var params plugin.ResourceParams
+		if response := decodeParams(request, &params); response != nil {
+			return response
+		}
+		result, err = provider.GetSchema(params.ResourceType)
+
+	case plugin.MethodConfigure:
+		var params providers.ProviderConfig
+		if response := decodeParams(request, &params); response != nil {
+			return response
+		}
+		if configurable, ok := provider.(providers.Configurable); ok {
+			err = configurable.Configure(params)
+		} else {
+			err = &providers.ProviderError{
+				Provider: provider.Name(),
+				Message:  "provider does not accept settings",
+				Code:     "NOT_SUPPORTED",
+			}
+		}
+
+	case plugin.MethodShutdown:
+		// Answered with an empty result, after which Serve returns
+
+	default:
+		return errorResponse(request.ID, plugin.CodeMethodNotFound, fmt.Sprintf("unknown method %q", request.Method))
+	}
+
+	if err != nil {
+		return &plugin.Response{JSONRPC: plugin.JSONRPCVersion, ID: request.ID, Error: plugin.EncodeError(err)}
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		return errorResponse(request.ID, plugin.CodeInternalError, fmt.Sprintf("failed to encode result: %v", err))
+	}
+	return &plugin.Response{JSONRPC: plugin.JSONRPCVersion, ID: request.ID, Result: data}
+}
+
+// decodeParams decodes the parameters of a request, returning an error response when they are invalid
+func decodeParams(request *plugin.Request, params interface{}) *plugin.Response {
+	if err := json.Unmarshal(request.Params, params); err != nil {
+		return errorResponse(request.ID, plugin.CodeInvalidParams, fmt.Sprintf("invalid %s params: %v", request.Method, err))
+	}
+	return nil
+}
+
+// errorResponse creates a JSON-RPC error response
+func errorResponse(id int64, code int, message string) *plugin.Response {
+	return &plugin.Response{
+		JSONRPC: plugin.JSONRPCVersion,
+		ID:      id,
+		Error:   &plugin.Error{Code: code, Message: message},
+	}
+}
@@ -0,0 +1,122 @@
+package sdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/plugin"
+	"github.com/LederWorks/hippodamus/pkg/providers"
+	"github.com/LederWorks/hippodamus/providers/core"
+)
+
+// serve sends requests to a core provider plugin and returns its responses
+func serve(t *testing.T, requests ...string) []plugin.Response {
+	t.Helper()
+
+	var output bytes.Buffer
+	if err := ServeIO(core.NewCoreProviderWithVersion("1.0.0"), strings.NewReader(strings.Join(requests, "\n")+"\n"), &output); err != nil {
+		t.Fatalf("ServeIO() error = %v", err)
+	}
+
+	var responses []plugin.Response
+	decoder := json.NewDecoder(&output)
+	for decoder.More() {
+		var response plugin.Response
+		if err := decoder.Decode(&response); err != nil {
+			t.Fatalf("invalid response: %v", err)
+		}
+		responses = append(responses, response)
+	}
+	return responses
+}
+
+func TestServeIO_Handshake(t *testing.T) {
+	responses := serve(t,
+		`{"jsonrpc":"2.0","id":1,"method":"handshake","params":{"protocolVersion":1,"hostVersion":"dev"}}`,
+		`{"jsonrpc":"2.0","id":2,"method":"handshake","params":{"protocolVersion":99}}`,
+	)
+
+	var result plugin.HandshakeResult
+	if err := json.Unmarshal(responses[0].Result, &result); err != nil {
+		t.Fatalf("invalid handshake result: %v", err)
+	}
+	if result.Name != "core" || result.Version != "1.0.0" || result.ProtocolVersion != plugin.ProtocolVersion {
+		t.Errorf("Expected core 1.0.0, got %+v", result)
+	}
+
+	if responses[1].Error == nil || responses[1].Error.Code != plugin.CodeInvalidRequest {
+		t.Errorf("Expected protocol version error, got %+v", responses[1])
+	}
+}
+
+func TestServeIO_Methods(t *testing.T) {
+	responses := serve(t,
+		`{"jsonrpc":"2.0","id":1,"method":"resources"}`,
+		`{"jsonrpc":"2.0","id":2,"method":"generateTemplate","params":{"resourceType":"text","params":{"label":"Hello"}}}`,
+		`{"jsonrpc":"2.0","id":3,"method":"getSchema","params":{"resourceType":"missing"}}`,
+		`{"jsonrpc":"2.0","id":4,"method":"render"}`,
+		`not json`,
+		`{"jsonrpc":"2.0","id":5,"method":"shutdown"}`,
+		`{"jsonrpc":"2.0","id":6,"method":"resources"}`,
+	)
+
+	if len(responses) != 6 {
+		t.Fatalf("Expected 6 responses before shutdown, got %d", len(responses))
+	}
+
+	var resources []providers.ResourceDefinition
+	if err := json.Unmarshal(responses[0].Result, &resources); err != nil || len(resources) == 0 {
+		t.Errorf("Expected resource definitions, got %s", responses[0].Result)
+	}
+
+	if responses[1].Error != nil || !strings.Contains(string(responses[1].Result), "Hello") {
+		t.Errorf("Expected generated element, got %+v", responses[1])
+	}
+
+	schemaErr := plugin.DecodeError(responses[2].Error)
+	if providerErr, ok := schemaErr.(*providers.ProviderError); !ok || providerErr.Code != "SCHEMA_NOT_FOUND" {
+		t.Errorf("Expected SCHEMA_NOT_FOUND ProviderError, got %v", schemaErr)
+	}
+
+	if responses[3].Error == nil || responses[3].Error.Code != plugin.CodeMethodNotFound {
+		t.Errorf("Expected method not found, got %+v", responses[3])
+	}
+	if responses[4].Error == nil || responses[4].Error.Code != plugin.CodeParseError {
+		t.Errorf("Expected parse error, got %+v", responses[4])
+	}
+	if responses[5].ID != 5 || responses[5].Error != nil {
+		t.Errorf("Expected shutdown to be answered, got %+v", responses[5])
+	}
+}
+
+func TestServeIO_ValidationErrors(t *testing.T) {
+	responses := serve(t,
+		`{"jsonrpc":"2.0","id":1,"method":"validate","params":{"resourceType":"text","params":{"fontSize":100}}}`,
+	)
+
+	if len(responses) != 1 || responses[0].Error == nil {
+		t.Fatalf("Expected a validation error, got %+v", responses)
+	}
+
+	err := plugin.DecodeError(responses[0].Error)
+	validationErrs, ok := err.(providers.ValidationErrors)
+	if !ok || len(validationErrs) != 2 {
+		t.Fatalf("Expected both ValidationErrors, got %T %v", err, err)
+	}
+	if validationErrs[0].Field != "label" || validationErrs[1].Field != "fontSize" || validationErrs[1].Code != "OUT_OF_RANGE" {
+		t.Errorf("Expected label and fontSize errors, got %v", validationErrs)
+	}
+}
+
+func TestServeIO_Configure(t *testing.T) {
+	responses := serve(t,
+		`{"jsonrpc":"2.0","id":1,"method":"configure","params":{"name":"core-flat","settings":{"style":"flat"}}}`,
+	)
+
+	err := plugin.DecodeError(responses[0].Error)
+	if providerErr, ok := err.(*providers.ProviderError); !ok || providerErr.Code != "NOT_SUPPORTED" {
+		t.Errorf("Expected NOT_SUPPORTED for a provider without settings, got %v", err)
+	}
+}
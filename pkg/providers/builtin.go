@@ -4,6 +4,12 @@ package providers
 func GetBuiltinProviders() []string {
 	return []string{
 		"aws",
-		// TODO: Add "azure", "gcp" when implemented
+		"azure",
+		"bpmn",
+		"c4",
+		"kubernetes",
+		"network",
+		"uml",
+		// TODO: Add "gcp" when implemented
 	}
 }
@@ -27,6 +27,14 @@ type Provider interface {
 	GetSchema(resourceType string) (map[string]interface{}, error)
 }
 
+// Configurable is implemented by providers that accept settings in their declaration.
+// Configure is called once, before any resource is generated, on an instance dedicated
+// to the declaration, so differently configured instances of a provider can coexist.
+type Configurable interface {
+	// Configure applies the settings of a provider declaration
+	Configure(config ProviderConfig) error
+}
+
 // ResourceDefinition defines a resource type that a provider supports
 type ResourceDefinition struct {
 	Type        string                 `json:"type"`        // Resource type (e.g., "aws-vpc", "azure-rg")
@@ -35,6 +43,22 @@ type ResourceDefinition struct {
 	Category    string                 `json:"category"`    // Resource category (compute, network, storage, etc.)
 	Schema      map[string]interface{} `json:"schema"`      // JSON schema for validation
 	Examples    []ResourceExample      `json:"examples"`    // Usage examples
+
+	// Containment rules, enforced by the template processor. Entries name resource types of
+	// the same provider, or resources of other providers in the provider.resource form.
+	AllowedParents    []string `json:"allowedParents"`    // Resources the resource may be placed in directly, any parent when empty
+	AllowedChildren   []string `json:"allowedChildren"`   // Resources that may be placed in the resource, any when nil and none when empty
+	RequiredAncestors []string `json:"requiredAncestors"` // Resources that must all enclose the resource
+
+	// Connection rules of connector resources, enforced by the template processor once the
+	// elements of a page are known. Entries name resources as for the containment rules,
+	// endpoints that are not provider resources are not restricted.
+	DisallowedSources []string `json:"disallowedSources"` // Resources the connector may not start at
+	DisallowedTargets []string `json:"disallowedTargets"` // Resources the connector may not end at
+
+	// Sibling rules, enforced by the template processor among the resources of the same type
+	// that share a parent
+	DisjointCIDRs []string `json:"disjointCIDRs"` // Parameters whose CIDR blocks must not overlap
 }
 
 // ResourceExample provides usage examples for a resource
@@ -44,11 +68,11 @@ type ResourceExample struct {
 	Config      map[string]interface{} `json:"config"`
 }
 
-// ProviderConfig contains provider initialization configuration
+// ProviderConfig contains provider initialization configuration, passed to Configurable providers
 type ProviderConfig struct {
-	Name     string                 `json:"name"`
-	Version  string                 `json:"version"`
-	Settings map[string]interface{} `json:"settings"`
+	Name     string                 `json:"name"`     // Local name of the declaration
+	Version  string                 `json:"version"`  // Version constraint of the declaration
+	Settings map[string]interface{} `json:"settings"` // Settings of the declaration
 }
 
 // ProviderMetadata contains metadata about a provider
@@ -0,0 +1,226 @@
+// Package providertest verifies that a provider behaves the way the template processor
+// expects, so builtin, declarative, plugin and third-party providers can share one set
+// of conformance checks:
+//
+//	func TestProvider_Conformance(t *testing.T) {
+//		providertest.Run(t, NewMyProvider())
+//	}
+package providertest
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/drawio"
+	"github.com/LederWorks/hippodamus/pkg/providers"
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// UnsupportedType is the resource type used to check the errors for resource types
+// a provider does not define
+const UnsupportedType = "providertest-unsupported"
+
+// Error codes providers return for resource types they do not define
+const (
+	codeUnsupportedResource = "UNSUPPORTED_RESOURCE"
+	codeSchemaNotFound      = "SCHEMA_NOT_FOUND"
+)
+
+// Run checks provider and reports every problem found as an error of t
+func Run(t testing.TB, provider providers.Provider) {
+	t.Helper()
+	for _, problem := range check(provider) {
+		t.Error(problem)
+	}
+}
+
+// Check checks provider and returns an error describing every problem found, or nil.
+// It verifies that
+//   - every resource definition has a type and a valid JSON schema, also returned by GetSchema
+//   - the config of every resource example passes Validate and GenerateTemplate, and the
+//     generated element renders through drawio.Generator
+//   - Validate reports each required parameter missing from the first example
+//   - Validate and GenerateTemplate return a ProviderError with code UNSUPPORTED_RESOURCE
+//     and GetSchema one with code SCHEMA_NOT_FOUND for resource types the provider does
+//     not define
+func Check(provider providers.Provider) error {
+	return errors.Join(check(provider)...)
+}
+
+// check returns the problems found in provider
+func check(provider providers.Provider) []error {
+	c := &checker{provider: provider}
+	c.checkResources()
+	c.checkUnsupported()
+	return c.problems
+}
+
+// checker collects the problems found in a provider
+type checker struct {
+	provider providers.Provider
+	problems []error
+}
+
+func (c *checker) fail(format string, args ...interface{}) {
+	c.problems = append(c.problems, fmt.Errorf("provider %s: "+format, append([]interface{}{c.provider.Name()}, args...)...))
+}
+
+// checkResources checks the definition, examples and required parameters of every resource
+func (c *checker) checkResources() {
+	definitions := c.provider.Resources()
+	if len(definitions) == 0 {
+		c.fail("defines no resources")
+	}
+
+	seen := make(map[string]bool)
+	for _, definition := range definitions {
+		if definition.Type == "" {
+			c.fail("resource %q has no type", definition.Name)
+			continue
+		}
+		if seen[definition.Type] {
+			c.fail("resource %s is defined more than once", definition.Type)
+		}
+		seen[definition.Type] = true
+
+		c.checkDefinition(definition)
+		for _, example := range definition.Examples {
+			c.checkExample(definition, example)
+		}
+		c.checkRequired(definition)
+	}
+}
+
+// checkDefinition checks the schema of a resource and that GetSchema returns it
+func (c *checker) checkDefinition(definition providers.ResourceDefinition) {
+	for _, problem := range schemaProblems("", definition.Schema) {
+		c.fail("resource %s: invalid schema: %s", definition.Type, problem)
+	}
+
+	resourceSchema, err := c.provider.GetSchema(definition.Type)
+	if err != nil {
+		c.fail("resource %s: GetSchema() error = %v", definition.Type, err)
+	} else if resourceSchema == nil {
+		c.fail("resource %s: GetSchema() returned no schema", definition.Type)
+	}
+}
+
+// checkExample checks that an example validates, generates an element and renders
+func (c *checker) checkExample(definition providers.ResourceDefinition, example providers.ResourceExample) {
+	if err := c.provider.Validate(definition.Type, example.Config); err != nil {
+		c.fail("resource %s: example %q: Validate() error = %v", definition.Type, example.Name, err)
+		return
+	}
+
+	element, err := c.provider.GenerateTemplate(definition.Type, example.Config)
+	if err != nil {
+		c.fail("resource %s: example %q: GenerateTemplate() error = %v", definition.Type, example.Name, err)
+		return
+	}
+	if element == nil {
+		c.fail("resource %s: example %q: GenerateTemplate() returned no element", definition.Type, example.Name)
+		return
+	}
+
+	if err := render(element); err != nil {
+		c.fail("resource %s: example %q: rendering failed: %v", definition.Type, example.Name, err)
+	}
+}
+
+// checkRequired checks that Validate reports each required parameter when it is missing
+// from the config of the first example, or from empty parameters without examples
+func (c *checker) checkRequired(definition providers.ResourceDefinition) {
+	base := map[string]interface{}{}
+	if len(definition.Examples) > 0 {
+		base = definition.Examples[0].Config
+	}
+
+	for _, field := range stringList(definition.Schema["required"]) {
+		params := make(map[string]interface{}, len(base))
+		for name, value := range base {
+			if name != field {
+				params[name] = value
+			}
+		}
+
+		err := c.provider.Validate(definition.Type, params)
+		switch {
+		case err == nil:
+			c.fail("resource %s: Validate() accepted parameters without required %s", definition.Type, field)
+		case !reportsField(err, field):
+			c.fail("resource %s: Validate() did not report missing required %s, got %v", definition.Type, field, err)
+		}
+	}
+}
+
+// checkUnsupported checks the errors for a resource type the provider does not define
+func (c *checker) checkUnsupported() {
+	if err := c.provider.Validate(UnsupportedType, map[string]interface{}{}); !hasCode(err, codeUnsupportedResource) {
+		c.fail("Validate() of an unsupported resource type returned %v, expected a ProviderError with code %s", err, codeUnsupportedResource)
+	}
+	if _, err := c.provider.GenerateTemplate(UnsupportedType, map[string]interface{}{}); !hasCode(err, codeUnsupportedResource) {
+		c.fail("GenerateTemplate() of an unsupported resource type returned %v, expected a ProviderError with code %s", err, codeUnsupportedResource)
+	}
+	if _, err := c.provider.GetSchema(UnsupportedType); !hasCode(err, codeSchemaNotFound) {
+		c.fail("GetSchema() of an unsupported resource type returned %v, expected a ProviderError with code %s", err, codeSchemaNotFound)
+	}
+}
+
+// render generates the draw.io document of a page holding element
+func render(element *schema.Element) error {
+	pageElement := *element
+	pageElement.ID = "example"
+	pageElement.Name = "Example"
+
+	config := &schema.DiagramConfig{
+		Diagram: schema.Diagram{Pages: []schema.Page{{ID: "page", Name: "Page", Elements: []schema.Element{pageElement}}}},
+	}
+	doc, err := drawio.NewGenerator().Generate(config)
+	if err != nil {
+		return err
+	}
+	_, err = xml.Marshal(doc)
+	return err
+}
+
+// ValidationCode returns the code of the validation error that err holds for field, or
+// an empty string when err reports no problem with field. Resource tests use it to check
+// why parameters are rejected.
+func ValidationCode(err error, field string) string {
+	if validationErr := fieldError(err, field); validationErr != nil {
+		return validationErr.Code
+	}
+	return ""
+}
+
+// reportsField reports whether err holds a validation error for field
+func reportsField(err error, field string) bool {
+	return fieldError(err, field) != nil
+}
+
+// fieldError returns the validation error that err holds for field, or nil
+func fieldError(err error, field string) *providers.ValidationError {
+	var validationErrs providers.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		for _, validationErr := range validationErrs {
+			if validationErr.Field == field {
+				return validationErr
+			}
+		}
+		return nil
+	}
+
+	var validationErr *providers.ValidationError
+	if errors.As(err, &validationErr) && validationErr.Field == field {
+		return validationErr
+	}
+	return nil
+}
+
+// hasCode reports whether err is a ProviderError with the given code
+func hasCode(err error, code string) bool {
+	var providerErr *providers.ProviderError
+	return errors.As(err, &providerErr) && providerErr.Code == code
+}
@@ -0,0 +1,197 @@
+package providertest
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers"
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// fakeProvider validates parameters against the schemas of its definitions and generates
+// a shape, or its element when it has one
+type fakeProvider struct {
+	definitions []providers.ResourceDefinition
+	lenient     bool            // Validate accepts any parameters
+	plainErrors bool            // Unsupported resource types return plain errors
+	element     *schema.Element // Element generated for every resource
+}
+
+func (p *fakeProvider) Name() string    { return "fake" }
+func (p *fakeProvider) Version() string { return "1.0.0" }
+
+func (p *fakeProvider) Resources() []providers.ResourceDefinition {
+	return p.definitions
+}
+
+func (p *fakeProvider) definition(resourceType string) (providers.ResourceDefinition, error) {
+	for _, definition := range p.definitions {
+		if definition.Type == resourceType {
+			return definition, nil
+		}
+	}
+	if p.plainErrors {
+		return providers.ResourceDefinition{}, fmt.Errorf("unknown resource %s", resourceType)
+	}
+	return providers.ResourceDefinition{}, &providers.ProviderError{Provider: p.Name(), Resource: resourceType, Message: "unsupported resource type", Code: "UNSUPPORTED_RESOURCE"}
+}
+
+func (p *fakeProvider) Validate(resourceType string, params map[string]interface{}) error {
+	definition, err := p.definition(resourceType)
+	if err != nil || p.lenient {
+		return err
+	}
+	return providers.ValidateParams(definition.Schema, params)
+}
+
+func (p *fakeProvider) GenerateTemplate(resourceType string, params map[string]interface{}) (*schema.Element, error) {
+	if err := p.Validate(resourceType, params); err != nil {
+		return nil, err
+	}
+	if p.element != nil {
+		element := *p.element
+		return &element, nil
+	}
+	label, _ := params["label"].(string)
+	return &schema.Element{Type: schema.ElementTypeShape, Properties: schema.ElementProperties{Label: label}}, nil
+}
+
+func (p *fakeProvider) GetSchema(resourceType string) (map[string]interface{}, error) {
+	definition, err := p.definition(resourceType)
+	if err != nil {
+		if !p.plainErrors {
+			err = &providers.ProviderError{Provider: p.Name(), Resource: resourceType, Message: "schema not found", Code: "SCHEMA_NOT_FOUND"}
+		}
+		return nil, err
+	}
+	return definition.Schema, nil
+}
+
+// boxDefinition returns a resource with a required label and a valid example
+func boxDefinition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type: "box",
+		Name: "Box",
+		Schema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"label": map[string]interface{}{"type": "string", "minLength": 1},
+				"size":  map[string]interface{}{"type": "string", "enum": []string{"small", "large"}, "default": "small"},
+			},
+			"required": []string{"label"},
+		},
+		Examples: []providers.ResourceExample{
+			{Name: "Box", Config: map[string]interface{}{"label": "Hello", "size": "large"}},
+		},
+	}
+}
+
+func TestCheck_ConformingProvider(t *testing.T) {
+	provider := &fakeProvider{definitions: []providers.ResourceDefinition{boxDefinition()}}
+	if err := Check(provider); err != nil {
+		t.Errorf("Check() error = %v", err)
+	}
+	Run(t, provider)
+}
+
+func TestCheck_Problems(t *testing.T) {
+	tests := []struct {
+		name     string
+		provider *fakeProvider
+		want     []string
+	}{
+		{
+			name:     "no resources",
+			provider: &fakeProvider{},
+			want:     []string{"provider fake: defines no resources"},
+		},
+		{
+			name: "invalid schema",
+			provider: &fakeProvider{definitions: []providers.ResourceDefinition{func() providers.ResourceDefinition {
+				definition := boxDefinition()
+				definition.Schema = map[string]interface{}{
+					"type": "array",
+					"properties": map[string]interface{}{
+						"label": map[string]interface{}{"type": "text"},
+						"size":  map[string]interface{}{"type": "number", "default": "big", "enum": []interface{}{1, "two"}},
+						"ports": map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": "port"}},
+					},
+					"required": []string{"label", "name"},
+				}
+				definition.Examples = nil
+				return definition
+			}()}},
+			want: []string{
+				"resource box: invalid schema: type is array, expected object",
+				"resource box: invalid schema: required property name is not declared",
+				"resource box: invalid schema: property label has unknown type text",
+				"resource box: invalid schema: default of property size is invalid",
+				"resource box: invalid schema: enum value two of property size is invalid",
+				"resource box: invalid schema: property ports[] has unknown type port",
+			},
+		},
+		{
+			name: "invalid example",
+			provider: &fakeProvider{definitions: []providers.ResourceDefinition{func() providers.ResourceDefinition {
+				definition := boxDefinition()
+				definition.Examples = append(definition.Examples, providers.ResourceExample{Name: "Huge", Config: map[string]interface{}{"label": "Huge", "size": "huge"}})
+				return definition
+			}()}},
+			want: []string{`resource box: example "Huge": Validate() error = size:`},
+		},
+		{
+			name:     "required not enforced",
+			provider: &fakeProvider{definitions: []providers.ResourceDefinition{boxDefinition()}, lenient: true},
+			want:     []string{"resource box: Validate() accepted parameters without required label"},
+		},
+		{
+			name: "unrenderable element",
+			provider: &fakeProvider{
+				definitions: []providers.ResourceDefinition{boxDefinition()},
+				element:     &schema.Element{Type: schema.ElementTypeGroup, Children: []schema.Element{{Type: schema.ElementTypeShape}}},
+			},
+			want: []string{`resource box: example "Box": rendering failed`},
+		},
+		{
+			name:     "unsupported resource errors",
+			provider: &fakeProvider{definitions: []providers.ResourceDefinition{boxDefinition()}, plainErrors: true},
+			want: []string{
+				"Validate() of an unsupported resource type returned unknown resource providertest-unsupported, expected a ProviderError with code UNSUPPORTED_RESOURCE",
+				"GenerateTemplate() of an unsupported resource type returned unknown resource providertest-unsupported, expected a ProviderError with code UNSUPPORTED_RESOURCE",
+				"GetSchema() of an unsupported resource type returned unknown resource providertest-unsupported, expected a ProviderError with code SCHEMA_NOT_FOUND",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Check(tt.provider)
+			if err == nil {
+				t.Fatal("Expected problems, got none")
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("Expected a problem containing %q, got:\n%v", want, err)
+				}
+			}
+		})
+	}
+}
+
+func TestValidationCode(t *testing.T) {
+	err := providers.ValidateParams(boxDefinition().Schema, map[string]interface{}{"label": "", "size": "huge"})
+
+	if code := ValidationCode(err, "size"); code != "INVALID_ENUM" {
+		t.Errorf("Expected INVALID_ENUM for size, got %q", code)
+	}
+	if code := ValidationCode(err, "label"); code != "REQUIRED" {
+		t.Errorf("Expected REQUIRED for label, got %q", code)
+	}
+	if code := ValidationCode(err, "color"); code != "" {
+		t.Errorf("Expected no code for a valid field, got %q", code)
+	}
+	if code := ValidationCode(&providers.ValidationError{Field: "size", Code: "OUT_OF_RANGE"}, "size"); code != "OUT_OF_RANGE" {
+		t.Errorf("Expected the code of a single validation error, got %q", code)
+	}
+}
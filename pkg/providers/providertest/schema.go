@@ -0,0 +1,130 @@
+package providertest
+
+import (
+	"fmt"
+
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// schemaTypes are the JSON schema types providers.ValidateParams understands
+var schemaTypes = map[string]bool{
+	"string":  true,
+	"number":  true,
+	"integer": true,
+	"boolean": true,
+	"array":   true,
+	"object":  true,
+	"null":    true,
+}
+
+// schemaProblems returns the problems of the object schema of a resource, or of the
+// nested object at path
+func schemaProblems(path string, objectSchema map[string]interface{}) []string {
+	if objectSchema == nil {
+		return []string{"no schema"}
+	}
+
+	var problems []string
+	if path == "" && objectSchema["type"] != "object" {
+		problems = append(problems, fmt.Sprintf("type is %v, expected object", objectSchema["type"]))
+	}
+
+	properties, _ := objectSchema["properties"].(map[string]interface{})
+	if _, declared := objectSchema["properties"]; declared && properties == nil {
+		problems = append(problems, fmt.Sprintf("%sproperties is not an object", prefix(path)))
+	}
+	for _, name := range stringList(objectSchema["required"]) {
+		if _, exists := properties[name]; !exists {
+			problems = append(problems, fmt.Sprintf("required property %s%s is not declared", prefix(path), name))
+		}
+	}
+
+	for name, property := range properties {
+		field := prefix(path) + name
+		propertySchema, ok := property.(map[string]interface{})
+		if !ok {
+			problems = append(problems, fmt.Sprintf("property %s is not a schema", field))
+			continue
+		}
+		problems = append(problems, propertyProblems(field, name, propertySchema)...)
+	}
+	return problems
+}
+
+// propertyProblems returns the problems of the schema of the property name at field
+func propertyProblems(field, name string, propertySchema map[string]interface{}) []string {
+	var problems []string
+	for _, propertyType := range stringList(propertySchema["type"]) {
+		if !schemaTypes[propertyType] {
+			problems = append(problems, fmt.Sprintf("property %s has unknown type %s", field, propertyType))
+		}
+	}
+
+	// Defaults and enum values must satisfy the property schema themselves
+	wrapper := map[string]interface{}{
+		"type":       "object",
+		"properties": map[string]interface{}{name: propertySchema},
+	}
+	if value, exists := propertySchema["default"]; exists {
+		if err := providers.ValidateParams(wrapper, map[string]interface{}{name: value}); err != nil {
+			problems = append(problems, fmt.Sprintf("default of property %s is invalid: %v", field, err))
+		}
+	}
+	for _, value := range enumValues(propertySchema["enum"]) {
+		if err := providers.ValidateParams(wrapper, map[string]interface{}{name: value}); err != nil {
+			problems = append(problems, fmt.Sprintf("enum value %v of property %s is invalid: %v", value, field, err))
+		}
+	}
+
+	if _, nested := propertySchema["properties"]; nested {
+		problems = append(problems, schemaProblems(field, propertySchema)...)
+	}
+	if items, ok := propertySchema["items"].(map[string]interface{}); ok {
+		problems = append(problems, propertyProblems(field+"[]", "items", items)...)
+	}
+	return problems
+}
+
+// prefix returns the prefix of the names of the properties of the object at path
+func prefix(path string) string {
+	if path == "" {
+		return ""
+	}
+	return path + "."
+}
+
+// stringList returns a schema keyword that is a string or a list of strings, which are
+// []string in schemas built in Go and []interface{} in decoded schemas
+func stringList(value interface{}) []string {
+	switch list := value.(type) {
+	case string:
+		return []string{list}
+	case []string:
+		return list
+	case []interface{}:
+		strs := make([]string, len(list))
+		for i, item := range list {
+			strs[i] = fmt.Sprint(item)
+		}
+		return strs
+	default:
+		return nil
+	}
+}
+
+// enumValues returns the values of an enum keyword, []string in schemas built in Go and
+// []interface{} in decoded schemas
+func enumValues(value interface{}) []interface{} {
+	switch list := value.(type) {
+	case []interface{}:
+		return list
+	case []string:
+		values := make([]interface{}, len(list))
+		for i, item := range list {
+			values[i] = item
+		}
+		return values
+	default:
+		return nil
+	}
+}
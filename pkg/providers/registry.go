@@ -11,6 +11,7 @@ import (
 // Registry manages all available providers
 type Registry struct {
 	providers map[string]Provider
+	factories map[string]func() Provider // Constructors of providers that can be instantiated again
 	mutex     sync.RWMutex
 }
 
@@ -18,6 +19,7 @@ type Registry struct {
 func NewRegistry() *Registry {
 	return &Registry{
 		providers: make(map[string]Provider),
+		factories: make(map[string]func() Provider),
 	}
 }
 
@@ -39,6 +41,35 @@ func (r *Registry) Register(provider Provider) error {
 	return nil
 }
 
+// RegisterFactory registers the provider created by factory and keeps the factory, so that
+// NewInstance can create further instances, such as differently configured ones
+func (r *Registry) RegisterFactory(factory func() Provider) error {
+	provider := factory()
+	if err := r.Register(provider); err != nil {
+		return err
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.factories[provider.Name()] = factory
+	return nil
+}
+
+// NewInstance creates a new instance of a provider registered with RegisterFactory
+func (r *Registry) NewInstance(name string) (Provider, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	if _, exists := r.providers[name]; !exists {
+		return nil, fmt.Errorf("provider %s not found", name)
+	}
+	factory, exists := r.factories[name]
+	if !exists {
+		return nil, fmt.Errorf("provider %s cannot be instantiated", name)
+	}
+	return factory(), nil
+}
+
 // Get retrieves a provider by name
 func (r *Registry) Get(name string) (Provider, error) {
 	r.mutex.RLock()
@@ -89,6 +120,7 @@ func (r *Registry) Unregister(name string) error {
 	}
 
 	delete(r.providers, name)
+	delete(r.factories, name)
 	return nil
 }
 
@@ -0,0 +1,90 @@
+package providers
+
+import (
+	"fmt"
+	"net"
+)
+
+// ResourceSchema builds the JSON schema of a resource drawn as a single cell from the
+// label, position and size shared by such resources and the resource specific properties.
+// Resource specific properties replace the shared properties of the same name.
+func ResourceSchema(defaultLabel string, width, height float64, properties map[string]interface{}, required ...string) map[string]interface{} {
+	merged := map[string]interface{}{
+		"label": map[string]interface{}{
+			"type":        "string",
+			"description": "Display label",
+			"default":     defaultLabel,
+		},
+		"x": map[string]interface{}{
+			"type":        "number",
+			"description": "X position",
+			"default":     0,
+		},
+		"y": map[string]interface{}{
+			"type":        "number",
+			"description": "Y position",
+			"default":     0,
+		},
+		"width": map[string]interface{}{
+			"type":        "number",
+			"description": "Width",
+			"default":     width,
+			"minimum":     20,
+		},
+		"height": map[string]interface{}{
+			"type":        "number",
+			"description": "Height",
+			"default":     height,
+			"minimum":     20,
+		},
+	}
+	for name, property := range properties {
+		merged[name] = property
+	}
+
+	if required == nil {
+		required = []string{}
+	}
+
+	return map[string]interface{}{
+		"type":       "object",
+		"properties": merged,
+		"required":   required,
+	}
+}
+
+// ValidateCIDR validates that an optional string parameter is an IPv4 or IPv6 CIDR block,
+// which a JSON schema cannot express. Parameters of another type are left to ValidateParams.
+func ValidateCIDR(params map[string]interface{}, field string) error {
+	value, ok := params[field].(string)
+	if !ok {
+		return nil
+	}
+
+	if _, _, err := net.ParseCIDR(value); err != nil {
+		return &ValidationError{
+			Field:   field,
+			Message: fmt.Sprintf("invalid CIDR block %q", value),
+			Code:    "INVALID_FORMAT",
+		}
+	}
+	return nil
+}
+
+// ValidateIP validates that an optional string parameter is an IPv4 or IPv6 address,
+// which a JSON schema cannot express. Parameters of another type are left to ValidateParams.
+func ValidateIP(params map[string]interface{}, field string) error {
+	value, ok := params[field].(string)
+	if !ok {
+		return nil
+	}
+
+	if net.ParseIP(value) == nil {
+		return &ValidationError{
+			Field:   field,
+			Message: fmt.Sprintf("invalid IP address %q", value),
+			Code:    "INVALID_FORMAT",
+		}
+	}
+	return nil
+}
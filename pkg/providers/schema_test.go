@@ -0,0 +1,82 @@
+package providers
+
+import (
+	"testing"
+)
+
+func TestResourceSchema(t *testing.T) {
+	resourceSchema := ResourceSchema("Zone", 400, 200, map[string]interface{}{
+		"cidr":  map[string]interface{}{"type": "string"},
+		"label": map[string]interface{}{"type": "string", "description": "Zone name"},
+	}, "cidr")
+
+	properties := schemaProperties(resourceSchema)
+	for _, field := range []string{"label", "x", "y", "width", "height", "cidr"} {
+		if _, exists := properties[field]; !exists {
+			t.Errorf("Expected property %s in schema", field)
+		}
+	}
+	if properties["width"]["default"] != 400.0 || properties["height"]["default"] != 200.0 {
+		t.Errorf("Expected default size 400x200, got %vx%v", properties["width"]["default"], properties["height"]["default"])
+	}
+	if properties["label"]["description"] != "Zone name" {
+		t.Errorf("Expected the resource label property to replace the shared one, got %v", properties["label"])
+	}
+	if required := stringList(resourceSchema["required"]); len(required) != 1 || required[0] != "cidr" {
+		t.Errorf("Expected cidr to be required, got %v", required)
+	}
+
+	if err := ValidateParams(resourceSchema, map[string]interface{}{"cidr": "10.0.0.0/8", "width": 10}); err == nil {
+		t.Error("Expected a width below the minimum to be rejected")
+	}
+}
+
+func TestValidateCIDR(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  map[string]interface{}
+		wantErr bool
+	}{
+		{name: "IPv4 block", params: map[string]interface{}{"cidr": "10.0.0.0/16"}},
+		{name: "IPv6 block", params: map[string]interface{}{"cidr": "2001:db8::/32"}},
+		{name: "missing", params: map[string]interface{}{}},
+		{name: "wrong type left to the schema", params: map[string]interface{}{"cidr": 10}},
+		{name: "address without prefix", params: map[string]interface{}{"cidr": "10.0.0.0"}, wantErr: true},
+		{name: "prefix out of range", params: map[string]interface{}{"cidr": "10.0.0.0/33"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCIDR(tt.params, "cidr")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateCIDR() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if validationErr, ok := err.(*ValidationError); tt.wantErr && (!ok || validationErr.Field != "cidr" || validationErr.Code != "INVALID_FORMAT") {
+				t.Errorf("Expected INVALID_FORMAT error for cidr, got %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateIP(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  map[string]interface{}
+		wantErr bool
+	}{
+		{name: "IPv4 address", params: map[string]interface{}{"ip": "192.168.1.1"}},
+		{name: "IPv6 address", params: map[string]interface{}{"ip": "2001:db8::1"}},
+		{name: "missing", params: map[string]interface{}{}},
+		{name: "host name", params: map[string]interface{}{"ip": "router.example.com"}, wantErr: true},
+		{name: "CIDR block", params: map[string]interface{}{"ip": "10.0.0.1/24"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateIP(tt.params, "ip")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateIP() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,359 @@
+package providers
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// ValidationErrors collects every ValidationError found in a set of parameters.
+// errors.As finds the individual errors through Unwrap.
+type ValidationErrors []*ValidationError
+
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Unwrap returns the individual validation errors
+func (e ValidationErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, err := range e {
+		errs[i] = err
+	}
+	return errs
+}
+
+// ValidateParams validates resource parameters against the JSON schema of a resource
+// definition and returns every violation as ValidationErrors, or nil. Fields of nested
+// values are reported with paths such as "ports[0].port".
+//
+// The supported keywords are type, enum, const, minimum, maximum, exclusiveMinimum,
+// exclusiveMaximum, minLength, maxLength, pattern, properties, required,
+// additionalProperties, items, minItems and maxItems. Properties that are not
+// declared are accepted unless additionalProperties is false.
+func ValidateParams(resourceSchema map[string]interface{}, params map[string]interface{}) error {
+	v := &schemaValidator{}
+	v.validateObject("", resourceSchema, params)
+	if len(v.errors) == 0 {
+		return nil
+	}
+	return v.errors
+}
+
+// ApplyDefaults returns a copy of params completed with the defaults declared in the
+// JSON schema of a resource definition, including those of nested objects
+func ApplyDefaults(resourceSchema map[string]interface{}, params map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(params))
+	for name, value := range params {
+		result[name] = value
+	}
+
+	for name, property := range schemaProperties(resourceSchema) {
+		value, exists := result[name]
+		if !exists {
+			if defaultValue, hasDefault := property["default"]; hasDefault {
+				result[name] = defaultValue
+			}
+			continue
+		}
+		if nested, ok := value.(map[string]interface{}); ok && property["properties"] != nil {
+			result[name] = ApplyDefaults(property, nested)
+		}
+	}
+
+	return result
+}
+
+// schemaValidator accumulates the violations found while walking a schema
+type schemaValidator struct {
+	errors ValidationErrors
+}
+
+func (v *schemaValidator) fail(field, code, format string, args ...interface{}) {
+	v.errors = append(v.errors, &ValidationError{
+		Field:   field,
+		Message: fmt.Sprintf(format, args...),
+		Code:    code,
+	})
+}
+
+// validateObject validates the properties of an object value
+func (v *schemaValidator) validateObject(path string, objectSchema map[string]interface{}, object map[string]interface{}) {
+	properties := schemaProperties(objectSchema)
+
+	for _, name := range stringList(objectSchema["required"]) {
+		if _, exists := object[name]; !exists {
+			field := joinPath(path, name)
+			v.fail(field, "REQUIRED", "%s is required", field)
+		}
+	}
+
+	additional := objectSchema["additionalProperties"]
+	for _, name := range sortedKeys(object) {
+		field := joinPath(path, name)
+		if property, declared := properties[name]; declared {
+			v.validateValue(field, property, object[name])
+			continue
+		}
+
+		switch additional := additional.(type) {
+		case bool:
+			if !additional {
+				v.fail(field, "NOT_SUPPORTED", "%s is not a supported parameter", field)
+			}
+		case map[string]interface{}:
+			v.validateValue(field, additional, object[name])
+		}
+	}
+}
+
+// validateValue validates a value against its schema
+func (v *schemaValidator) validateValue(field string, valueSchema map[string]interface{}, value interface{}) {
+	if types := stringList(valueSchema["type"]); len(types) > 0 && !hasAnyType(value, types) {
+		v.fail(field, "INVALID_TYPE", "%s must be of type %s", field, strings.Join(types, " or "))
+		return // The remaining keywords assume the declared type
+	}
+
+	if allowed, ok := valueSchema["enum"]; ok && !containsValue(allowed, value) {
+		v.fail(field, "INVALID_ENUM", "invalid %s %v, must be one of %v", field, value, allowed)
+	}
+	if constant, ok := valueSchema["const"]; ok && !equalValues(constant, value) {
+		v.fail(field, "INVALID_ENUM", "%s must be %v", field, constant)
+	}
+
+	if str, ok := value.(string); ok {
+		v.validateString(field, valueSchema, str)
+	} else if number, ok := toFloat(value); ok {
+		v.validateNumber(field, valueSchema, number)
+	} else if object, ok := asObject(value); ok {
+		if valueSchema["properties"] != nil || valueSchema["required"] != nil || valueSchema["additionalProperties"] != nil {
+			v.validateObject(field, valueSchema, object)
+		}
+	} else if list, ok := asList(value); ok {
+		v.validateArray(field, valueSchema, list)
+	}
+}
+
+// validateString validates the length and pattern of a string
+func (v *schemaValidator) validateString(field string, valueSchema map[string]interface{}, value string) {
+	length := len([]rune(value))
+	if minLength, ok := toFloat(valueSchema["minLength"]); ok && float64(length) < minLength {
+		if minLength == 1 {
+			v.fail(field, "REQUIRED", "%s must not be empty", field)
+		} else {
+			v.fail(field, "OUT_OF_RANGE", "%s must be at least %v characters long", field, minLength)
+		}
+	}
+	if maxLength, ok := toFloat(valueSchema["maxLength"]); ok && float64(length) > maxLength {
+		v.fail(field, "OUT_OF_RANGE", "%s must be at most %v characters long", field, maxLength)
+	}
+
+	if pattern, ok := valueSchema["pattern"].(string); ok {
+		matched, err := regexp.MatchString(pattern, value)
+		switch {
+		case err != nil:
+			v.fail(field, "INVALID_SCHEMA", "invalid pattern %q for %s: %v", pattern, field, err)
+		case !matched:
+			v.fail(field, "INVALID_FORMAT", "invalid %s %q, must match %s", field, value, pattern)
+		}
+	}
+}
+
+// validateNumber validates the range of a number
+func (v *schemaValidator) validateNumber(field string, valueSchema map[string]interface{}, value float64) {
+	if minimum, ok := toFloat(valueSchema["minimum"]); ok && value < minimum {
+		v.fail(field, "OUT_OF_RANGE", "%s must be at least %v", field, minimum)
+	}
+	if maximum, ok := toFloat(valueSchema["maximum"]); ok && value > maximum {
+		v.fail(field, "OUT_OF_RANGE", "%s must be at most %v", field, maximum)
+	}
+	if minimum, ok := toFloat(valueSchema["exclusiveMinimum"]); ok && value <= minimum {
+		v.fail(field, "OUT_OF_RANGE", "%s must be greater than %v", field, minimum)
+	}
+	if maximum, ok := toFloat(valueSchema["exclusiveMaximum"]); ok && value >= maximum {
+		v.fail(field, "OUT_OF_RANGE", "%s must be less than %v", field, maximum)
+	}
+}
+
+// validateArray validates the length and items of an array
+func (v *schemaValidator) validateArray(field string, valueSchema map[string]interface{}, value []interface{}) {
+	if minItems, ok := toFloat(valueSchema["minItems"]); ok && float64(len(value)) < minItems {
+		v.fail(field, "OUT_OF_RANGE", "%s must have at least %v items", field, minItems)
+	}
+	if maxItems, ok := toFloat(valueSchema["maxItems"]); ok && float64(len(value)) > maxItems {
+		v.fail(field, "OUT_OF_RANGE", "%s must have at most %v items", field, maxItems)
+	}
+
+	if items, ok := valueSchema["items"].(map[string]interface{}); ok {
+		for i, item := range value {
+			v.validateValue(fmt.Sprintf("%s[%d]", field, i), items, item)
+		}
+	}
+}
+
+// hasAnyType reports whether a value has one of the given JSON schema types
+func hasAnyType(value interface{}, types []string) bool {
+	for _, valueType := range types {
+		if hasType(value, valueType) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasType reports whether a value has the given JSON schema type
+func hasType(value interface{}, valueType string) bool {
+	switch valueType {
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "number":
+		_, ok := toFloat(value)
+		return ok
+	case "integer":
+		number, ok := toFloat(value)
+		return ok && number == float64(int64(number))
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "array":
+		_, ok := asList(value)
+		return ok
+	case "object":
+		_, ok := asObject(value)
+		return ok
+	case "null":
+		return value == nil
+	default:
+		return true
+	}
+}
+
+// toFloat converts the numeric types produced by Go code and the YAML and JSON decoders to float64
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
+
+// asList returns the items of a slice of any element type, such as the []string
+// parameters of Go callers and the []interface{} of decoded parameters
+func asList(value interface{}) ([]interface{}, bool) {
+	if list, ok := value.([]interface{}); ok {
+		return list, true
+	}
+	reflected := reflect.ValueOf(value)
+	if reflected.Kind() != reflect.Slice {
+		return nil, false
+	}
+	list := make([]interface{}, reflected.Len())
+	for i := range list {
+		list[i] = reflected.Index(i).Interface()
+	}
+	return list, true
+}
+
+// asObject returns the entries of a map with string keys of any value type
+func asObject(value interface{}) (map[string]interface{}, bool) {
+	if object, ok := value.(map[string]interface{}); ok {
+		return object, true
+	}
+	reflected := reflect.ValueOf(value)
+	if reflected.Kind() != reflect.Map || reflected.Type().Key().Kind() != reflect.String {
+		return nil, false
+	}
+	object := make(map[string]interface{}, reflected.Len())
+	iter := reflected.MapRange()
+	for iter.Next() {
+		object[iter.Key().String()] = iter.Value().Interface()
+	}
+	return object, true
+}
+
+// containsValue reports whether an enum, written as []string or []interface{}, contains a value
+func containsValue(allowed interface{}, value interface{}) bool {
+	list, _ := asList(allowed)
+	for _, candidate := range list {
+		if equalValues(candidate, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// equalValues compares schema and parameter values, treating all numeric types alike
+func equalValues(a, b interface{}) bool {
+	if x, ok := toFloat(a); ok {
+		y, ok := toFloat(b)
+		return ok && x == y
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// schemaProperties returns the property schemas of an object schema
+func schemaProperties(objectSchema map[string]interface{}) map[string]map[string]interface{} {
+	properties := make(map[string]map[string]interface{})
+	declared, _ := objectSchema["properties"].(map[string]interface{})
+	for name, property := range declared {
+		if propertySchema, ok := property.(map[string]interface{}); ok {
+			properties[name] = propertySchema
+		}
+	}
+	return properties
+}
+
+// stringList returns a schema keyword that is a string or a list of strings, which are
+// []string in schemas built in Go and []interface{} in decoded schemas
+func stringList(value interface{}) []string {
+	switch list := value.(type) {
+	case string:
+		return []string{list}
+	case []string:
+		return list
+	case []interface{}:
+		strs := make([]string, len(list))
+		for i, item := range list {
+			strs[i] = fmt.Sprint(item)
+		}
+		return strs
+	default:
+		return nil
+	}
+}
+
+// joinPath returns the path of a property of the object at path
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// sortedKeys returns the keys of a map in order, so errors are reported deterministically
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
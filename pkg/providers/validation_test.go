@@ -0,0 +1,175 @@
+package providers
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+var testSchema = map[string]interface{}{
+	"type": "object",
+	"properties": map[string]interface{}{
+		"label": map[string]interface{}{
+			"type":      "string",
+			"minLength": 1,
+		},
+		"shape": map[string]interface{}{
+			"type":    "string",
+			"enum":    []string{"rectangle", "ellipse"},
+			"default": "rectangle",
+		},
+		"width": map[string]interface{}{
+			"type":    "number",
+			"minimum": 10,
+			"maximum": 500,
+			"default": 120,
+		},
+		"replicas": map[string]interface{}{
+			"type":             "integer",
+			"exclusiveMinimum": 0,
+		},
+		"name": map[string]interface{}{
+			"type":    "string",
+			"pattern": "^[a-z][a-z0-9-]*$",
+		},
+		"ports": map[string]interface{}{
+			"type":     "array",
+			"maxItems": 2,
+			"items": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"port": map[string]interface{}{"type": "integer", "minimum": 1, "maximum": 65535},
+					"protocol": map[string]interface{}{
+						"type":    "string",
+						"enum":    []interface{}{"TCP", "UDP"},
+						"default": "TCP",
+					},
+				},
+				"required": []interface{}{"port"},
+			},
+		},
+		"metadata": map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"owner": map[string]interface{}{"type": "string", "default": "platform"},
+			},
+			"additionalProperties": false,
+		},
+	},
+	"required": []string{"label"},
+}
+
+func TestValidateParams_Valid(t *testing.T) {
+	params := map[string]interface{}{
+		"label":    "Web",
+		"shape":    "ellipse",
+		"width":    200,
+		"replicas": 3.0,
+		"name":     "web-1",
+		"ports":    []interface{}{map[string]interface{}{"port": 443, "protocol": "TCP"}},
+		"metadata": map[string]interface{}{"owner": "web"},
+		"extra":    true, // Undeclared parameters are accepted
+	}
+
+	if err := ValidateParams(testSchema, params); err != nil {
+		t.Errorf("ValidateParams() error = %v", err)
+	}
+}
+
+func TestValidateParams_AllErrors(t *testing.T) {
+	params := map[string]interface{}{
+		"shape":    "hexagon",
+		"width":    5.5,
+		"replicas": 1.5,
+		"name":     "Web",
+		"ports": []interface{}{
+			map[string]interface{}{"port": 70000},
+			map[string]interface{}{"protocol": "ICMP"},
+			"8080",
+		},
+		"metadata": map[string]interface{}{"team": "web"},
+	}
+
+	err := ValidateParams(testSchema, params)
+
+	var validationErrs ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		t.Fatalf("Expected ValidationErrors, got %v", err)
+	}
+
+	type violation struct{ field, code string }
+	var got []violation
+	for _, validationErr := range validationErrs {
+		got = append(got, violation{validationErr.Field, validationErr.Code})
+	}
+	want := []violation{
+		{"label", "REQUIRED"},
+		{"metadata.team", "NOT_SUPPORTED"},
+		{"name", "INVALID_FORMAT"},
+		{"ports", "OUT_OF_RANGE"},
+		{"ports[0].port", "OUT_OF_RANGE"},
+		{"ports[1].port", "REQUIRED"},
+		{"ports[1].protocol", "INVALID_ENUM"},
+		{"ports[2]", "INVALID_TYPE"},
+		{"replicas", "INVALID_TYPE"},
+		{"shape", "INVALID_ENUM"},
+		{"width", "OUT_OF_RANGE"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected violations\n%v\ngot\n%v", want, got)
+	}
+
+	// The individual errors remain reachable with errors.As
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) || validationErr.Field != "label" {
+		t.Errorf("Expected the first ValidationError, got %v", validationErr)
+	}
+}
+
+func TestValidateParams_Details(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  map[string]interface{}
+		wantErr string
+	}{
+		{"empty string", map[string]interface{}{"label": ""}, "label: label must not be empty"},
+		{"type", map[string]interface{}{"label": 42}, "label: label must be of type string"},
+		{"maximum", map[string]interface{}{"label": "a", "width": 501}, "width: width must be at most 500"},
+		{"exclusive minimum", map[string]interface{}{"label": "a", "replicas": 0}, "replicas: replicas must be greater than 0"},
+		{"enum", map[string]interface{}{"label": "a", "shape": "cloud"}, "shape: invalid shape cloud, must be one of [rectangle ellipse]"},
+		{"typed slice", map[string]interface{}{"label": "a", "ports": []string{"80"}}, "ports[0]: ports[0] must be of type object"},
+		{"several", map[string]interface{}{"width": 1}, "label: label is required; width: width must be at least 10"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateParams(testSchema, tt.params)
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("Expected error %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestApplyDefaults(t *testing.T) {
+	params := map[string]interface{}{
+		"label":    "Web",
+		"width":    200,
+		"metadata": map[string]interface{}{},
+	}
+
+	got := ApplyDefaults(testSchema, params)
+	want := map[string]interface{}{
+		"label":    "Web",
+		"shape":    "rectangle",
+		"width":    200,
+		"metadata": map[string]interface{}{"owner": "platform"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+
+	if len(params) != 3 || len(params["metadata"].(map[string]interface{})) != 0 {
+		t.Errorf("Expected the parameters to be left unchanged, got %v", params)
+	}
+}
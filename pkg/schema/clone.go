@@ -0,0 +1,73 @@
+package schema
+
+// DeepCopy returns a copy of the element that shares no slices or maps with the original
+func (e *Element) DeepCopy() Element {
+	clone := *e
+
+	if e.Parameters != nil {
+		clone.Parameters = copyValueMap(e.Parameters)
+	}
+
+	clone.Properties.Custom = nil
+	if e.Properties.Custom != nil {
+		clone.Properties.Custom = copyValueMap(e.Properties.Custom)
+	}
+
+	if e.Properties.Waypoints != nil {
+		clone.Properties.Waypoints = append([]Waypoint(nil), e.Properties.Waypoints...)
+	}
+
+	if e.Style.Custom != nil {
+		clone.Style.Custom = make(map[string]string, len(e.Style.Custom))
+		for key, value := range e.Style.Custom {
+			clone.Style.Custom[key] = value
+		}
+	}
+
+	if e.Tags != nil {
+		clone.Tags = append([]string(nil), e.Tags...)
+	}
+
+	if e.Show != nil {
+		clone.Show = append([]string(nil), e.Show...)
+	}
+
+	if e.Children != nil {
+		clone.Children = make([]Element, len(e.Children))
+		for i := range e.Children {
+			clone.Children[i] = e.Children[i].DeepCopy()
+		}
+	}
+
+	if e.Nesting.ChildDefaults != nil {
+		childDefaults := e.Nesting.ChildDefaults.DeepCopy()
+		clone.Nesting.ChildDefaults = &childDefaults
+	}
+
+	return clone
+}
+
+// copyValueMap copies a parameter map, recursing into nested maps and slices
+func copyValueMap(values map[string]interface{}) map[string]interface{} {
+	clone := make(map[string]interface{}, len(values))
+	for key, value := range values {
+		clone[key] = copyValue(value)
+	}
+	return clone
+}
+
+// copyValue copies a YAML value, recursing into nested maps and slices
+func copyValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return copyValueMap(v)
+	case []interface{}:
+		clone := make([]interface{}, len(v))
+		for i := range v {
+			clone[i] = copyValue(v[i])
+		}
+		return clone
+	default:
+		return v
+	}
+}
@@ -9,6 +9,8 @@ type DiagramConfig struct {
 	Providers     []ProviderRef     `yaml:"providers,omitempty" json:"providers,omitempty"`         // Provider declarations
 	Templates     []TemplateRef     `yaml:"templates,omitempty" json:"templates,omitempty"`         // Individual template declarations
 	TemplateHives []TemplateHiveRef `yaml:"templateHives,omitempty" json:"templateHives,omitempty"` // Template hive declarations
+	Include       []string          `yaml:"include,omitempty" json:"include,omitempty"`             // Other configuration files merged into this one
+	Model         []Element         `yaml:"model,omitempty" json:"model,omitempty"`                 // Reusable elements referenced from pages by ID
 	Diagram       Diagram           `yaml:"diagram" json:"diagram"`
 }
 
@@ -47,14 +49,34 @@ type ProviderRef struct {
 	Path    string `yaml:"path,omitempty" json:"path,omitempty"`       // Filesystem path for local providers
 	Type    string `yaml:"type,omitempty" json:"type,omitempty"`       // "builtin", "registry" (default), or "custom"
 	Version string `yaml:"version,omitempty" json:"version,omitempty"` // Provider version constraint
+
+	Settings map[string]interface{} `yaml:"settings,omitempty" json:"settings,omitempty"` // Settings passed to the provider's Configure hook
 }
 
 // Diagram represents the main diagram structure
 type Diagram struct {
 	Pages      []Page            `yaml:"pages" json:"pages"`
+	Views      []View            `yaml:"views,omitempty" json:"views,omitempty"`
 	Properties DiagramProperties `yaml:"properties,omitempty" json:"properties,omitempty"`
 }
 
+// View defines a filtered rendering of the diagram's pages based on element tags
+type View struct {
+	ID         string   `yaml:"id" json:"id"`
+	Name       string   `yaml:"name,omitempty" json:"name,omitempty"`
+	Include    string   `yaml:"include,omitempty" json:"include,omitempty"`       // Tag expression elements must match (e.g. "network && !internal")
+	Exclude    string   `yaml:"exclude,omitempty" json:"exclude,omitempty"`       // Tag expression for elements to remove
+	Pages      []string `yaml:"pages,omitempty" json:"pages,omitempty"`           // Page IDs to render (default: all pages)
+	Output     string   `yaml:"output,omitempty" json:"output,omitempty"`         // Separate output file (default: additional pages in the main output)
+	Connectors string   `yaml:"connectors,omitempty" json:"connectors,omitempty"` // "redirect" (default) or "drop" for connectors touching removed elements
+}
+
+// Connector handling constants for views
+const (
+	ViewConnectorsRedirect = "redirect" // Redirect to the nearest visible ancestor
+	ViewConnectorsDrop     = "drop"     // Drop the connector
+)
+
 // DiagramProperties contains global diagram settings
 type DiagramProperties struct {
 	Grid       GridSettings       `yaml:"grid,omitempty" json:"grid,omitempty"`
@@ -82,6 +104,16 @@ type Page struct {
 	Layers     []Layer        `yaml:"layers,omitempty" json:"layers,omitempty"`
 	Elements   []Element      `yaml:"elements,omitempty" json:"elements,omitempty"`
 	Properties PageProperties `yaml:"properties,omitempty" json:"properties,omitempty"`
+
+	// Detail collapses containers nested deeper than this level (0 renders every level)
+	Detail int `yaml:"detail,omitempty" json:"detail,omitempty"`
+
+	// DrillDown lists element types, templates, resources or model references whose
+	// containers are moved to detail pages linked from this page
+	DrillDown []string `yaml:"drillDown,omitempty" json:"drillDown,omitempty"`
+
+	// SourceFile is the configuration file the page was loaded from (set by the loader)
+	SourceFile string `yaml:"-" json:"-"`
 }
 
 // PageProperties contains page-specific settings
@@ -112,9 +144,23 @@ type Element struct {
 	Style      Style                  `yaml:"style,omitempty" json:"style,omitempty"`
 	Children   []Element              `yaml:"children,omitempty" json:"children,omitempty"`
 	Tags       []string               `yaml:"tags,omitempty" json:"tags,omitempty"`
+	Include    string                 `yaml:"include,omitempty" json:"include,omitempty"` // Element fragment file placed at this element's position
+
+	// Model references
+	Ref          string   `yaml:"ref,omitempty" json:"ref,omitempty"`                   // ID of the model element this element instantiates
+	Show         []string `yaml:"show,omitempty" json:"show,omitempty"`                 // IDs or names of model children to show (default: all)
+	HideChildren bool     `yaml:"hideChildren,omitempty" json:"hideChildren,omitempty"` // Hide all children of the model element
 
 	// Nesting configuration
 	Nesting NestingConfig `yaml:"nesting,omitempty" json:"nesting,omitempty"`
+
+	// SourceFile is the configuration file the element was loaded from (set by the loader)
+	SourceFile string `yaml:"-" json:"-"`
+}
+
+// ElementFragment is the content of a file included by an element's include field
+type ElementFragment struct {
+	Elements []Element `yaml:"elements" json:"elements"`
 }
 
 // ElementType defines the type of element
@@ -186,6 +232,8 @@ type ElementProperties struct {
 	// Content
 	Label string `yaml:"label,omitempty" json:"label,omitempty"`
 	Value string `yaml:"value,omitempty" json:"value,omitempty"`
+	Link  string `yaml:"link,omitempty" json:"link,omitempty"`   // URL or draw.io page link (data:page/id,<page>)
+	Badge string `yaml:"badge,omitempty" json:"badge,omitempty"` // Short text shown in a marker at the top-right corner (e.g. "+3")
 
 	// Shape-specific
 	Shape     string `yaml:"shape,omitempty" json:"shape,omitempty"`
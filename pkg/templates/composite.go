@@ -0,0 +1,84 @@
+package templates
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// mergeGeneratedChildren combines the children generated by a composite provider resource
+// with the children declared on its element. A declared child whose ID or name matches a
+// generated child overrides it, and an ID such as "tiers/web" addresses a generated child
+// by its path of IDs. Other declared children are added after the generated ones.
+func (tp *TemplateProcessor) mergeGeneratedChildren(generated, declared []schema.Element) ([]schema.Element, error) {
+	children := append([]schema.Element(nil), generated...)
+
+	for i := range declared {
+		child := &declared[i]
+		head, rest, isPath := strings.Cut(child.ID, "/")
+
+		var target int
+		if isPath {
+			target = findChild(children, head, "")
+		} else {
+			target = findChild(children, child.ID, child.Name)
+		}
+
+		switch {
+		case target < 0 && isPath:
+			return nil, fmt.Errorf("no generated element %s for %s", head, child.ID)
+		case target < 0:
+			children = append(children, *child)
+		case isPath:
+			// Descend into the generated child with the rest of the path
+			nested := *child
+			nested.ID = rest
+			merged, err := tp.mergeGeneratedChildren(children[target].Children, []schema.Element{nested})
+			if err != nil {
+				return nil, err
+			}
+			children[target].Children = merged
+		default:
+			if err := tp.overrideGeneratedChild(&children[target], child); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return children, nil
+}
+
+// overrideGeneratedChild applies a declared child to the generated child it matches. A
+// declared child with its own type, template or resource replaces the generated one;
+// otherwise its name, geometry, style, parameters and tags override those of the
+// generated child and its children are merged with the generated children.
+func (tp *TemplateProcessor) overrideGeneratedChild(generated *schema.Element, declared *schema.Element) error {
+	if declared.Type != "" || declared.Template != "" || declared.Resource != "" {
+		*generated = *declared
+		return nil
+	}
+
+	if declared.Name != "" {
+		generated.Name = declared.Name
+	}
+	tp.applyOverrides(generated, declared)
+
+	children, err := tp.mergeGeneratedChildren(generated.Children, declared.Children)
+	if err != nil {
+		return fmt.Errorf("invalid children of %s: %w", tp.getElementDisplayName(generated), err)
+	}
+	generated.Children = children
+	return nil
+}
+
+// findChild returns the index of the child with the given ID, or with the given name when
+// id is empty, or -1
+func findChild(children []schema.Element, id, name string) int {
+	for i := range children {
+		if id != "" && children[i].ID == id || id == "" && name != "" && children[i].Name == name {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,136 @@
+package templates
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/drawio"
+	"github.com/LederWorks/hippodamus/pkg/schema"
+	"github.com/LederWorks/hippodamus/providers/core"
+)
+
+func TestProcessDiagram_CompositeResources(t *testing.T) {
+	config := &schema.DiagramConfig{
+		Diagram: schema.Diagram{Pages: []schema.Page{{
+			ID:   "apps",
+			Name: "Applications",
+			Elements: []schema.Element{{
+				ID:         "crm",
+				Name:       "CRM",
+				Resource:   "core-three-tier-app",
+				Parameters: map[string]interface{}{"label": "CRM"},
+				Children: []schema.Element{
+					{ID: "db", Properties: schema.ElementProperties{Label: "Customers"}, Style: schema.Style{FillColor: "#FFCDD2"}},
+					{ID: "web", Resource: "core.shape", Parameters: map[string]interface{}{"label": "Portal", "shape": "cloud"}},
+					{ID: "db/replica", Type: schema.ElementTypeShape, Properties: schema.ElementProperties{Label: "Replica"}},
+					{ID: "cache", Resource: "core-shape", Parameters: map[string]interface{}{"label": "Cache"}},
+				},
+			}},
+		}}},
+	}
+	if err := newTestProcessor(t, core.NewCoreProvider()).ProcessDiagram(config); err != nil {
+		t.Fatalf("ProcessDiagram() error = %v", err)
+	}
+
+	crm := config.Diagram.Pages[0].Elements[0]
+	var ids []string
+	for _, child := range crm.Children {
+		ids = append(ids, child.ID)
+	}
+	if strings.Join(ids, ",") != "web,app,db,web-to-app,app-to-db,cache" {
+		t.Fatalf("Expected the generated children followed by cache, got %v", ids)
+	}
+
+	web, db, cache := crm.Children[0], crm.Children[2], crm.Children[5]
+	if web.Properties.Label != "Portal" || web.Properties.Shape != "cloud" {
+		t.Errorf("Expected web to be replaced by the Portal cloud, got %q %s", web.Properties.Label, web.Properties.Shape)
+	}
+	if db.Properties.Label != "Customers" || db.Style.FillColor != "#FFCDD2" || db.Properties.Shape != "cylinder" {
+		t.Errorf("Expected db to keep its cylinder with the overridden label and fill, got %q %s %s", db.Properties.Label, db.Style.FillColor, db.Properties.Shape)
+	}
+	if len(db.Children) != 1 || db.Children[0].Properties.Label != "Replica" {
+		t.Errorf("Expected db/replica to be added to db, got %+v", db.Children)
+	}
+	if cache.Properties.Label != "Cache" {
+		t.Errorf("Expected the declared cache resource to be generated, got %q", cache.Properties.Label)
+	}
+}
+
+func TestProcessDiagram_CompositeConnectors(t *testing.T) {
+	config := &schema.DiagramConfig{
+		Diagram: schema.Diagram{Pages: []schema.Page{{
+			ID:   "apps",
+			Name: "Applications",
+			Elements: []schema.Element{
+				{ID: "shop", Name: "Shop", Resource: "core-three-tier-app", Parameters: map[string]interface{}{"label": "Shop"}},
+				{ID: "crm", Name: "CRM", Resource: "core-three-tier-app", Parameters: map[string]interface{}{"label": "CRM"}},
+				{ID: "sync", Name: "Sync", Type: schema.ElementTypeConnector, Properties: schema.ElementProperties{Source: "crm/app", Target: "shop/db"}},
+			},
+		}}},
+	}
+	if err := newTestProcessor(t, core.NewCoreProvider()).ProcessDiagram(config); err != nil {
+		t.Fatalf("ProcessDiagram() error = %v", err)
+	}
+
+	document, err := drawio.NewGenerator().Generate(config)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	edges := make(map[string]string)
+	for _, cell := range document.Diagram[0].GraphModel.Root.Cells {
+		if cell.Edge == "1" {
+			edges[cell.ID] = cell.Source + " -> " + cell.Target
+		}
+	}
+
+	// Internal connectors stay within their application although both use the same tier IDs
+	expected := map[string]string{
+		"apps/shop/app-to-db": "apps/shop/app -> apps/shop/db",
+		"apps/crm/app-to-db":  "apps/crm/app -> apps/crm/db",
+		"apps/sync":           "apps/crm/app -> apps/shop/db",
+	}
+	for id, want := range expected {
+		if edges[id] != want {
+			t.Errorf("Expected %s to connect %s, got %q", id, want, edges[id])
+		}
+	}
+}
+
+func TestProcessDiagram_CompositeOverrideErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		children []schema.Element
+		wantErr  string
+	}{
+		{
+			name:     "unknown generated element",
+			children: []schema.Element{{ID: "queue/worker", Type: schema.ElementTypeShape}},
+			wantErr:  "invalid children of element CRM: no generated element queue for queue/worker",
+		},
+		{
+			name:     "unknown nested element",
+			children: []schema.Element{{ID: "db/replica/disk", Type: schema.ElementTypeShape}},
+			wantErr:  "invalid children of element CRM: no generated element replica for replica/disk",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &schema.DiagramConfig{
+				Diagram: schema.Diagram{Pages: []schema.Page{{
+					ID:   "apps",
+					Name: "Applications",
+					Elements: []schema.Element{
+						{ID: "crm", Name: "CRM", Resource: "core-three-tier-app", Children: tt.children},
+					},
+				}}},
+			}
+
+			err := newTestProcessor(t, core.NewCoreProvider()).ProcessDiagram(config)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
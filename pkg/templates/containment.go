@@ -0,0 +1,118 @@
+package templates
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// resourceRef identifies the resource of an element by provider and resource type
+type resourceRef struct {
+	provider     string
+	resourceType string
+}
+
+// String returns the resource in the provider.resource form
+func (r resourceRef) String() string {
+	return r.provider + "." + r.resourceType
+}
+
+// validateContainment enforces the containment rules declared on the definition of a resource
+// and on the definition of the resource it is placed in
+func (tp *TemplateProcessor) validateContainment(providerName, resourceType string, ancestors []*schema.Element) error {
+	resource := resourceRef{provider: providerName, resourceType: resourceType}
+
+	// The direct parent, when it is a provider resource
+	var parent *resourceRef
+	if len(ancestors) > 0 {
+		if parents := tp.ancestorResources(ancestors[:1]); len(parents) > 0 {
+			parent = &parents[0]
+		}
+	}
+
+	definition, _ := tp.resourceDefinition(providerName, resourceType)
+
+	if len(definition.AllowedParents) > 0 {
+		allowed := qualifyResources(providerName, definition.AllowedParents)
+		switch {
+		case parent == nil && len(ancestors) == 0:
+			return fmt.Errorf("resource %s must be placed in %s, not at the top level", resource, strings.Join(allowed, " or "))
+		case parent == nil:
+			return fmt.Errorf("resource %s must be placed in %s, not in element %s", resource, strings.Join(allowed, " or "), tp.getElementDisplayName(ancestors[0]))
+		case !matchesAny(providerName, definition.AllowedParents, *parent):
+			return fmt.Errorf("resource %s cannot be placed in %s, allowed parents: %s", resource, parent, strings.Join(allowed, ", "))
+		}
+	}
+
+	if parent != nil {
+		parentDefinition, _ := tp.resourceDefinition(parent.provider, parent.resourceType)
+		switch {
+		case parentDefinition.AllowedChildren == nil:
+		case len(parentDefinition.AllowedChildren) == 0:
+			return fmt.Errorf("resource %s does not accept %s as a child, it cannot contain resources", parent, resource)
+		case !matchesAny(parent.provider, parentDefinition.AllowedChildren, resource):
+			return fmt.Errorf("resource %s does not accept %s as a child, allowed children: %s", parent, resource, strings.Join(qualifyResources(parent.provider, parentDefinition.AllowedChildren), ", "))
+		}
+	}
+
+	for _, required := range definition.RequiredAncestors {
+		found := false
+		for _, ancestor := range tp.ancestorResources(ancestors) {
+			if matchesAny(providerName, []string{required}, ancestor) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("resource %s must be placed within %s", resource, qualifyResources(providerName, []string{required})[0])
+		}
+	}
+
+	return nil
+}
+
+// ancestorResources returns the resources of the ancestors that are provider resources, the
+// closest first
+func (tp *TemplateProcessor) ancestorResources(ancestors []*schema.Element) []resourceRef {
+	var resources []resourceRef
+	for _, ancestor := range ancestors {
+		if ancestor.Resource == "" {
+			continue
+		}
+		providerName, resourceType, err := tp.parseProviderResource(ancestor.Resource)
+		if err != nil {
+			continue
+		}
+		resources = append(resources, resourceRef{provider: providerName, resourceType: resourceType})
+	}
+	return resources
+}
+
+// matchesAny reports whether a resource matches one of the entries of a containment rule
+// declared by a resource of the provider owner
+func matchesAny(owner string, entries []string, resource resourceRef) bool {
+	for _, entry := range entries {
+		providerName, resourceType, explicit := strings.Cut(entry, ".")
+		if !explicit {
+			providerName, resourceType = owner, entry
+		}
+		if providerName == resource.provider && resourceType == resource.resourceType {
+			return true
+		}
+	}
+	return false
+}
+
+// qualifyResources returns the entries of a containment rule in the provider.resource form
+func qualifyResources(owner string, entries []string) []string {
+	qualified := make([]string, len(entries))
+	for i, entry := range entries {
+		if strings.Contains(entry, ".") {
+			qualified[i] = entry
+		} else {
+			qualified[i] = owner + "." + entry
+		}
+	}
+	return qualified
+}
@@ -0,0 +1,241 @@
+package templates
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers"
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// resourceElement returns an element of the resource with the given children
+func resourceElement(id, resource string, children ...schema.Element) schema.Element {
+	return schema.Element{ID: id, Name: id, Resource: resource, Children: children}
+}
+
+func TestProcessDiagram_Containment(t *testing.T) {
+	group := func(children ...schema.Element) schema.Element {
+		return schema.Element{ID: "group", Name: "Group", Type: schema.ElementTypeShape, Children: children}
+	}
+
+	tests := []struct {
+		name     string
+		elements []schema.Element
+		wantErr  string
+	}{
+		{
+			name: "allowed placements",
+			elements: []schema.Element{
+				resourceElement("vpc", "net.vpc",
+					resourceElement("zone", "net.zone", resourceElement("subnet", "net.subnet")),
+					resourceElement("label", "doc.label"),
+				),
+				resourceElement("text", "doc.text", schema.Element{ID: "plain", Type: schema.ElementTypeShape}),
+			},
+		},
+		{
+			name:     "parent at the top level",
+			elements: []schema.Element{resourceElement("subnet", "net.subnet")},
+			wantErr:  "resource net.subnet must be placed in net.vpc or net.zone, not at the top level",
+		},
+		{
+			name:     "parent without a resource",
+			elements: []schema.Element{resourceElement("vpc", "net.vpc", group(resourceElement("subnet", "net.subnet")))},
+			wantErr:  "resource net.subnet must be placed in net.vpc or net.zone, not in element Group",
+		},
+		{
+			name:     "missing ancestor",
+			elements: []schema.Element{resourceElement("zone", "net.zone", resourceElement("subnet", "net.subnet"))},
+			wantErr:  "resource net.subnet must be placed within net.vpc",
+		},
+		{
+			name:     "child not allowed",
+			elements: []schema.Element{resourceElement("vpc", "net.vpc", resourceElement("text", "doc.text"))},
+			wantErr:  "resource net.vpc does not accept doc.text as a child, allowed children: net.zone, net.subnet, doc.label",
+		},
+		{
+			name:     "no children allowed",
+			elements: []schema.Element{resourceElement("text", "doc.text", resourceElement("vpc", "net.vpc"))},
+			wantErr:  "resource doc.text does not accept net.vpc as a child, it cannot contain resources",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tp := newTestProcessor(t,
+				&stubProvider{
+					name:          "net",
+					resourceTypes: []string{"vpc", "zone", "subnet"},
+					definitions: map[string]providers.ResourceDefinition{
+						"vpc":    {Type: "vpc", AllowedChildren: []string{"zone", "subnet", "doc.label"}},
+						"subnet": {Type: "subnet", AllowedParents: []string{"vpc", "zone"}, RequiredAncestors: []string{"vpc"}},
+					},
+				},
+				&stubProvider{
+					name:          "doc",
+					resourceTypes: []string{"text", "label"},
+					definitions: map[string]providers.ResourceDefinition{
+						"text": {Type: "text", AllowedChildren: []string{}},
+					},
+				},
+			)
+			config := &schema.DiagramConfig{
+				Diagram: schema.Diagram{Pages: []schema.Page{{ID: "page", Name: "Page", Elements: tt.elements}}},
+			}
+
+			err := tp.ProcessDiagram(config)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("ProcessDiagram() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestProcessDiagram_ConnectionRules(t *testing.T) {
+	flow := func(id, source, target string) schema.Element {
+		return schema.Element{ID: id, Resource: "flow.sequence", Parameters: map[string]interface{}{"source": source, "target": target}}
+	}
+
+	tests := []struct {
+		name     string
+		elements []schema.Element
+		wantErr  string
+	}{
+		{
+			name: "allowed endpoints",
+			elements: []schema.Element{
+				resourceElement("start", "flow.start"),
+				resourceElement("task", "flow.task"),
+				resourceElement("end", "flow.end"),
+				{ID: "note", Name: "Note", Type: schema.ElementTypeShape},
+				flow("f1", "start", "task"),
+				flow("f2", "task", "end"),
+				flow("f3", "note", "task"),
+			},
+		},
+		{
+			name: "disallowed source",
+			elements: []schema.Element{
+				resourceElement("end", "flow.end"),
+				resourceElement("task", "flow.task"),
+				flow("f1", "end", "task"),
+			},
+			wantErr: "resource flow.sequence cannot start at flow.end",
+		},
+		{
+			name: "disallowed target of another provider",
+			elements: []schema.Element{
+				resourceElement("task", "flow.task"),
+				resourceElement("text", "doc.text"),
+				flow("f1", "task", "text"),
+			},
+			wantErr: "resource flow.sequence cannot end at doc.text",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tp := newTestProcessor(t,
+				&stubProvider{
+					name:          "flow",
+					resourceTypes: []string{"start", "task", "end", "sequence"},
+					definitions: map[string]providers.ResourceDefinition{
+						"sequence": {Type: "sequence", DisallowedSources: []string{"end"}, DisallowedTargets: []string{"start", "doc.text"}},
+					},
+				},
+				&stubProvider{name: "doc", resourceTypes: []string{"text"}},
+			)
+			config := &schema.DiagramConfig{
+				Diagram: schema.Diagram{Pages: []schema.Page{{ID: "page", Name: "Page", Elements: tt.elements}}},
+			}
+
+			err := tp.ProcessDiagram(config)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("ProcessDiagram() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestProcessDiagram_SiblingRules(t *testing.T) {
+	zone := func(id, cidr string) schema.Element {
+		return schema.Element{ID: id, Name: id, Resource: "net.zone", Parameters: map[string]interface{}{"cidr": cidr}}
+	}
+
+	tests := []struct {
+		name     string
+		elements []schema.Element
+		wantErr  string
+	}{
+		{
+			name:     "disjoint blocks",
+			elements: []schema.Element{zone("servers", "10.0.1.0/24"), zone("clients", "10.0.2.0/24"), zone("v6", "2001:db8::/32")},
+		},
+		{
+			name: "overlapping blocks in different parents",
+			elements: []schema.Element{
+				resourceElement("a", "net.vpc", zone("servers", "10.0.1.0/24")),
+				resourceElement("b", "net.vpc", zone("clients", "10.0.0.0/16")),
+			},
+		},
+		{
+			name: "overlapping block of another resource",
+			elements: []schema.Element{
+				zone("servers", "10.0.1.0/24"),
+				{ID: "vpc", Resource: "net.vpc", Parameters: map[string]interface{}{"cidr": "10.0.0.0/8"}},
+			},
+		},
+		{
+			name:     "nested block",
+			elements: []schema.Element{zone("servers", "10.0.1.0/24"), zone("invalid", "10.0.0.0/33"), zone("clients", "10.0.0.0/16")},
+			wantErr:  "invalid net.zone resources: cidr: CIDR block 10.0.0.0/16 of clients overlaps 10.0.1.0/24 of servers",
+		},
+		{
+			name: "overlapping blocks in a parent",
+			elements: []schema.Element{
+				resourceElement("vpc", "net.vpc", zone("servers", "10.0.1.0/24"), zone("backup", "10.0.1.0/24")),
+			},
+			wantErr: "invalid net.zone resources: cidr: CIDR block 10.0.1.0/24 of backup overlaps 10.0.1.0/24 of servers",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tp := newTestProcessor(t,
+				&stubProvider{
+					name:          "net",
+					resourceTypes: []string{"vpc", "zone"},
+					definitions: map[string]providers.ResourceDefinition{
+						"zone": {Type: "zone", DisjointCIDRs: []string{"cidr"}},
+					},
+				},
+			)
+			config := &schema.DiagramConfig{
+				Diagram: schema.Diagram{Pages: []schema.Page{{ID: "page", Name: "Page", Elements: tt.elements}}},
+			}
+
+			err := tp.ProcessDiagram(config)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("ProcessDiagram() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,199 @@
+package templates
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/LederWorks/hippodamus/pkg/providers"
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// ResourceError reports a provider resource that could not be generated for an element.
+// It wraps the error of the provider, so errors.As still finds a ProviderError or the
+// ValidationErrors of the parameters.
+type ResourceError struct {
+	Path     string         // Element path from the page, such as "network/vpc/subnet-a"
+	Resource string         // Resource in the provider.resource form
+	Fields   []FieldProblem // Rejected parameters when the provider returned validation errors
+	Err      error          // Error returned by the provider
+}
+
+// FieldProblem describes a rejected parameter with its value and the values the resource
+// schema allows for it
+type FieldProblem struct {
+	Field   string
+	Code    string
+	Message string
+	Value   interface{}   // Value of the parameter, nil when it is missing
+	Set     bool          // Whether the parameter was given
+	Allowed []interface{} // Values of the schema enum, nil when the schema has none
+}
+
+// Error leaves out the path, which is completed after the wrapping errors have been formatted
+// and whose elements those errors already name
+func (e *ResourceError) Error() string {
+	return fmt.Sprintf("invalid resource %s: %v", e.Resource, e.Err)
+}
+
+// Unwrap returns the error of the provider
+func (e *ResourceError) Unwrap() error {
+	return e.Err
+}
+
+// Details returns a multi-line description of the error with one entry per rejected
+// parameter, for command line output
+func (e *ResourceError) Details() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "element: %s\n", e.Path)
+	fmt.Fprintf(&b, "resource: %s\n", e.Resource)
+
+	var providerErr *providers.ProviderError
+	if len(e.Fields) == 0 && errors.As(e.Err, &providerErr) {
+		fmt.Fprintf(&b, "error: %s (%s)\n", providerErr.Message, providerErr.Code)
+		return b.String()
+	}
+	if len(e.Fields) == 0 {
+		fmt.Fprintf(&b, "error: %v\n", e.Err)
+		return b.String()
+	}
+
+	for _, field := range e.Fields {
+		fmt.Fprintf(&b, "  %s: %s (%s)\n", field.Field, field.Message, field.Code)
+		if field.Set {
+			fmt.Fprintf(&b, "    value: %s\n", formatValue(field.Value))
+		} else {
+			b.WriteString("    value: (not set)\n")
+		}
+		if len(field.Allowed) > 0 {
+			allowed := make([]string, len(field.Allowed))
+			for i, value := range field.Allowed {
+				allowed[i] = formatValue(value)
+			}
+			fmt.Fprintf(&b, "    allowed: %s\n", strings.Join(allowed, ", "))
+		}
+	}
+	return b.String()
+}
+
+// newResourceError builds the ResourceError of an element from the error of its provider,
+// looking up the rejected values in the parameters and the allowed values in the schema
+func newResourceError(resource string, provider providers.Provider, resourceType string, params map[string]interface{}, err error) *ResourceError {
+	resourceErr := &ResourceError{Resource: resource, Err: err}
+
+	var validationErrs []*providers.ValidationError
+	var all providers.ValidationErrors
+	var single *providers.ValidationError
+	switch {
+	case errors.As(err, &all):
+		validationErrs = all
+	case errors.As(err, &single):
+		validationErrs = []*providers.ValidationError{single}
+	default:
+		return resourceErr
+	}
+
+	resourceSchema, _ := provider.GetSchema(resourceType)
+	for _, validationErr := range validationErrs {
+		value, set := lookupParam(params, validationErr.Field)
+		resourceErr.Fields = append(resourceErr.Fields, FieldProblem{
+			Field:   validationErr.Field,
+			Code:    validationErr.Code,
+			Message: validationErr.Message,
+			Value:   value,
+			Set:     set,
+			Allowed: allowedValues(resourceSchema, validationErr.Field),
+		})
+	}
+	return resourceErr
+}
+
+// prependElementPath adds an element to the front of the path of the ResourceError in err, if any
+func prependElementPath(err error, element string) {
+	var resourceErr *ResourceError
+	if !errors.As(err, &resourceErr) || element == "" {
+		return
+	}
+	if resourceErr.Path == "" {
+		resourceErr.Path = element
+	} else {
+		resourceErr.Path = element + "/" + resourceErr.Path
+	}
+}
+
+// elementPathName returns the name an element has in element paths, its ID or else its name
+func elementPathName(element *schema.Element) string {
+	if element.ID != "" {
+		return element.ID
+	}
+	return element.Name
+}
+
+// fieldPathSegments splits a field path such as "ports[0].port" into "ports", "0" and "port"
+func fieldPathSegments(field string) []string {
+	field = strings.ReplaceAll(field, "[", ".")
+	field = strings.ReplaceAll(field, "]", "")
+	return strings.Split(field, ".")
+}
+
+// lookupParam returns the value of the parameter at a field path
+func lookupParam(params map[string]interface{}, field string) (interface{}, bool) {
+	var value interface{} = params
+	for _, segment := range fieldPathSegments(field) {
+		switch current := value.(type) {
+		case map[string]interface{}:
+			next, exists := current[segment]
+			if !exists {
+				return nil, false
+			}
+			value = next
+		case []interface{}:
+			index, err := strconv.Atoi(segment)
+			if err != nil || index < 0 || index >= len(current) {
+				return nil, false
+			}
+			value = current[index]
+		default:
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+// allowedValues returns the enum of the property schema at a field path
+func allowedValues(resourceSchema map[string]interface{}, field string) []interface{} {
+	current := resourceSchema
+	for _, segment := range fieldPathSegments(field) {
+		if _, err := strconv.Atoi(segment); err == nil {
+			current, _ = current["items"].(map[string]interface{})
+		} else {
+			properties, _ := current["properties"].(map[string]interface{})
+			current, _ = properties[segment].(map[string]interface{})
+		}
+		if current == nil {
+			return nil
+		}
+	}
+
+	switch enum := current["enum"].(type) {
+	case []interface{}:
+		return enum
+	case []string:
+		values := make([]interface{}, len(enum))
+		for i, value := range enum {
+			values[i] = value
+		}
+		return values
+	default:
+		return nil
+	}
+}
+
+// formatValue quotes strings so empty and numeric-looking values stand out
+func formatValue(value interface{}) string {
+	if str, ok := value.(string); ok {
+		return strconv.Quote(str)
+	}
+	return fmt.Sprint(value)
+}
@@ -0,0 +1,296 @@
+package templates
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// resolveModelRefs replaces page elements that reference model elements with page-local instances
+func (tp *TemplateProcessor) resolveModelRefs(config *schema.DiagramConfig) error {
+	model := make(map[string]*schema.Element, len(config.Model))
+	for i := range config.Model {
+		element := &config.Model[i]
+		if element.ID == "" {
+			return fmt.Errorf("model elements must have an 'id' field")
+		}
+		if element.Ref != "" {
+			return fmt.Errorf("model element %s cannot itself use ref", element.ID)
+		}
+		if _, exists := model[element.ID]; exists {
+			return fmt.Errorf("duplicate model element ID: %s", element.ID)
+		}
+		model[element.ID] = element
+	}
+
+	for i := range config.Diagram.Pages {
+		page := &config.Diagram.Pages[i]
+		aliases := make(map[string][]string)
+
+		for j := range page.Layers {
+			if err := tp.resolveRefs(page.Layers[j].Elements, model, nil, aliases); err != nil {
+				return fmt.Errorf("failed to resolve model references in page %s: %w", page.ID, err)
+			}
+		}
+
+		if err := tp.resolveRefs(page.Elements, model, nil, aliases); err != nil {
+			return fmt.Errorf("failed to resolve model references in page %s: %w", page.ID, err)
+		}
+
+		if err := resolveModelAliases(page, aliases); err != nil {
+			return fmt.Errorf("failed to resolve model references in page %s: %w", page.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// resolveRefs resolves model references in a list of elements and their children, recording
+// the instance IDs of model elements instantiated under a page-local ID in aliases
+func (tp *TemplateProcessor) resolveRefs(elements []schema.Element, model map[string]*schema.Element, resolving []string, aliases map[string][]string) error {
+	for i := range elements {
+		element := &elements[i]
+		childResolving := resolving
+
+		if element.Ref != "" {
+			ref := element.Ref
+			for _, active := range resolving {
+				if active == ref {
+					return fmt.Errorf("model reference cycle detected at %s", ref)
+				}
+			}
+
+			modelElement, exists := model[ref]
+			if !exists {
+				return fmt.Errorf("model element %s not found for element %s", ref, tp.getElementDisplayName(element))
+			}
+
+			instance, err := tp.instantiateModelElement(element, modelElement)
+			if err != nil {
+				return fmt.Errorf("failed to instantiate model element %s: %w", ref, err)
+			}
+			*element = instance
+			if instance.ID != ref && !containsString(aliases[ref], instance.ID) {
+				aliases[ref] = append(aliases[ref], instance.ID)
+			}
+
+			childResolving = append(append([]string{}, resolving...), ref)
+		}
+
+		if err := tp.resolveRefs(element.Children, model, childResolving, aliases); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resolveModelAliases points connector endpoints that use the ID of a model element
+// instantiated under a page-local ID, or a path below it, to the instance. Endpoints that
+// are IDs of page elements keep referring to those elements.
+func resolveModelAliases(page *schema.Page, aliases map[string][]string) error {
+	if len(aliases) == 0 {
+		return nil
+	}
+
+	ids := make(map[string]bool)
+	collectIDs(page.Elements, ids)
+	for i := range page.Layers {
+		collectIDs(page.Layers[i].Elements, ids)
+	}
+
+	resolve := func(endpoint string) (string, error) {
+		if endpoint == "" || ids[endpoint] {
+			return endpoint, nil
+		}
+		modelID, rest, _ := strings.Cut(endpoint, "/")
+		instances, exists := aliases[modelID]
+		if !exists || ids[modelID] {
+			return endpoint, nil
+		}
+		if len(instances) > 1 {
+			return "", fmt.Errorf("connector endpoint %s is ambiguous, model element %s is shown as %s", endpoint, modelID, strings.Join(instances, ", "))
+		}
+		if rest == "" {
+			return instances[0], nil
+		}
+		return instances[0] + "/" + rest, nil
+	}
+
+	var walk func(elements []schema.Element) error
+	walk = func(elements []schema.Element) error {
+		for i := range elements {
+			element := &elements[i]
+			if element.Type == schema.ElementTypeConnector {
+				var err error
+				if element.Properties.Source, err = resolve(element.Properties.Source); err != nil {
+					return err
+				}
+				if element.Properties.Target, err = resolve(element.Properties.Target); err != nil {
+					return err
+				}
+			}
+			if err := walk(element.Children); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for i := range page.Layers {
+		if err := walk(page.Layers[i].Elements); err != nil {
+			return err
+		}
+	}
+	return walk(page.Elements)
+}
+
+// collectIDs adds the IDs of elements and their children to ids
+func collectIDs(elements []schema.Element, ids map[string]bool) {
+	for i := range elements {
+		if elements[i].ID != "" {
+			ids[elements[i].ID] = true
+		}
+		collectIDs(elements[i].Children, ids)
+	}
+}
+
+// instantiateModelElement creates a page-local copy of a model element with the page element's overrides applied
+func (tp *TemplateProcessor) instantiateModelElement(pageElement *schema.Element, modelElement *schema.Element) (schema.Element, error) {
+	if pageElement.Type != "" || pageElement.Template != "" || pageElement.Resource != "" {
+		return schema.Element{}, fmt.Errorf("element %s cannot combine ref with type, template or resource", tp.getElementDisplayName(pageElement))
+	}
+
+	instance := modelElement.DeepCopy()
+
+	// Page-local identification lets the same model element appear under different IDs
+	if pageElement.ID != "" {
+		instance.ID = pageElement.ID
+	}
+	if pageElement.Name != "" {
+		instance.Name = pageElement.Name
+	}
+
+	tp.applyOverrides(&instance, pageElement)
+
+	// Select which model children are shown on this page
+	if pageElement.HideChildren {
+		instance.Children = nil
+	} else if len(pageElement.Show) > 0 {
+		shown := make([]schema.Element, 0, len(pageElement.Show))
+		for _, selector := range pageElement.Show {
+			found := false
+			for _, child := range instance.Children {
+				if child.ID == selector || child.Name == selector {
+					shown = append(shown, child)
+					found = true
+					break
+				}
+			}
+			if !found {
+				return schema.Element{}, fmt.Errorf("model element %s has no child %s", modelElement.ID, selector)
+			}
+		}
+		instance.Children = shown
+	}
+
+	// Page-local children are added to the model children
+	for i := range pageElement.Children {
+		instance.Children = append(instance.Children, pageElement.Children[i].DeepCopy())
+	}
+
+	if pageElement.SourceFile != "" {
+		instance.SourceFile = pageElement.SourceFile
+	}
+
+	return instance, nil
+}
+
+// applyOverrides applies the geometry, style, parameters and tags of an element that
+// instantiates or refines another element to the instance
+func (tp *TemplateProcessor) applyOverrides(instance *schema.Element, overrides *schema.Element) {
+	tp.overrideGeometry(instance, &overrides.Properties)
+
+	// The overriding style takes precedence over the instance style
+	style := overrides.Style
+	style.Custom = make(map[string]string, len(overrides.Style.Custom))
+	for key, value := range overrides.Style.Custom {
+		style.Custom[key] = value
+	}
+	tp.mergeStyles(&style, &instance.Style)
+	instance.Style = style
+
+	// Overriding parameters replace those of the instance
+	if len(overrides.Parameters) > 0 {
+		if instance.Parameters == nil {
+			instance.Parameters = make(map[string]interface{}, len(overrides.Parameters))
+		}
+		for key, value := range overrides.Parameters {
+			instance.Parameters[key] = value
+		}
+	}
+
+	for _, tag := range overrides.Tags {
+		if !containsString(instance.Tags, tag) {
+			instance.Tags = append(instance.Tags, tag)
+		}
+	}
+}
+
+// overrideGeometry applies non-zero position, size and label overrides to an element.
+// Provider resources take their geometry from parameters, so those are overridden as well.
+func (tp *TemplateProcessor) overrideGeometry(element *schema.Element, overrides *schema.ElementProperties) {
+	values := map[string]float64{
+		"x":      overrides.X,
+		"y":      overrides.Y,
+		"width":  overrides.Width,
+		"height": overrides.Height,
+	}
+
+	if overrides.X != 0 {
+		element.Properties.X = overrides.X
+	}
+	if overrides.Y != 0 {
+		element.Properties.Y = overrides.Y
+	}
+	if overrides.Width != 0 {
+		element.Properties.Width = overrides.Width
+	}
+	if overrides.Height != 0 {
+		element.Properties.Height = overrides.Height
+	}
+	if overrides.Label != "" {
+		element.Properties.Label = overrides.Label
+	}
+
+	if element.Resource == "" {
+		return
+	}
+
+	for key, value := range values {
+		if value == 0 {
+			continue
+		}
+		if element.Parameters == nil {
+			element.Parameters = make(map[string]interface{})
+		}
+		element.Parameters[key] = value
+	}
+	if overrides.Label != "" {
+		if element.Parameters == nil {
+			element.Parameters = make(map[string]interface{})
+		}
+		element.Parameters["label"] = overrides.Label
+	}
+}
+
+// containsString checks if a string slice contains a value
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,217 @@
+package templates
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/drawio"
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+func TestResolveModelRefs_Overrides(t *testing.T) {
+	config := &schema.DiagramConfig{
+		Version: "1.0",
+		Model: []schema.Element{{
+			ID:         "orders",
+			Name:       "Orders Service",
+			Type:       schema.ElementTypeShape,
+			Properties: schema.ElementProperties{Width: 200, Height: 120},
+			Style:      schema.Style{FillColor: "#E3F2FD", StrokeColor: "#1976D2"},
+			Tags:       []string{"service"},
+			Children: []schema.Element{
+				{ID: "api", Name: "API", Type: schema.ElementTypeShape},
+				{ID: "db", Name: "Database", Type: schema.ElementTypeShape},
+			},
+		}},
+		Diagram: schema.Diagram{Pages: []schema.Page{
+			{
+				ID:   "network",
+				Name: "Network",
+				Elements: []schema.Element{{
+					Ref:        "orders",
+					Properties: schema.ElementProperties{X: 40, Y: 60},
+					Style:      schema.Style{FillColor: "#FFF3E0"},
+					Show:       []string{"api"},
+				}},
+			},
+			{
+				ID:       "data",
+				Name:     "Data",
+				Elements: []schema.Element{{Ref: "orders", Tags: []string{"data"}, HideChildren: true}},
+			},
+		}},
+	}
+
+	tp := NewTemplateProcessor("")
+	if err := tp.ProcessDiagram(config); err != nil {
+		t.Fatalf("ProcessDiagram() error = %v", err)
+	}
+
+	orders := config.Diagram.Pages[0].Elements[0]
+	if orders.ID != "orders" || orders.Name != "Orders Service" {
+		t.Errorf("Expected model identification, got id '%s' name '%s'", orders.ID, orders.Name)
+	}
+
+	if orders.Properties.X != 40 || orders.Properties.Y != 60 {
+		t.Errorf("Expected position override (40, 60), got (%v, %v)", orders.Properties.X, orders.Properties.Y)
+	}
+
+	if orders.Properties.Width != 200 {
+		t.Errorf("Expected model width 200, got %v", orders.Properties.Width)
+	}
+
+	if orders.Style.FillColor != "#FFF3E0" || orders.Style.StrokeColor != "#1976D2" {
+		t.Errorf("Expected page fill and model stroke, got fill '%s' stroke '%s'", orders.Style.FillColor, orders.Style.StrokeColor)
+	}
+
+	if len(orders.Children) != 1 || orders.Children[0].ID != "api" {
+		t.Errorf("Expected only the 'api' child to be shown, got %+v", orders.Children)
+	}
+
+	data := config.Diagram.Pages[1].Elements[0]
+	if len(data.Children) != 0 {
+		t.Errorf("Expected hidden children on data page, got %d", len(data.Children))
+	}
+
+	if len(data.Tags) != 2 {
+		t.Errorf("Expected model and page tags to be combined, got %v", data.Tags)
+	}
+
+	// Instances must not share state with the model
+	if len(config.Model[0].Children) != 2 {
+		t.Errorf("Expected model element to keep its children, got %d", len(config.Model[0].Children))
+	}
+}
+
+func TestResolveModelRefs_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		model    []schema.Element
+		elements []schema.Element
+	}{
+		{
+			name:     "unknown model element",
+			model:    []schema.Element{{ID: "orders", Type: schema.ElementTypeShape}},
+			elements: []schema.Element{{Ref: "missing"}},
+		},
+		{
+			name: "unknown child",
+			model: []schema.Element{{
+				ID:       "orders",
+				Type:     schema.ElementTypeShape,
+				Children: []schema.Element{{ID: "api", Type: schema.ElementTypeShape}},
+			}},
+			elements: []schema.Element{{Ref: "orders", Show: []string{"cache"}}},
+		},
+		{
+			name: "reference cycle",
+			model: []schema.Element{{
+				ID:       "billing",
+				Type:     schema.ElementTypeShape,
+				Children: []schema.Element{{ID: "self", Ref: "billing"}},
+			}},
+			elements: []schema.Element{{Ref: "billing"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &schema.DiagramConfig{
+				Version: "1.0",
+				Model:   tt.model,
+				Diagram: schema.Diagram{Pages: []schema.Page{{ID: "network", Name: "Network", Elements: tt.elements}}},
+			}
+
+			if err := NewTemplateProcessor("").ProcessDiagram(config); err == nil {
+				t.Errorf("Expected %s to cause an error", tt.name)
+			}
+		})
+	}
+}
+
+func TestResolveModelRefs_Connectors(t *testing.T) {
+	model := []schema.Element{
+		{
+			ID:   "orders",
+			Name: "Orders Service",
+			Type: schema.ElementTypeShape,
+			Children: []schema.Element{
+				{ID: "api", Name: "API", Type: schema.ElementTypeShape},
+				{ID: "db", Name: "Database", Type: schema.ElementTypeShape},
+			},
+		},
+		{ID: "billing", Name: "Billing Service", Type: schema.ElementTypeShape},
+	}
+
+	tests := []struct {
+		name     string
+		elements []schema.Element
+		wantEdge string // Source and target cells of the connector
+		wantErr  string
+	}{
+		{
+			name: "page-local instances",
+			elements: []schema.Element{
+				{Ref: "orders"},
+				{ID: "billing", Name: "Billing", Ref: "billing"},
+				{ID: "call", Name: "Call", Type: schema.ElementTypeConnector, Properties: schema.ElementProperties{Source: "orders", Target: "billing"}},
+			},
+			wantEdge: "network/orders -> network/billing",
+		},
+		{
+			name: "model ID of a renamed instance",
+			elements: []schema.Element{
+				{ID: "orders-eu", Ref: "orders"},
+				{ID: "billing", Name: "Billing", Ref: "billing"},
+				{ID: "call", Name: "Call", Type: schema.ElementTypeConnector, Properties: schema.ElementProperties{Source: "orders/api", Target: "billing"}},
+			},
+			wantEdge: "network/orders-eu/api -> network/billing",
+		},
+		{
+			name: "model ID of several instances",
+			elements: []schema.Element{
+				{ID: "orders-eu", Ref: "orders"},
+				{ID: "orders-us", Ref: "orders"},
+				{ID: "billing", Name: "Billing", Ref: "billing"},
+				{ID: "call", Name: "Call", Type: schema.ElementTypeConnector, Properties: schema.ElementProperties{Source: "orders", Target: "billing"}},
+			},
+			wantErr: "ambiguous",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &schema.DiagramConfig{
+				Version: "1.0",
+				Model:   model,
+				Diagram: schema.Diagram{Pages: []schema.Page{{ID: "network", Name: "Network", Elements: tt.elements}}},
+			}
+
+			err := NewTemplateProcessor("").ProcessDiagram(config)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ProcessDiagram() error = %v", err)
+			}
+
+			document, err := drawio.NewGenerator().Generate(config)
+			if err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+
+			var edges []string
+			for _, cell := range document.Diagram[0].GraphModel.Root.Cells {
+				if cell.Edge == "1" {
+					edges = append(edges, cell.Source+" -> "+cell.Target)
+				}
+			}
+			if len(edges) != 1 || edges[0] != tt.wantEdge {
+				t.Errorf("Expected connector %s, got %v", tt.wantEdge, edges)
+			}
+		})
+	}
+}
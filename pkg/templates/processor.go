@@ -1,14 +1,20 @@
 package templates
 
 import (
+	"errors"
 	"fmt"
+	"io"
+	"net"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"text/template"
 
 	"gopkg.in/yaml.v3"
 
+	"github.com/LederWorks/hippodamus/pkg/declarative"
+	"github.com/LederWorks/hippodamus/pkg/plugin"
 	"github.com/LederWorks/hippodamus/pkg/providers"
 	"github.com/LederWorks/hippodamus/pkg/schema"
 )
@@ -20,6 +26,10 @@ type TemplateProcessor struct {
 	hives        map[string][]string            // Maps hive name to list of templates
 	registry     *providers.Registry            // Provider registry for dynamic templates
 	providerRefs map[string]*schema.ProviderRef // Declared providers from config
+	sourceFile   string                         // Configuration file of the page being processed
+
+	custom        map[string]providers.Provider // Loaded custom and configured providers, by declared name
+	pluginOptions plugin.Options                // How plugins are launched
 }
 
 // NewTemplateProcessor creates a new template processor with hive support
@@ -30,9 +40,29 @@ func NewTemplateProcessor(templateDir string) *TemplateProcessor {
 		hives:        make(map[string][]string),
 		registry:     providers.DefaultRegistry,
 		providerRefs: make(map[string]*schema.ProviderRef),
+		custom:       make(map[string]providers.Provider),
 	}
 }
 
+// SetPluginOptions configures how plugins of custom providers are launched
+func (tp *TemplateProcessor) SetPluginOptions(options plugin.Options) {
+	tp.pluginOptions = options
+}
+
+// Close shuts down the plugins launched for custom providers
+func (tp *TemplateProcessor) Close() error {
+	var firstErr error
+	for name, provider := range tp.custom {
+		if closer, ok := provider.(io.Closer); ok {
+			if err := closer.Close(); err != nil && firstErr == nil {
+				firstErr = fmt.Errorf("failed to shut down provider %s: %w", name, err)
+			}
+		}
+		delete(tp.custom, name)
+	}
+	return firstErr
+}
+
 // getElementDisplayName returns the display name for an element (used for error messages)
 func (tp *TemplateProcessor) getElementDisplayName(element *schema.Element) string {
 	if element.Name != "" {
@@ -265,10 +295,21 @@ func (tp *TemplateProcessor) ProcessDiagram(config *schema.DiagramConfig) error
 		return err
 	}
 
+	// Instantiate model elements referenced from pages
+	if err := tp.resolveModelRefs(config); err != nil {
+		return err
+	}
+
 	// Process each page
 	for i := range config.Diagram.Pages {
-		if err := tp.processPage(&config.Diagram.Pages[i]); err != nil {
-			return fmt.Errorf("failed to process page %s: %w", config.Diagram.Pages[i].ID, err)
+		page := &config.Diagram.Pages[i]
+		tp.sourceFile = page.SourceFile
+		if err := tp.processPage(page); err != nil {
+			prependElementPath(err, page.ID)
+			if page.SourceFile != "" {
+				return fmt.Errorf("failed to process page %s (%s): %w", page.ID, page.SourceFile, err)
+			}
+			return fmt.Errorf("failed to process page %s: %w", page.ID, err)
 		}
 	}
 
@@ -280,6 +321,7 @@ func (tp *TemplateProcessor) processPage(page *schema.Page) error {
 	// Process layers
 	for i := range page.Layers {
 		if err := tp.processElements(page.Layers[i].Elements); err != nil {
+			prependElementPath(err, page.Layers[i].ID)
 			return fmt.Errorf("failed to process layer %s: %w", page.Layers[i].ID, err)
 		}
 	}
@@ -289,18 +331,89 @@ func (tp *TemplateProcessor) processPage(page *schema.Page) error {
 		return fmt.Errorf("failed to process page elements: %w", err)
 	}
 
+	// Connectors are validated once all their endpoints have been resolved
+	return tp.validateConnections(page)
+}
+
+// validateConnections enforces the connection rules declared on the definitions of the
+// connector resources of a page against their source and target elements
+func (tp *TemplateProcessor) validateConnections(page *schema.Page) error {
+	index := make(map[string]*schema.Element)
+	var connectors []*schema.Element
+
+	var walk func(elements []schema.Element, path string)
+	walk = func(elements []schema.Element, path string) {
+		for i := range elements {
+			element := &elements[i]
+			elementPath := elementPathName(element)
+			if path != "" {
+				elementPath = path + "/" + elementPath
+			}
+			// Elements are found by ID, name and their path of IDs, such as "shop/db"
+			for _, key := range []string{element.ID, element.Name, elementPath} {
+				// The first element wins when an identifier is used more than once on a page
+				if _, exists := index[key]; key != "" && !exists {
+					index[key] = element
+				}
+			}
+			if element.Type == schema.ElementTypeConnector && element.Resource != "" {
+				connectors = append(connectors, element)
+			}
+			walk(element.Children, elementPath)
+		}
+	}
+	for i := range page.Layers {
+		walk(page.Layers[i].Elements, "")
+	}
+	walk(page.Elements, "")
+
+	for _, connector := range connectors {
+		providerName, resourceType, err := tp.parseProviderResource(connector.Resource)
+		if err != nil {
+			return fmt.Errorf("invalid connector %s: %w", tp.getElementDisplayName(connector), err)
+		}
+		resource := resourceRef{provider: providerName, resourceType: resourceType}
+		definition, _ := tp.resourceDefinition(providerName, resourceType)
+
+		// Endpoints that are not provider resources are not restricted
+		endpointResource := func(endpoint string) (resourceRef, bool) {
+			element, exists := index[endpoint]
+			if !exists || element.Resource == "" {
+				return resourceRef{}, false
+			}
+			endpointProvider, endpointType, err := tp.parseProviderResource(element.Resource)
+			if err != nil {
+				return resourceRef{}, false
+			}
+			return resourceRef{provider: endpointProvider, resourceType: endpointType}, true
+		}
+
+		if source, ok := endpointResource(connector.Properties.Source); ok && matchesAny(providerName, definition.DisallowedSources, source) {
+			return fmt.Errorf("invalid connection %s for element %s: resource %s cannot start at %s", connector.Resource, tp.getElementDisplayName(connector), resource, source)
+		}
+		if target, ok := endpointResource(connector.Properties.Target); ok && matchesAny(providerName, definition.DisallowedTargets, target) {
+			return fmt.Errorf("invalid connection %s for element %s: resource %s cannot end at %s", connector.Resource, tp.getElementDisplayName(connector), resource, target)
+		}
+	}
+
 	return nil
 }
 
 // processElements processes a list of elements and applies templates
 func (tp *TemplateProcessor) processElements(elements []schema.Element) error {
-	return tp.processElementsWithContext(elements, []string{})
+	return tp.processElementsWithContext(elements, []string{}, nil)
 }
 
-// processElementsWithContext processes elements with parent template context
-func (tp *TemplateProcessor) processElementsWithContext(elements []schema.Element, parentTemplates []string) error {
+// processElementsWithContext processes elements with parent template context and their
+// ancestor elements, the direct parent first
+func (tp *TemplateProcessor) processElementsWithContext(elements []schema.Element, parentTemplates []string, ancestors []*schema.Element) error {
 	for i := range elements {
-		if err := tp.processElementWithContext(&elements[i], parentTemplates); err != nil {
+		if err := tp.processElementWithContext(&elements[i], parentTemplates, ancestors); err != nil {
+			prependElementPath(err, elementPathName(&elements[i]))
+			// Point to the included file when the element did not come from the page's own file
+			if elements[i].SourceFile != "" && elements[i].SourceFile != tp.sourceFile {
+				return fmt.Errorf("failed to process element %s (%s): %w", elements[i].ID, elements[i].SourceFile, err)
+			}
 			return fmt.Errorf("failed to process element %s: %w", elements[i].ID, err)
 		}
 
@@ -312,47 +425,119 @@ func (tp *TemplateProcessor) processElementsWithContext(elements []schema.Elemen
 			childContext = parentTemplates
 		}
 
-		if err := tp.processElementsWithContext(elements[i].Children, childContext); err != nil {
+		childAncestors := append([]*schema.Element{&elements[i]}, ancestors...)
+		if err := tp.processElementsWithContext(elements[i].Children, childContext, childAncestors); err != nil {
+			prependElementPath(err, elementPathName(&elements[i]))
 			return fmt.Errorf("failed to process children of element %s: %w", elements[i].ID, err)
 		}
 	}
 
+	return tp.validateSiblings(elements)
+}
+
+// validateSiblings enforces the sibling rules declared on the definitions of the provider
+// resources that share a parent
+func (tp *TemplateProcessor) validateSiblings(elements []schema.Element) error {
+	type cidrBlock struct {
+		resource resourceRef
+		field    string
+		network  *net.IPNet
+		element  *schema.Element
+	}
+	var blocks []cidrBlock
+
+	for i := range elements {
+		element := &elements[i]
+		if element.Resource == "" {
+			continue
+		}
+		providerName, resourceType, err := tp.parseProviderResource(element.Resource)
+		if err != nil {
+			return fmt.Errorf("invalid element %s: %w", tp.getElementDisplayName(element), err)
+		}
+		resource := resourceRef{provider: providerName, resourceType: resourceType}
+
+		definition, _ := tp.resourceDefinition(providerName, resourceType)
+		for _, field := range definition.DisjointCIDRs {
+			cidr, ok := element.Parameters[field].(string)
+			if !ok {
+				continue
+			}
+			_, network, err := net.ParseCIDR(cidr)
+			if err != nil {
+				// Invalid blocks are reported by the validation of the resource
+				continue
+			}
+
+			for _, other := range blocks {
+				if other.resource != resource || other.field != field {
+					continue
+				}
+				if network.Contains(other.network.IP) || other.network.Contains(network.IP) {
+					return fmt.Errorf("invalid %s resources: %s: CIDR block %s of %s overlaps %s of %s", resource, field, network, tp.getElementDisplayName(element), other.network, tp.getElementDisplayName(other.element))
+				}
+			}
+			blocks = append(blocks, cidrBlock{resource: resource, field: field, network: network, element: element})
+		}
+	}
+
 	return nil
 }
 
 // processElement processes a single element and applies its template if specified
 func (tp *TemplateProcessor) processElement(element *schema.Element) error {
-	return tp.processElementWithContext(element, []string{})
+	return tp.processElementWithContext(element, []string{}, nil)
 }
 
-// processElementWithContext processes a single element with parent template context
-func (tp *TemplateProcessor) processElementWithContext(element *schema.Element, parentTemplates []string) error {
+// processElementWithContext processes a single element with parent template context and its
+// ancestor elements, the direct parent first
+func (tp *TemplateProcessor) processElementWithContext(element *schema.Element, parentTemplates []string, ancestors []*schema.Element) error {
 	// Handle provider resource - clean syntax: resource: "core-text"
 	if element.Resource != "" {
-		// Parse provider-resource format with smart matching
-		providerName, resourceType := tp.parseProviderResource(element.Resource)
-		if providerName == "" || resourceType == "" {
-			return fmt.Errorf("invalid resource format '%s' for element %s, expected 'provider-resource'", element.Resource, tp.getElementDisplayName(element))
+		// Resolve the provider-resource reference against the resources of the providers
+		providerName, resourceType, err := tp.parseProviderResource(element.Resource)
+		if err != nil {
+			return fmt.Errorf("invalid resource for element %s: %w", tp.getElementDisplayName(element), err)
 		}
 
 		// Validate provider is declared (optional for backward compatibility)
 		tp.resolveProviderSource(providerName)
 
+		if err := tp.validateContainment(providerName, resourceType, ancestors); err != nil {
+			return fmt.Errorf("invalid placement of resource %s for element %s: %w", element.Resource, tp.getElementDisplayName(element), err)
+		}
+
 		// Generate provider resource
-		if providedElement := tp.tryProviderResource(providerName, resourceType, element.Parameters); providedElement != nil {
-			// Preserve original ID and Name, then apply provider resource
-			originalID := element.ID
-			originalName := element.Name
-			element.Type = providedElement.Type
-			element.Properties = providedElement.Properties
-			element.Style = providedElement.Style
-			element.Nesting = providedElement.Nesting
-			// Restore original identification
-			element.ID = originalID
-			element.Name = originalName
-			return nil
+		providedElement, err := tp.tryProviderResource(providerName, resourceType, element.Parameters)
+		if err != nil {
+			var resourceErr *ResourceError
+			if errors.As(err, &resourceErr) {
+				return err // Already names the resource; the callers add the element path
+			}
+			return fmt.Errorf("failed to generate provider resource %s for element %s: %w", element.Resource, tp.getElementDisplayName(element), err)
+		}
+
+		// Preserve original ID and Name, then apply provider resource
+		originalID := element.ID
+		originalName := element.Name
+		element.Type = providedElement.Type
+		element.Properties = providedElement.Properties
+		element.Style = providedElement.Style
+		element.Nesting = providedElement.Nesting
+		// Children generated by the provider, such as the member rows of a class or the
+		// parts of a composite resource, come before the children declared on the element,
+		// which can also override them
+		if len(providedElement.Children) > 0 {
+			children, err := tp.mergeGeneratedChildren(providedElement.Children, element.Children)
+			if err != nil {
+				return fmt.Errorf("invalid children of element %s: %w", tp.getElementDisplayName(element), err)
+			}
+			element.Children = children
 		}
-		return fmt.Errorf("failed to generate provider resource %s for element %s", element.Resource, tp.getElementDisplayName(element))
+		// Restore original identification
+		element.ID = originalID
+		element.Name = originalName
+		return nil
 	}
 
 	// Handle YAML template - filesystem templates: template: "my-template"
@@ -386,6 +571,10 @@ func (tp *TemplateProcessor) processElementWithContext(element *schema.Element,
 	return nil
 }
 
+// BuiltinVariables are the template variables every template can use besides its
+// parameters: the properties of the element it is applied to and default colors
+var BuiltinVariables = []string{"id", "name", "x", "y", "width", "height", "label", "fillColor", "strokeColor"}
+
 // applyTemplate applies a template to an element using the new unified group approach
 func (tp *TemplateProcessor) applyTemplate(element *schema.Element, tmpl *schema.Template) error {
 	// Prepare template variables
@@ -867,19 +1056,29 @@ func (tp *TemplateProcessor) hasParentOfType(parentTemplates []string, templateT
 		return false
 	}
 	// Direct parent is the first in the list
-	return parentTemplates[0] == templateType
+	return templateMatches(parentTemplates[0], templateType)
 }
 
 // hasAncestorOfType checks if there's any ancestor of the specified template type
 func (tp *TemplateProcessor) hasAncestorOfType(parentTemplates []string, templateType string) bool {
 	for _, parent := range parentTemplates {
-		if parent == templateType {
+		if templateMatches(parent, templateType) {
 			return true
 		}
 	}
 	return false
 }
 
+// templateMatches reports whether a parent template reference satisfies a dependency on
+// templateType. A reference in hive notation satisfies a dependency on the template name.
+func templateMatches(parentTemplate, templateType string) bool {
+	if parentTemplate == templateType {
+		return true
+	}
+	_, name, qualified := strings.Cut(parentTemplate, "/")
+	return qualified && !strings.Contains(templateType, "/") && name == templateType
+}
+
 // getCurrentHive determines the current hive context from parent templates
 func (tp *TemplateProcessor) getCurrentHive(parentTemplates []string) string {
 	if len(parentTemplates) == 0 {
@@ -962,6 +1161,34 @@ func (tp *TemplateProcessor) resolveTemplateReference(templateRef string, curren
 	return templateRef
 }
 
+// ResolveTemplate resolves a template reference the way elements of a template in
+// currentHive resolve it, and returns the key and the template it refers to
+func (tp *TemplateProcessor) ResolveTemplate(templateRef, currentHive string) (string, *schema.Template, bool) {
+	key := tp.resolveTemplateReference(templateRef, currentHive)
+	template, exists := tp.templates[key]
+	return key, template, exists
+}
+
+// ResolveResource resolves a resource reference given as provider.resource, provider/resource
+// or provider-resource the way elements resolve it, and returns it in the provider.resource form
+func (tp *TemplateProcessor) ResolveResource(resource string) (string, bool) {
+	providerName, resourceType, err := tp.parseProviderResource(resource)
+	if err != nil {
+		return "", false
+	}
+	return providerName + "." + resourceType, true
+}
+
+// Registry returns the provider registry resources are resolved against
+func (tp *TemplateProcessor) Registry() *providers.Registry {
+	return tp.registry
+}
+
+// SetRegistry replaces the provider registry resources are resolved against
+func (tp *TemplateProcessor) SetRegistry(registry *providers.Registry) {
+	tp.registry = registry
+}
+
 // ListHives returns all available template hives
 func (tp *TemplateProcessor) ListHives() []string {
 	hives := make([]string, 0, len(tp.hives))
@@ -988,48 +1215,105 @@ func (tp *TemplateProcessor) ListAllTemplateKeys() []string {
 	return keys
 }
 
-// tryProviderResource attempts to resolve a resource using the provider system (new explicit syntax)
-func (tp *TemplateProcessor) tryProviderResource(providerName, resourceType string, parameters map[string]interface{}) *schema.Element {
+// tryProviderResource resolves a resource using the provider system (new explicit syntax).
+// Errors of the provider are returned as a ResourceError whose path the callers complete.
+func (tp *TemplateProcessor) tryProviderResource(providerName, resourceType string, parameters map[string]interface{}) (*schema.Element, error) {
 	// Resolve provider based on declarations and type preference
 	provider := tp.resolveProvider(providerName)
 	if provider == nil {
-		return nil // Provider not found
+		return nil, fmt.Errorf("provider %s not found", providerName)
 	}
 
+	resource := providerName + "." + resourceType
+
 	// Validate parameters
 	if err := provider.Validate(resourceType, parameters); err != nil {
-		return nil // Validation failed
+		return nil, newResourceError(resource, provider, resourceType, parameters, err)
 	}
 
 	// Generate resource
 	element, err := provider.GenerateTemplate(resourceType, parameters)
 	if err != nil {
-		return nil // Resource generation failed
+		return nil, newResourceError(resource, provider, resourceType, parameters, err)
 	}
-
-	return element
-}
-
-// tryProviderTemplate attempts to resolve a template using the provider system (legacy format: "provider-resource")
-func (tp *TemplateProcessor) tryProviderTemplate(templateName string, parameters map[string]interface{}) *schema.Element {
-	// Parse provider template format: "provider-resource"
-	parts := strings.SplitN(templateName, "-", 2)
-	if len(parts) != 2 {
-		return nil // Not a provider template format
+	if element == nil {
+		return nil, newResourceError(resource, provider, resourceType, parameters, fmt.Errorf("provider generated no element"))
 	}
 
-	providerName, resourceType := parts[0], parts[1]
-	return tp.tryProviderResource(providerName, resourceType, parameters)
+	return element, nil
 }
 
 // LoadProviderRefs loads provider references from the diagram config
 func (tp *TemplateProcessor) LoadProviderRefs(providers []schema.ProviderRef) error {
 	for _, provider := range providers {
 		tp.providerRefs[provider.Name] = &provider
+
+		if _, loaded := tp.custom[provider.Name]; loaded {
+			continue
+		}
+
+		// Custom providers with a path are declarative provider directories or plugin executables
+		if provider.Type == schema.ProviderTypeCustom && provider.Path != "" {
+			custom, err := tp.loadCustomProvider(provider.Path)
+			if err != nil {
+				return fmt.Errorf("failed to load provider %s: %w", provider.Name, err)
+			}
+			if err := configureProvider(custom, &provider); err != nil {
+				// The plugin of a provider that is not kept is shut down right away
+				if closer, ok := custom.(io.Closer); ok {
+					closer.Close()
+				}
+				return err
+			}
+			tp.custom[provider.Name] = custom
+			continue
+		}
+
+		// Declarations with settings get their own instance of the provider, so several
+		// differently configured instances can be used side by side
+		if len(provider.Settings) > 0 {
+			instance, err := tp.registry.NewInstance(tp.getActualProviderName(provider.Name, &provider))
+			if err != nil {
+				return fmt.Errorf("failed to configure provider %s: %w", provider.Name, err)
+			}
+			if err := configureProvider(instance, &provider); err != nil {
+				return err
+			}
+			tp.custom[provider.Name] = instance
+		}
+	}
+	return nil
+}
+
+// configureProvider passes the settings of a declaration to its provider
+func configureProvider(provider providers.Provider, providerRef *schema.ProviderRef) error {
+	if len(providerRef.Settings) == 0 {
+		return nil
+	}
+	configurable, ok := provider.(providers.Configurable)
+	if !ok {
+		return fmt.Errorf("failed to configure provider %s: provider %s does not accept settings", providerRef.Name, provider.Name())
+	}
+	err := configurable.Configure(providers.ProviderConfig{
+		Name:     providerRef.Name,
+		Version:  providerRef.Version,
+		Settings: providerRef.Settings,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to configure provider %s: %w", providerRef.Name, err)
 	}
 	return nil
 }
 
+// loadCustomProvider loads the declarative provider in a directory with a provider.yaml,
+// or launches the plugin executable at path
+func (tp *TemplateProcessor) loadCustomProvider(path string) (providers.Provider, error) {
+	if declarative.IsProvider(path) {
+		return declarative.Load(path)
+	}
+	return plugin.Launch(path, tp.pluginOptions)
+}
+
 // resolveProviderSource resolves a provider name to its full source path
 func (tp *TemplateProcessor) resolveProviderSource(providerName string) string {
 	// Check if provider is declared in config
@@ -1048,6 +1332,11 @@ func (tp *TemplateProcessor) resolveProviderSource(providerName string) string {
 // resolveProvider resolves a provider name to an actual provider instance
 // Implements the priority system: registry > builtin, with explicit type override
 func (tp *TemplateProcessor) resolveProvider(providerName string) providers.Provider {
+	// Custom providers are addressed by their declared name
+	if provider, exists := tp.custom[providerName]; exists {
+		return provider
+	}
+
 	providerRef, isDeclared := tp.providerRefs[providerName]
 
 	// Determine the actual provider name to use from registry
@@ -1097,38 +1386,88 @@ func (tp *TemplateProcessor) getActualProviderName(declaredName string, provider
 	return declaredName
 }
 
-// parseProviderResource parses a resource string into provider and resource parts
-// For clean syntax like "core-text", "aws-vpc", "custom-core-shape"
-func (tp *TemplateProcessor) parseProviderResource(resourceString string) (string, string) {
-	parts := strings.Split(resourceString, "-")
-	if len(parts) < 2 {
-		return "", "" // Invalid format
+// parseProviderResource resolves a resource reference to its provider and resource type.
+// "provider.resource" and "provider/resource" name the provider explicitly. In the
+// "provider-resource" form, provider names and resource types may both contain dashes,
+// so the reference is matched against the resources of the available providers and
+// must match exactly one of them.
+func (tp *TemplateProcessor) parseProviderResource(resourceString string) (string, string, error) {
+	if i := strings.IndexAny(resourceString, "./"); i >= 0 {
+		providerName, resourceType := resourceString[:i], resourceString[i+1:]
+		if providerName == "" || resourceType == "" {
+			return "", "", fmt.Errorf("invalid resource %q, expected provider.resource", resourceString)
+		}
+		if tp.resolveProvider(providerName) == nil {
+			return "", "", fmt.Errorf("unknown provider %s in resource %q", providerName, resourceString)
+		}
+		return providerName, resourceType, nil
 	}
 
-	// Known resource types (from our providers)
-	knownResourceTypes := map[string]bool{
-		"text":         true,
-		"shape":        true,
-		"group":        true,
-		"swimlane":     true,
-		"connector":    true,
-		"vpc":          true, // AWS
-		"organization": true, // AWS
+	var candidates []string
+	var providerName, resourceType string
+	for _, name := range tp.providerNames() {
+		if !strings.HasPrefix(resourceString, name+"-") {
+			continue
+		}
+		rest := strings.TrimPrefix(resourceString, name+"-")
+		if tp.providesResource(name, rest) {
+			candidates = append(candidates, name+"."+rest)
+			providerName, resourceType = name, rest
+		}
 	}
 
-	// Try different combinations, starting from the end
-	for i := len(parts) - 1; i >= 1; i-- {
-		potentialResource := parts[i]
-		if knownResourceTypes[potentialResource] {
-			// Found a known resource type
-			providerName := strings.Join(parts[:i], "-")
-			return providerName, potentialResource
+	switch len(candidates) {
+	case 1:
+		return providerName, resourceType, nil
+	case 0:
+		return "", "", fmt.Errorf("unknown resource %q, no provider defines it (expected provider-resource or provider.resource)", resourceString)
+	default:
+		return "", "", fmt.Errorf("ambiguous resource %q matches %s, use the provider.resource form", resourceString, strings.Join(candidates, ", "))
+	}
+}
+
+// providerNames returns the names under which providers can be referenced: declared
+// providers, custom providers and the providers of the registry
+func (tp *TemplateProcessor) providerNames() []string {
+	seen := make(map[string]bool)
+	var names []string
+	add := func(name string) {
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
 		}
 	}
 
-	// Fallback: assume last part is resource, everything else is provider
-	providerName := strings.Join(parts[:len(parts)-1], "-")
-	resourceType := parts[len(parts)-1]
+	for name := range tp.providerRefs {
+		add(name)
+	}
+	for name := range tp.custom {
+		add(name)
+	}
+	for _, name := range tp.registry.List() {
+		add(name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// providesResource reports whether the provider referenced by providerName defines resourceType
+func (tp *TemplateProcessor) providesResource(providerName, resourceType string) bool {
+	_, exists := tp.resourceDefinition(providerName, resourceType)
+	return exists
+}
 
-	return providerName, resourceType
+// resourceDefinition returns the definition of resourceType in the provider referenced by providerName
+func (tp *TemplateProcessor) resourceDefinition(providerName, resourceType string) (providers.ResourceDefinition, bool) {
+	provider := tp.resolveProvider(providerName)
+	if provider == nil {
+		return providers.ResourceDefinition{}, false
+	}
+	for _, resource := range provider.Resources() {
+		if resource.Type == resourceType {
+			return resource, true
+		}
+	}
+	return providers.ResourceDefinition{}, false
 }
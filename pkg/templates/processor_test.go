@@ -0,0 +1,383 @@
+package templates
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers"
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// stubProvider is a provider that defines resource types sharing one parameter schema
+type stubProvider struct {
+	name          string
+	resourceTypes []string
+	schema        map[string]interface{}
+	generateErr   error
+	definitions   map[string]providers.ResourceDefinition // Definitions of resource types with rules
+}
+
+func (p *stubProvider) Name() string    { return p.name }
+func (p *stubProvider) Version() string { return "1.0.0" }
+
+func (p *stubProvider) Resources() []providers.ResourceDefinition {
+	var definitions []providers.ResourceDefinition
+	for _, resourceType := range p.resourceTypes {
+		definition, exists := p.definitions[resourceType]
+		if !exists {
+			definition = providers.ResourceDefinition{Type: resourceType}
+		}
+		definitions = append(definitions, definition)
+	}
+	return definitions
+}
+
+func (p *stubProvider) GenerateTemplate(resourceType string, params map[string]interface{}) (*schema.Element, error) {
+	if p.generateErr != nil {
+		return nil, p.generateErr
+	}
+	// Resources with a source are drawn as connectors
+	if source, ok := params["source"].(string); ok {
+		target, _ := params["target"].(string)
+		return &schema.Element{Type: schema.ElementTypeConnector, Properties: schema.ElementProperties{Label: p.name + "." + resourceType, Source: source, Target: target}}, nil
+	}
+	return &schema.Element{Type: schema.ElementTypeShape, Properties: schema.ElementProperties{Label: p.name + "." + resourceType}}, nil
+}
+
+func (p *stubProvider) Validate(resourceType string, params map[string]interface{}) error {
+	return providers.ValidateParams(p.schema, params)
+}
+
+func (p *stubProvider) GetSchema(resourceType string) (map[string]interface{}, error) {
+	return p.schema, nil
+}
+
+// configurableStub is a stubProvider that appends its style setting to the labels it generates
+type configurableStub struct {
+	stubProvider
+	style string
+}
+
+func (p *configurableStub) Configure(config providers.ProviderConfig) error {
+	style, ok := config.Settings["style"].(string)
+	if !ok {
+		return fmt.Errorf("style must be a string")
+	}
+	p.style = style
+	return nil
+}
+
+func (p *configurableStub) GenerateTemplate(resourceType string, params map[string]interface{}) (*schema.Element, error) {
+	element, err := p.stubProvider.GenerateTemplate(resourceType, params)
+	if err == nil && p.style != "" {
+		element.Properties.Label += " " + p.style
+	}
+	return element, err
+}
+
+// newTestProcessor returns a processor whose registry holds the given providers
+func newTestProcessor(t *testing.T, registered ...providers.Provider) *TemplateProcessor {
+	t.Helper()
+	tp := NewTemplateProcessor("")
+	tp.registry = providers.NewRegistry()
+	for _, provider := range registered {
+		if err := tp.registry.Register(provider); err != nil {
+			t.Fatalf("Register() error = %v", err)
+		}
+	}
+	return tp
+}
+
+func TestParseProviderResource(t *testing.T) {
+	tp := newTestProcessor(t,
+		&stubProvider{name: "cloud", resourceTypes: []string{"vpc", "key-vault", "vpc-peering"}},
+		&stubProvider{name: "my", resourceTypes: []string{"cloud-vpc", "text"}},
+		&stubProvider{name: "my-cloud", resourceTypes: []string{"vpc"}},
+	)
+
+	tests := []struct {
+		resource     string
+		wantProvider string
+		wantResource string
+		wantErr      string
+	}{
+		{resource: "cloud-vpc", wantProvider: "cloud", wantResource: "vpc"},
+		{resource: "cloud-key-vault", wantProvider: "cloud", wantResource: "key-vault"},
+		{resource: "cloud-vpc-peering", wantProvider: "cloud", wantResource: "vpc-peering"},
+		{resource: "my-text", wantProvider: "my", wantResource: "text"},
+		{resource: "my.cloud-vpc", wantProvider: "my", wantResource: "cloud-vpc"},
+		{resource: "my-cloud/vpc", wantProvider: "my-cloud", wantResource: "vpc"},
+		{resource: "my-cloud-vpc", wantErr: `ambiguous resource "my-cloud-vpc" matches my.cloud-vpc, my-cloud.vpc`},
+		{resource: "cloud-subnet", wantErr: `unknown resource "cloud-subnet"`},
+		{resource: "shape", wantErr: `unknown resource "shape"`},
+		{resource: "other.vpc", wantErr: `unknown provider other in resource "other.vpc"`},
+		{resource: "cloud.", wantErr: `invalid resource "cloud."`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.resource, func(t *testing.T) {
+			providerName, resourceType, err := tp.parseProviderResource(tt.resource)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseProviderResource() error = %v", err)
+			}
+			if providerName != tt.wantProvider || resourceType != tt.wantResource {
+				t.Errorf("Expected %s.%s, got %s.%s", tt.wantProvider, tt.wantResource, providerName, resourceType)
+			}
+		})
+	}
+}
+
+func TestParseProviderResource_DeclaredProviders(t *testing.T) {
+	tp := newTestProcessor(t, &stubProvider{name: "core", resourceTypes: []string{"shape"}})
+
+	// A declared provider sourced from the core provider is referenced by its declared name
+	err := tp.LoadProviderRefs([]schema.ProviderRef{
+		{Name: "team-core", Source: "LederWorks/hippodamus-provider-core"},
+	})
+	if err != nil {
+		t.Fatalf("LoadProviderRefs() error = %v", err)
+	}
+
+	providerName, resourceType, err := tp.parseProviderResource("team-core-shape")
+	if err != nil {
+		t.Fatalf("parseProviderResource() error = %v", err)
+	}
+	if providerName != "team-core" || resourceType != "shape" {
+		t.Errorf("Expected team-core.shape, got %s.%s", providerName, resourceType)
+	}
+}
+
+func TestProcessElement_ResourceErrors(t *testing.T) {
+	tp := newTestProcessor(t,
+		&stubProvider{name: "my", resourceTypes: []string{"cloud-vpc"}},
+		&stubProvider{name: "my-cloud", resourceTypes: []string{"vpc"}},
+	)
+
+	tests := []struct {
+		resource  string
+		wantLabel string
+		wantErr   string
+	}{
+		{resource: "my-cloud-vpc", wantErr: "invalid resource for element net: ambiguous resource"},
+		{resource: "my-cloud.vpc", wantLabel: "my-cloud.vpc"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.resource, func(t *testing.T) {
+			element := &schema.Element{ID: "net", Resource: tt.resource}
+			err := tp.processElement(element)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("processElement() error = %v", err)
+			}
+			if element.Properties.Label != tt.wantLabel {
+				t.Errorf("Expected the element of %s, got %q", tt.wantLabel, element.Properties.Label)
+			}
+		})
+	}
+}
+
+func TestProcessDiagram_ProviderErrors(t *testing.T) {
+	tp := newTestProcessor(t,
+		&stubProvider{
+			name:          "cloud",
+			resourceTypes: []string{"vpc"},
+			schema: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"region": map[string]interface{}{"type": "string", "enum": []string{"east", "west"}},
+					"subnets": map[string]interface{}{
+						"type": "array",
+						"items": map[string]interface{}{
+							"type": "object",
+							"properties": map[string]interface{}{
+								"tier": map[string]interface{}{"type": "string", "enum": []interface{}{"public", "private"}},
+							},
+						},
+					},
+				},
+				"required": []string{"name"},
+			},
+		},
+		&stubProvider{
+			name:          "broken",
+			resourceTypes: []string{"box"},
+			generateErr:   &providers.ProviderError{Provider: "broken", Resource: "box", Message: "no layout", Code: "GENERATION_FAILED"},
+		},
+	)
+
+	err := tp.ProcessDiagram(&schema.DiagramConfig{Diagram: schema.Diagram{Pages: []schema.Page{{
+		ID: "main",
+		Layers: []schema.Layer{{
+			ID: "infra",
+			Elements: []schema.Element{{ID: "network", Type: schema.ElementTypeGroup, Children: []schema.Element{{
+				Name:     "vpc",
+				Resource: "cloud-vpc",
+				Parameters: map[string]interface{}{
+					"region":  "north",
+					"subnets": []interface{}{map[string]interface{}{"tier": "dmz"}},
+				},
+			}}}},
+		}},
+	}}}})
+
+	var resourceErr *ResourceError
+	if !errors.As(err, &resourceErr) {
+		t.Fatalf("Expected a ResourceError, got %v", err)
+	}
+	if resourceErr.Path != "main/infra/network/vpc" || resourceErr.Resource != "cloud.vpc" {
+		t.Errorf("Expected cloud.vpc at main/infra/network/vpc, got %s at %s", resourceErr.Resource, resourceErr.Path)
+	}
+	want := []FieldProblem{
+		{Field: "name", Code: "REQUIRED", Message: "name is required"},
+		{Field: "region", Code: "INVALID_ENUM", Message: "invalid region north, must be one of [east west]",
+			Value: "north", Set: true, Allowed: []interface{}{"east", "west"}},
+		{Field: "subnets[0].tier", Code: "INVALID_ENUM", Message: "invalid subnets[0].tier dmz, must be one of [public private]",
+			Value: "dmz", Set: true, Allowed: []interface{}{"public", "private"}},
+	}
+	if !reflect.DeepEqual(resourceErr.Fields, want) {
+		t.Errorf("Expected fields\n%+v\ngot\n%+v", want, resourceErr.Fields)
+	}
+	var validationErr *providers.ValidationError
+	if !errors.As(err, &validationErr) || validationErr.Code != "REQUIRED" {
+		t.Errorf("Expected the ValidationError of the provider to remain reachable, got %v", validationErr)
+	}
+	details := resourceErr.Details()
+	for _, line := range []string{"element: main/infra/network/vpc", `value: "north"`, `allowed: "east", "west"`, "value: (not set)"} {
+		if !strings.Contains(details, line) {
+			t.Errorf("Expected details to contain %q, got\n%s", line, details)
+		}
+	}
+
+	err = tp.ProcessDiagram(&schema.DiagramConfig{Diagram: schema.Diagram{Pages: []schema.Page{{
+		ID: "main",
+		Layers: []schema.Layer{{
+			ID: "infra",
+			Elements: []schema.Element{{ID: "network", Type: schema.ElementTypeGroup, Children: []schema.Element{{
+				ID:       "box",
+				Resource: "broken.box",
+			}}}},
+		}},
+	}}}})
+	var providerErr *providers.ProviderError
+	if !errors.As(err, &resourceErr) || !errors.As(err, &providerErr) {
+		t.Fatalf("Expected a ResourceError wrapping a ProviderError, got %v", err)
+	}
+	if resourceErr.Path != "main/infra/network/box" || len(resourceErr.Fields) != 0 || providerErr.Code != "GENERATION_FAILED" {
+		t.Errorf("Expected the GENERATION_FAILED error of main/infra/network/box, got %+v", resourceErr)
+	}
+	if !strings.Contains(resourceErr.Details(), "error: no layout (GENERATION_FAILED)") {
+		t.Errorf("Expected details with the provider error, got\n%s", resourceErr.Details())
+	}
+}
+
+func TestLoadProviderRefs_ConfiguredInstances(t *testing.T) {
+	tp := newTestProcessor(t, &stubProvider{name: "plain", resourceTypes: []string{"box"}})
+	err := tp.registry.RegisterFactory(func() providers.Provider {
+		return &configurableStub{stubProvider: stubProvider{name: "cloud", resourceTypes: []string{"vpc"}}}
+	})
+	if err != nil {
+		t.Fatalf("RegisterFactory() error = %v", err)
+	}
+	err = tp.registry.RegisterFactory(func() providers.Provider {
+		return &stubProvider{name: "fixed", resourceTypes: []string{"box"}}
+	})
+	if err != nil {
+		t.Fatalf("RegisterFactory() error = %v", err)
+	}
+
+	err = tp.LoadProviderRefs([]schema.ProviderRef{
+		{Name: "cloud-light", Source: "LederWorks/hippodamus-provider-cloud", Settings: map[string]interface{}{"style": "light"}},
+		{Name: "cloud-dark", Source: "LederWorks/hippodamus-provider-cloud", Settings: map[string]interface{}{"style": "dark"}},
+	})
+	if err != nil {
+		t.Fatalf("LoadProviderRefs() error = %v", err)
+	}
+
+	tests := []struct {
+		resource  string
+		wantLabel string
+	}{
+		{resource: "cloud-light-vpc", wantLabel: "cloud.vpc light"},
+		{resource: "cloud-dark.vpc", wantLabel: "cloud.vpc dark"},
+		{resource: "cloud-vpc", wantLabel: "cloud.vpc"}, // The registered instance keeps its defaults
+	}
+	for _, tt := range tests {
+		element := &schema.Element{ID: "net", Resource: tt.resource}
+		if err := tp.processElement(element); err != nil {
+			t.Fatalf("processElement(%s) error = %v", tt.resource, err)
+		}
+		if element.Properties.Label != tt.wantLabel {
+			t.Errorf("Expected %s to be generated by %q, got %q", tt.resource, tt.wantLabel, element.Properties.Label)
+		}
+	}
+
+	errorTests := []struct {
+		ref     schema.ProviderRef
+		wantErr string
+	}{
+		{
+			ref:     schema.ProviderRef{Name: "cloud-bad", Source: "LederWorks/hippodamus-provider-cloud", Settings: map[string]interface{}{"style": 1}},
+			wantErr: "failed to configure provider cloud-bad: style must be a string",
+		},
+		{
+			ref:     schema.ProviderRef{Name: "plain-light", Source: "LederWorks/hippodamus-provider-plain", Settings: map[string]interface{}{"style": "light"}},
+			wantErr: "failed to configure provider plain-light: provider plain cannot be instantiated",
+		},
+		{
+			ref:     schema.ProviderRef{Name: "fixed-light", Source: "LederWorks/hippodamus-provider-fixed", Settings: map[string]interface{}{"style": "light"}},
+			wantErr: "failed to configure provider fixed-light: provider fixed does not accept settings",
+		},
+	}
+	for _, tt := range errorTests {
+		err := tp.LoadProviderRefs([]schema.ProviderRef{tt.ref})
+		if err == nil || err.Error() != tt.wantErr {
+			t.Errorf("Expected error %q, got %v", tt.wantErr, err)
+		}
+	}
+}
+
+func TestLoadProviderRefs_FailedConfiguration(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"provider.yaml":      "name: acme\nversion: \"1.0.0\"\nresources:\n  - resources/box.yaml\n",
+		"resources/box.yaml": "type: box\nelement:\n  type: shape\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tp := newTestProcessor(t)
+	ref := schema.ProviderRef{Name: "acme", Type: schema.ProviderTypeCustom, Path: dir, Settings: map[string]interface{}{"style": "light"}}
+	err := tp.LoadProviderRefs([]schema.ProviderRef{ref})
+	if err == nil || err.Error() != "failed to configure provider acme: provider acme does not accept settings" {
+		t.Fatalf("Expected the declarative provider to reject settings, got %v", err)
+	}
+
+	// The provider that failed to be configured is not used
+	if _, _, err := tp.parseProviderResource("acme.box"); err == nil {
+		t.Error("Expected resources of the unconfigured provider to be unknown")
+	}
+}
@@ -0,0 +1,48 @@
+// Package templatetest sets up template processors for the tests of the packages that
+// describe or render templates:
+//
+//	tp := templatetest.NewProcessor(t, map[string]string{
+//		"net/zone.yaml": "name: zone\n...",
+//	}, core.NewCoreProvider())
+package templatetest
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers"
+	"github.com/LederWorks/hippodamus/pkg/templates"
+)
+
+// NewProcessor writes files, keyed by their path in the templates directory, into a
+// temporary directory and returns a processor with the templates loaded from it. The
+// processor resolves resources against a registry of its own holding the given providers.
+func NewProcessor(t testing.TB, files map[string]string, registered ...providers.Provider) *templates.TemplateProcessor {
+	t.Helper()
+
+	dir := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tp := templates.NewTemplateProcessor(dir)
+	registry := providers.NewRegistry()
+	for _, provider := range registered {
+		if err := registry.Register(provider); err != nil {
+			t.Fatalf("Register() error = %v", err)
+		}
+	}
+	tp.SetRegistry(registry)
+
+	if err := tp.LoadTemplates(); err != nil {
+		t.Fatalf("LoadTemplates() error = %v", err)
+	}
+	return tp
+}
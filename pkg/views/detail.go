@@ -0,0 +1,145 @@
+package views
+
+import (
+	"fmt"
+
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// ApplyDetail limits the level of detail of every page in a diagram. A positive depth
+// applies to all pages, otherwise the detail setting of each page is used.
+func ApplyDetail(config *schema.DiagramConfig, depth int) {
+	for i := range config.Diagram.Pages {
+		page := &config.Diagram.Pages[i]
+
+		pageDepth := depth
+		if pageDepth <= 0 {
+			pageDepth = page.Detail
+		}
+		if pageDepth > 0 {
+			*page = LimitDepth(page, pageDepth)
+		}
+	}
+}
+
+// LimitDepth returns a copy of a page in which containers at the given depth are
+// collapsed into summary nodes. Page-level elements are at depth 1.
+//
+// Collapsed containers keep their children, which draw.io hides until the container is
+// expanded, and show the number of hidden elements in a badge. Connectors to hidden
+// descendants are redirected to the collapsed container and connectors that end up
+// between the same elements are merged. Connectors between hidden descendants stay in
+// the container.
+func LimitDepth(page *schema.Page, depth int) schema.Page {
+	state := newPageTree(page)
+
+	limited := *page
+	limited.Layers = make([]schema.Layer, len(page.Layers))
+	for i, layer := range page.Layers {
+		layer.Elements = collapseElements(layer.Elements, 1, depth, "", state)
+		limited.Layers[i] = layer
+	}
+	limited.Elements = collapseElements(page.Elements, 1, depth, "", state)
+
+	for i := range limited.Layers {
+		limited.Layers[i].Elements = state.filterConnectors(limited.Layers[i].Elements, schema.ViewConnectorsRedirect)
+	}
+	limited.Elements = state.filterConnectors(limited.Elements, schema.ViewConnectorsRedirect)
+
+	return limited
+}
+
+// collapseElements collapses the containers of a list of elements in scope at the given depth
+func collapseElements(elements []schema.Element, level, depth int, scope string, state *prunedTree) []schema.Element {
+	collapsed := make([]schema.Element, 0, len(elements))
+	for i := range elements {
+		element := elements[i]
+		if element.Type == schema.ElementTypeConnector {
+			collapsed = append(collapsed, element)
+			continue
+		}
+
+		path := childPath(scope, identifier(&element))
+		state.keep(path)
+
+		if level < depth {
+			element.Children = collapseElements(element.Children, level+1, depth, path, state)
+			collapsed = append(collapsed, element)
+			continue
+		}
+
+		hidden := countDescendants(element.Children)
+		if hidden == 0 {
+			collapsed = append(collapsed, element)
+			continue
+		}
+
+		// Connectors from inside the collapsed container to elements outside of it are
+		// moved next to it, so they stay visible and can be redirected to it
+		removeDescendants(element.Children, path, state)
+		children, connectors := liftConnectors(element.Children, path, path, state)
+
+		element.Children = children
+		element.Properties.Collapsible = true
+		element.Properties.Collapsed = true
+		element.Properties.Badge = fmt.Sprintf("+%d", hidden)
+		state.collapse(path)
+
+		collapsed = append(collapsed, element)
+		collapsed = append(collapsed, connectors...)
+	}
+	return collapsed
+}
+
+// removeDescendants marks the elements of a subtree in scope as removed
+func removeDescendants(elements []schema.Element, scope string, state *prunedTree) {
+	for i := range elements {
+		element := &elements[i]
+		if element.Type == schema.ElementTypeConnector {
+			continue
+		}
+
+		path := childPath(scope, identifier(element))
+		state.remove(path)
+		removeDescendants(element.Children, path, state)
+	}
+}
+
+// liftConnectors removes the connectors with an endpoint outside of the container at
+// the given path from a subtree in scope and returns the subtree and the removed
+// connectors, whose endpoints are rewritten for the scope of the container
+func liftConnectors(elements []schema.Element, scope, container string, state *prunedTree) ([]schema.Element, []schema.Element) {
+	kept := make([]schema.Element, 0, len(elements))
+	var lifted []schema.Element
+	for _, element := range elements {
+		if element.Type == schema.ElementTypeConnector {
+			source, _ := state.locate(element.Properties.Source, scope)
+			target, _ := state.locate(element.Properties.Target, scope)
+			if within(source, container) && within(target, container) {
+				kept = append(kept, element)
+			} else {
+				state.moveConnector(&element, scope, parentPath(container))
+				lifted = append(lifted, element)
+			}
+			continue
+		}
+
+		var connectors []schema.Element
+		element.Children, connectors = liftConnectors(element.Children, childPath(scope, identifier(&element)), container, state)
+		lifted = append(lifted, connectors...)
+		kept = append(kept, element)
+	}
+	return kept, lifted
+}
+
+// countDescendants counts the elements below a container, excluding connectors
+func countDescendants(elements []schema.Element) int {
+	count := 0
+	for i := range elements {
+		if elements[i].Type == schema.ElementTypeConnector {
+			continue
+		}
+		count += 1 + countDescendants(elements[i].Children)
+	}
+	return count
+}
@@ -0,0 +1,200 @@
+package views
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// connectorPaths returns the connectors in a tree of elements as "id: source -> target",
+// followed by the label of connectors that have one
+func connectorPaths(elements []schema.Element) []string {
+	var paths []string
+	for _, element := range elements {
+		if element.Type == schema.ElementTypeConnector {
+			path := fmt.Sprintf("%s: %s -> %s", element.ID, element.Properties.Source, element.Properties.Target)
+			if element.Properties.Label != "" {
+				path += " (" + element.Properties.Label + ")"
+			}
+			paths = append(paths, path)
+		}
+		paths = append(paths, connectorPaths(element.Children)...)
+	}
+	return paths
+}
+
+func TestLimitDepth(t *testing.T) {
+	elements := []schema.Element{
+		{
+			ID:   "region",
+			Name: "Region",
+			Type: schema.ElementTypeGroup,
+			Children: []schema.Element{
+				{
+					ID:   "vpc",
+					Name: "VPC",
+					Type: schema.ElementTypeGroup,
+					Children: []schema.Element{
+						{ID: "web", Type: schema.ElementTypeShape},
+						{ID: "app", Type: schema.ElementTypeShape},
+						{ID: "web-to-app", Type: schema.ElementTypeConnector, Properties: schema.ElementProperties{Source: "web", Target: "app"}},
+						{ID: "web-to-db", Type: schema.ElementTypeConnector, Properties: schema.ElementProperties{Source: "web", Target: "db"}},
+					},
+				},
+			},
+		},
+		{ID: "db", Type: schema.ElementTypeShape},
+		{ID: "app-to-db", Type: schema.ElementTypeConnector, Properties: schema.ElementProperties{Source: "app", Target: "db", Label: "SQL"}},
+	}
+
+	tests := []struct {
+		name       string
+		depth      int
+		badges     map[string]string // Badges of the collapsed containers
+		expanded   []string
+		connectors []string
+	}{
+		{
+			// The hidden children are kept for expanding region in draw.io, and web-to-db
+			// and app-to-db both become region -> db and are merged
+			name:       "top-level containers",
+			depth:      1,
+			badges:     map[string]string{"region": "+3"},
+			connectors: []string{"web-to-app: web -> app", "web-to-db: region -> db (2 connections)"},
+		},
+		{
+			// The connector lifted out of vpc comes first and stands in for app-to-db
+			name:       "nested containers",
+			depth:      2,
+			badges:     map[string]string{"vpc": "+2"},
+			expanded:   []string{"region"},
+			connectors: []string{"web-to-app: web -> app", "web-to-db: vpc -> db (2 connections)"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page := schema.Page{ID: "main", Name: "Main", Elements: elements}
+			limited := LimitDepth(&page, tt.depth)
+
+			for id, badge := range tt.badges {
+				container := findElement(limited.Elements, id)
+				if container == nil || !container.Properties.Collapsed || !container.Properties.Collapsible {
+					t.Fatalf("Expected %s to be collapsed", id)
+				}
+				if container.Properties.Badge != badge || container.Properties.Label != "" {
+					t.Errorf("Expected badge '%s' and unchanged label of %s, got badge '%s' label '%s'", badge, id, container.Properties.Badge, container.Properties.Label)
+				}
+			}
+			for _, id := range tt.expanded {
+				if container := findElement(limited.Elements, id); container == nil || container.Properties.Collapsed {
+					t.Errorf("Expected %s to stay expanded", id)
+				}
+			}
+			for _, id := range []string{"vpc", "web", "app"} {
+				if findElement(limited.Elements, id) == nil {
+					t.Errorf("Expected %s to be kept", id)
+				}
+			}
+			if got := connectorPaths(limited.Elements); !reflect.DeepEqual(got, tt.connectors) {
+				t.Errorf("Expected connectors %v, got %v", tt.connectors, got)
+			}
+
+			// The original page is left untouched
+			if len(page.Elements[0].Children) != 1 || len(page.Elements) != 3 {
+				t.Error("Expected original page to keep its elements")
+			}
+		})
+	}
+}
+
+func TestLimitDepth_DuplicateChildIDs(t *testing.T) {
+	// shop and crm are instances of the same composite, with the same child IDs
+	tiers := func(connectors ...schema.Element) []schema.Element {
+		return append([]schema.Element{
+			{ID: "web", Type: schema.ElementTypeShape},
+			{ID: "app", Type: schema.ElementTypeShape},
+			{ID: "web-to-app", Type: schema.ElementTypeConnector, Properties: schema.ElementProperties{Source: "web", Target: "app"}},
+		}, connectors...)
+	}
+	page := schema.Page{ID: "main", Name: "Main", Elements: []schema.Element{
+		{ID: "lb", Type: schema.ElementTypeShape},
+		{ID: "shop", Type: schema.ElementTypeGroup, Children: tiers()},
+		{
+			ID:   "platform",
+			Type: schema.ElementTypeGroup,
+			Children: []schema.Element{{
+				ID:       "crm",
+				Type:     schema.ElementTypeGroup,
+				Children: tiers(schema.Element{ID: "web-to-lb", Type: schema.ElementTypeConnector, Properties: schema.ElementProperties{Source: "web", Target: "lb"}}),
+			}},
+		},
+		{ID: "lb-to-shop", Type: schema.ElementTypeConnector, Properties: schema.ElementProperties{Source: "lb", Target: "shop/web"}},
+		{ID: "lb-to-crm", Type: schema.ElementTypeConnector, Properties: schema.ElementProperties{Source: "lb", Target: "platform/crm/web"}},
+	}}
+
+	// Only crm is collapsed, the web tier of shop stays visible
+	limited := LimitDepth(&page, 2)
+
+	want := []string{
+		"web-to-app: web -> app",
+		"web-to-app: web -> app",
+		"web-to-lb: crm -> lb",
+		"lb-to-shop: lb -> shop/web",
+		"lb-to-crm: lb -> crm",
+	}
+	if got := connectorPaths(limited.Elements); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected connectors %v, got %v", want, got)
+	}
+
+	if crm := findElement(limited.Elements, "crm"); crm == nil || crm.Properties.Badge != "+2" {
+		t.Error("Expected crm to be collapsed")
+	}
+	if shop := findElement(limited.Elements, "shop"); shop == nil || shop.Properties.Collapsed {
+		t.Error("Expected shop to stay expanded")
+	}
+}
+
+func TestApplyDetail(t *testing.T) {
+	config := &schema.DiagramConfig{
+		Diagram: schema.Diagram{Pages: []schema.Page{
+			{
+				ID:     "detail",
+				Name:   "Detail",
+				Detail: 2,
+				Elements: []schema.Element{{
+					ID:       "region",
+					Type:     schema.ElementTypeGroup,
+					Children: []schema.Element{{ID: "vpc", Type: schema.ElementTypeGroup, Children: []schema.Element{{ID: "web", Type: schema.ElementTypeShape}}}},
+				}},
+			},
+			{
+				ID:   "full",
+				Name: "Full",
+				Elements: []schema.Element{{
+					ID:       "region",
+					Type:     schema.ElementTypeGroup,
+					Children: []schema.Element{{ID: "vpc", Type: schema.ElementTypeGroup, Children: []schema.Element{{ID: "web", Type: schema.ElementTypeShape}}}},
+				}},
+			},
+		}},
+	}
+
+	ApplyDetail(config, 0)
+
+	if vpc := findElement(config.Diagram.Pages[0].Elements, "vpc"); vpc == nil || !vpc.Properties.Collapsed {
+		t.Error("Expected page detail setting to collapse vpc")
+	}
+
+	if findElement(config.Diagram.Pages[1].Elements, "web") == nil {
+		t.Error("Expected page without detail setting to be rendered completely")
+	}
+
+	ApplyDetail(config, 1)
+
+	if region := findElement(config.Diagram.Pages[1].Elements, "region"); region == nil || !region.Properties.Collapsed {
+		t.Error("Expected depth to apply to every page")
+	}
+}
@@ -0,0 +1,371 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+const (
+	// breadcrumbHeight is the space reserved above the content of detail pages
+	breadcrumbHeight = 40
+
+	// breadcrumbSeparator separates the entries of a breadcrumb trail
+	breadcrumbSeparator = " › "
+)
+
+// breadcrumb is an entry in the trail leading to a detail page
+type breadcrumb struct {
+	pageID string
+	name   string
+}
+
+// ResourceResolver returns the provider.resource form of a resource reference, which
+// may also be written as provider/resource or provider-resource
+type ResourceResolver func(resource string) (string, bool)
+
+// drillDown splits a page into an overview page and linked detail pages
+type drillDown struct {
+	selectors map[string]bool
+	resolve   ResourceResolver
+	pageIDs   map[string]bool
+	pages     []schema.Page
+	err       error
+}
+
+// splitPage is a page whose matching containers are moved to detail pages
+type splitPage struct {
+	id         string
+	trail      []breadcrumb
+	state      *prunedTree
+	connectors []pageConnector // All connectors of the page
+}
+
+// pageConnector is a connector of a page with the paths of its container and endpoints,
+// endpoints that are not elements of the page have an empty path
+type pageConnector struct {
+	element schema.Element
+	scope   string
+	source  string
+	target  string
+}
+
+// ApplyDrillDown moves containers matching the drill-down selectors of each page, in
+// any of its layers, to detail pages of their own. Non-empty selectors apply to every
+// page and override the drillDown setting of the pages. Resource selectors match
+// elements in any of the forms resolve accepts, and only as written when resolve is nil.
+//
+// A container moved to a detail page is shown without its children and links to
+// its detail page, which again moves matching containers further down. Detail pages
+// start with breadcrumb links back to the pages above them.
+func ApplyDrillDown(config *schema.DiagramConfig, selectors []string, resolve ResourceResolver) error {
+	pageIDs := make(map[string]bool, len(config.Diagram.Pages))
+	for _, page := range config.Diagram.Pages {
+		pageIDs[page.ID] = true
+	}
+
+	pages := make([]schema.Page, 0, len(config.Diagram.Pages))
+	for i := range config.Diagram.Pages {
+		page := &config.Diagram.Pages[i]
+
+		pageSelectors := selectors
+		if len(pageSelectors) == 0 {
+			pageSelectors = page.DrillDown
+		}
+		if len(pageSelectors) == 0 {
+			pages = append(pages, *page)
+			continue
+		}
+
+		split := &drillDown{
+			selectors: make(map[string]bool, len(pageSelectors)),
+			resolve:   resolve,
+			pageIDs:   pageIDs,
+		}
+		for _, selector := range pageSelectors {
+			split.selectors[selector] = true
+			split.selectors[split.resource(selector)] = true
+		}
+
+		state := newPageTree(page)
+		root := &splitPage{
+			id:    page.ID,
+			trail: []breadcrumb{{pageID: page.ID, name: page.Name}},
+			state: state,
+		}
+		for _, layer := range page.Layers {
+			root.connectors = append(root.connectors, pageConnectors(layer.Elements, "", state)...)
+		}
+		root.connectors = append(root.connectors, pageConnectors(page.Elements, "", state)...)
+
+		overview := *page
+		overview.Layers = make([]schema.Layer, len(page.Layers))
+		for j, layer := range page.Layers {
+			layer.Elements = split.splitElements(layer.Elements, root, "")
+			overview.Layers[j] = layer
+		}
+		overview.Elements = split.splitElements(page.Elements, root, "")
+
+		for j := range overview.Layers {
+			overview.Layers[j].Elements = state.filterConnectors(overview.Layers[j].Elements, schema.ViewConnectorsRedirect)
+		}
+		overview.Elements = state.filterConnectors(overview.Elements, schema.ViewConnectorsRedirect)
+		if split.err != nil {
+			return fmt.Errorf("failed to split page %s: %w", page.ID, split.err)
+		}
+
+		pages = append(pages, overview)
+		pages = append(pages, split.pages...)
+	}
+
+	config.Diagram.Pages = pages
+	return nil
+}
+
+// ParseSelectors splits a comma-separated list of drill-down selectors
+func ParseSelectors(value string) []string {
+	var selectors []string
+	for _, selector := range strings.Split(value, ",") {
+		if selector = strings.TrimSpace(selector); selector != "" {
+			selectors = append(selectors, selector)
+		}
+	}
+	return selectors
+}
+
+// matches reports whether an element is moved to a detail page
+func (d *drillDown) matches(element *schema.Element) bool {
+	for _, key := range []string{string(element.Type), element.Template, d.resource(element.Resource), element.Ref} {
+		if key != "" && d.selectors[key] {
+			return true
+		}
+	}
+	return false
+}
+
+// resource returns the provider.resource form of a resource reference, or the reference
+// as written when it does not resolve
+func (d *drillDown) resource(resource string) string {
+	if d.resolve == nil || resource == "" {
+		return resource
+	}
+	if resolved, ok := d.resolve(resource); ok {
+		return resolved
+	}
+	return resource
+}
+
+// splitElements replaces matching containers in scope with linked summary nodes
+func (d *drillDown) splitElements(elements []schema.Element, page *splitPage, scope string) []schema.Element {
+	split := make([]schema.Element, 0, len(elements))
+	for i := range elements {
+		element := elements[i]
+		if element.Type == schema.ElementTypeConnector {
+			split = append(split, element)
+			continue
+		}
+
+		id := identifier(&element)
+		path := childPath(scope, id)
+		page.state.keep(path)
+
+		if !d.matches(&element) || countDescendants(element.Children) == 0 {
+			element.Children = d.splitElements(element.Children, page, path)
+			split = append(split, element)
+			continue
+		}
+
+		detailID := fmt.Sprintf("%s-%s", page.id, id)
+		d.addDetailPage(detailID, detailPageName(&element), path, element.Children, page)
+
+		// Connectors from inside the container to elements outside of it stay visible on
+		// this page through the summary node
+		removeDescendants(element.Children, path, page.state)
+		_, connectors := liftConnectors(element.Children, path, path, page.state)
+
+		element.Children = nil
+		element.Properties.Link = pageLink(detailID)
+
+		split = append(split, element)
+		split = append(split, connectors...)
+	}
+	return split
+}
+
+// detailPageName returns the name of the detail page of a container, which titles the
+// page and ends its breadcrumb trail
+func detailPageName(element *schema.Element) string {
+	if element.Properties.Label != "" {
+		return element.Properties.Label
+	}
+	if element.Name != "" {
+		return element.Name
+	}
+	return element.ID
+}
+
+// addDetailPage adds the detail page of the container at path on the parent page,
+// followed by its own detail pages
+func (d *drillDown) addDetailPage(pageID, name, container string, children []schema.Element, parent *splitPage) {
+	if d.pageIDs[pageID] {
+		if d.err == nil {
+			d.err = fmt.Errorf("detail page ID %s is already in use", pageID)
+		}
+		return
+	}
+	d.pageIDs[pageID] = true
+
+	page := schema.Page{ID: pageID, Name: name}
+	index := len(d.pages)
+	d.pages = append(d.pages, page)
+
+	// The paths of the detail page start below the container. Connectors between two of
+	// its descendants belong here wherever they are drawn, connectors leaving it do not.
+	state := newPrunedTree(children)
+	elements := detailElements(children, container, "", parent.state, state)
+	for _, connector := range parent.connectors {
+		if connector.scope == container || within(connector.scope, container) {
+			continue
+		}
+		if within(connector.source, container) && within(connector.target, container) {
+			element := connector.element
+			element.Properties.Source = state.reference(strings.TrimPrefix(connector.source, container+"/"), "")
+			element.Properties.Target = state.reference(strings.TrimPrefix(connector.target, container+"/"), "")
+			elements = append(elements, element)
+		}
+	}
+
+	detail := &splitPage{
+		id:         pageID,
+		trail:      append(append([]breadcrumb{}, parent.trail...), breadcrumb{pageID: pageID, name: name}),
+		state:      state,
+		connectors: pageConnectors(elements, "", state),
+	}
+	elements = d.splitElements(elements, detail, "")
+	elements = state.filterConnectors(elements, schema.ViewConnectorsRedirect)
+
+	// Make room for the breadcrumbs above the content
+	for i := range elements {
+		if elements[i].Type != schema.ElementTypeConnector {
+			elements[i].Properties.Y += breadcrumbHeight
+		}
+	}
+
+	breadcrumbs := breadcrumbElements(parent.trail, pageID, name)
+	ids := identifiers(elements)
+	for i := range breadcrumbs {
+		if ids[breadcrumbs[i].ID] && d.err == nil {
+			d.err = fmt.Errorf("breadcrumb ID %s of detail page %s is already in use", breadcrumbs[i].ID, pageID)
+		}
+	}
+
+	page.Elements = append(breadcrumbs, elements...)
+	d.pages[index] = page
+}
+
+// detailElements copies the descendants of the container at path on the parent page
+// for its detail page, starting at scope. Connectors with an endpoint outside of the
+// container are dropped and the endpoints of the others are rewritten for the detail page.
+func detailElements(elements []schema.Element, container, scope string, parent, detail *prunedTree) []schema.Element {
+	copied := make([]schema.Element, 0, len(elements))
+	for _, element := range elements {
+		if element.Type != schema.ElementTypeConnector {
+			element.Children = detailElements(element.Children, container, childPath(scope, identifier(&element)), parent, detail)
+			copied = append(copied, element)
+			continue
+		}
+
+		dangling := false
+		for _, endpoint := range []*string{&element.Properties.Source, &element.Properties.Target} {
+			if *endpoint == "" {
+				continue
+			}
+			path, ok := parent.locate(*endpoint, childPath(container, scope))
+			if !ok || !within(path, container) {
+				dangling = true
+				break
+			}
+			*endpoint = detail.reference(strings.TrimPrefix(path, container+"/"), scope)
+		}
+		if !dangling {
+			copied = append(copied, element)
+		}
+	}
+	return copied
+}
+
+// breadcrumbElements creates the linked text elements leading back to the pages above
+// the current page
+func breadcrumbElements(trail []breadcrumb, pageID, name string) []schema.Element {
+	elements := make([]schema.Element, 0, len(trail)+1)
+	x := 0.0
+	for _, entry := range trail {
+		label := entry.name + breadcrumbSeparator
+		elements = append(elements, breadcrumbElement(entry.pageID, label, x, pageLink(entry.pageID)))
+		x += textWidth(label)
+	}
+
+	last := breadcrumbElement(pageID, name, x, "")
+	last.Style.FontStyle = "1"
+	return append(elements, last)
+}
+
+// breadcrumbElement creates a single breadcrumb entry for a page, identified by the page
+func breadcrumbElement(pageID, label string, x float64, link string) schema.Element {
+	return schema.Element{
+		ID:   "breadcrumb-" + pageID,
+		Name: strings.TrimSuffix(label, breadcrumbSeparator),
+		Type: schema.ElementTypeText,
+		Properties: schema.ElementProperties{
+			X:      x,
+			Y:      0,
+			Width:  textWidth(label),
+			Height: 20,
+			Label:  label,
+			Link:   link,
+		},
+	}
+}
+
+// textWidth estimates the width needed to display a label
+func textWidth(label string) float64 {
+	return float64(len([]rune(label))*7 + 10)
+}
+
+// pageLink returns a draw.io link to a page
+func pageLink(pageID string) string {
+	return "data:page/id," + pageID
+}
+
+// pageConnectors returns the connectors in a tree of elements in scope with the paths
+// of their endpoints on the page
+func pageConnectors(elements []schema.Element, scope string, state *prunedTree) []pageConnector {
+	var connectors []pageConnector
+	for i := range elements {
+		element := &elements[i]
+		if element.Type != schema.ElementTypeConnector {
+			connectors = append(connectors, pageConnectors(element.Children, childPath(scope, identifier(element)), state)...)
+			continue
+		}
+
+		source, _ := state.locate(element.Properties.Source, scope)
+		target, _ := state.locate(element.Properties.Target, scope)
+		connectors = append(connectors, pageConnector{element: *element, scope: scope, source: source, target: target})
+	}
+	return connectors
+}
+
+// identifiers returns the identifiers of all elements in a tree
+func identifiers(elements []schema.Element) map[string]bool {
+	ids := make(map[string]bool)
+	var walk func(elements []schema.Element)
+	walk = func(elements []schema.Element) {
+		for i := range elements {
+			ids[identifier(&elements[i])] = true
+			walk(elements[i].Children)
+		}
+	}
+	walk(elements)
+	return ids
+}
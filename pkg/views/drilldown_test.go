@@ -0,0 +1,358 @@
+package views
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+func TestApplyDrillDown(t *testing.T) {
+	config := &schema.DiagramConfig{
+		Diagram: schema.Diagram{
+			Pages: []schema.Page{
+				{
+					ID:        "tenant",
+					Name:      "Tenant",
+					DrillDown: []string{"group"},
+					Elements: []schema.Element{
+						{
+							ID:   "platform",
+							Name: "Platform",
+							Type: schema.ElementTypeGroup,
+							Children: []schema.Element{
+								{
+									ID:   "connectivity",
+									Name: "Connectivity",
+									Type: schema.ElementTypeGroup,
+									Children: []schema.Element{
+										{ID: "hub", Type: schema.ElementTypeShape, Properties: schema.ElementProperties{Y: 10}},
+									},
+								},
+								{ID: "firewall", Type: schema.ElementTypeShape},
+							},
+						},
+						{ID: "shop", Type: schema.ElementTypeShape},
+						{ID: "shop-to-hub", Type: schema.ElementTypeConnector, Properties: schema.ElementProperties{Source: "shop", Target: "hub"}},
+						{ID: "hub-to-firewall", Type: schema.ElementTypeConnector, Properties: schema.ElementProperties{Source: "hub", Target: "firewall"}},
+					},
+				},
+			},
+		},
+	}
+
+	if err := ApplyDrillDown(config, nil, nil); err != nil {
+		t.Fatalf("ApplyDrillDown() error = %v", err)
+	}
+
+	pages := config.Diagram.Pages
+	if len(pages) != 3 {
+		t.Fatalf("Expected overview and two detail pages, got %d pages", len(pages))
+	}
+
+	if pages[1].ID != "tenant-platform" || pages[2].ID != "tenant-platform-connectivity" {
+		t.Errorf("Unexpected detail page IDs %s and %s", pages[1].ID, pages[2].ID)
+	}
+
+	// The overview links to the detail page of the container
+	platform := findElement(pages[0].Elements, "platform")
+	if platform == nil || len(platform.Children) != 0 {
+		t.Fatal("Expected platform to be shown without children on the overview")
+	}
+	if platform.Properties.Link != "data:page/id,tenant-platform" {
+		t.Errorf("Expected link to detail page, got '%s'", platform.Properties.Link)
+	}
+
+	if connector := findElement(pages[0].Elements, "shop-to-hub"); connector == nil || connector.Properties.Target != "platform" {
+		t.Error("Expected shop-to-hub to be redirected to platform on the overview")
+	}
+	if findElement(pages[0].Elements, "hub-to-firewall") != nil {
+		t.Error("Expected connector within platform to be left to the detail page")
+	}
+
+	// The detail page shows the children, connectors between them and breadcrumbs
+	detail := pages[1]
+	if findElement(detail.Elements, "firewall") == nil {
+		t.Error("Expected firewall on the platform detail page")
+	}
+	if connector := findElement(detail.Elements, "hub-to-firewall"); connector == nil || connector.Properties.Source != "connectivity" {
+		t.Error("Expected hub-to-firewall to be redirected to connectivity on the platform detail page")
+	}
+	if findElement(detail.Elements, "shop-to-hub") != nil {
+		t.Error("Expected connector leaving the container to be dropped from the detail page")
+	}
+
+	breadcrumbs := pages[2].Elements[:3]
+	for i, link := range []string{"data:page/id,tenant", "data:page/id,tenant-platform", ""} {
+		if breadcrumbs[i].Properties.Link != link {
+			t.Errorf("Expected breadcrumb %d to link to '%s', got '%s'", i, link, breadcrumbs[i].Properties.Link)
+		}
+	}
+	for i, id := range []string{"breadcrumb-tenant", "breadcrumb-tenant-platform", "breadcrumb-tenant-platform-connectivity"} {
+		if breadcrumbs[i].ID != id {
+			t.Errorf("Expected breadcrumb %d to have ID %s, got %s", i, id, breadcrumbs[i].ID)
+		}
+	}
+
+	if hub := findElement(pages[2].Elements, "hub"); hub == nil || hub.Properties.Y != 10+breadcrumbHeight {
+		t.Error("Expected detail page content to be moved below the breadcrumbs")
+	}
+}
+
+func TestApplyDrillDown_DuplicateChildIDs(t *testing.T) {
+	tiers := func(connectors ...schema.Element) []schema.Element {
+		return append([]schema.Element{
+			{ID: "web", Type: schema.ElementTypeShape},
+			{ID: "db", Type: schema.ElementTypeShape},
+		}, connectors...)
+	}
+	config := &schema.DiagramConfig{
+		Diagram: schema.Diagram{
+			Pages: []schema.Page{
+				{
+					ID:        "tenant",
+					Name:      "Tenant",
+					DrillDown: []string{"group"},
+					Elements: []schema.Element{
+						{ID: "a", Type: schema.ElementTypeGroup, Children: tiers(
+							schema.Element{ID: "web-to-b", Type: schema.ElementTypeConnector, Properties: schema.ElementProperties{Source: "web", Target: "b/db"}},
+						)},
+						{ID: "b", Type: schema.ElementTypeGroup, Children: tiers(
+							schema.Element{ID: "web-to-db", Type: schema.ElementTypeConnector, Properties: schema.ElementProperties{Source: "web", Target: "db"}},
+						)},
+						{ID: "c", Type: schema.ElementTypeGroup, Children: tiers()},
+						{ID: "a-to-b", Type: schema.ElementTypeConnector, Properties: schema.ElementProperties{Source: "a/web", Target: "b/db"}},
+						{ID: "c-web-to-db", Type: schema.ElementTypeConnector, Properties: schema.ElementProperties{Source: "c/web", Target: "c/db"}},
+					},
+				},
+			},
+		},
+	}
+
+	if err := ApplyDrillDown(config, nil, nil); err != nil {
+		t.Fatalf("ApplyDrillDown() error = %v", err)
+	}
+
+	// Connectors only appear on the detail page of a container holding both of their endpoints
+	want := map[string][]string{
+		"tenant":   {"web-to-b: a -> b (2 connections)"},
+		"tenant-a": nil,
+		"tenant-b": {"web-to-db: web -> db"},
+		"tenant-c": {"c-web-to-db: web -> db"},
+	}
+	for _, page := range config.Diagram.Pages {
+		if got := connectorPaths(page.Elements); !reflect.DeepEqual(got, want[page.ID]) {
+			t.Errorf("Expected connectors %v on page %s, got %v", want[page.ID], page.ID, got)
+		}
+	}
+}
+
+func TestApplyDrillDown_Selectors(t *testing.T) {
+	tests := []struct {
+		name      string
+		selectors []string
+		pageIDs   []string
+	}{
+		{name: "page setting", pageIDs: []string{"tenant", "tenant-platform", "tenant-platform-connectivity"}},
+		{name: "model reference", selectors: ParseSelectors(" connectivity , "), pageIDs: []string{"tenant", "tenant-connectivity"}},
+		{name: "element type", selectors: ParseSelectors("shape"), pageIDs: []string{"tenant"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &schema.DiagramConfig{
+				Diagram: schema.Diagram{
+					Pages: []schema.Page{
+						{
+							ID:        "tenant",
+							Name:      "Tenant",
+							DrillDown: []string{"group"},
+							Elements: []schema.Element{
+								{
+									ID:   "platform",
+									Name: "Platform",
+									Type: schema.ElementTypeGroup,
+									Children: []schema.Element{
+										{
+											ID:       "connectivity",
+											Name:     "Connectivity",
+											Type:     schema.ElementTypeGroup,
+											Ref:      "connectivity",
+											Children: []schema.Element{{ID: "hub", Type: schema.ElementTypeShape}},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+
+			if err := ApplyDrillDown(config, tt.selectors, nil); err != nil {
+				t.Fatalf("ApplyDrillDown() error = %v", err)
+			}
+
+			var pageIDs []string
+			for _, page := range config.Diagram.Pages {
+				pageIDs = append(pageIDs, page.ID)
+			}
+			if strings.Join(pageIDs, ",") != strings.Join(tt.pageIDs, ",") {
+				t.Errorf("Expected pages %v, got %v", tt.pageIDs, pageIDs)
+			}
+		})
+	}
+}
+
+func TestApplyDrillDown_ResourceForms(t *testing.T) {
+	// resolve accepts the forms the template processor accepts for the cloud provider
+	resolve := func(resource string) (string, bool) {
+		for _, prefix := range []string{"cloud.", "cloud/", "cloud-"} {
+			if strings.HasPrefix(resource, prefix) {
+				return "cloud." + strings.TrimPrefix(resource, prefix), true
+			}
+		}
+		return "", false
+	}
+
+	tests := []struct {
+		selector string
+		resource string
+	}{
+		{selector: "cloud.vpc", resource: "cloud.vpc"},
+		{selector: "cloud.vpc", resource: "cloud/vpc"},
+		{selector: "cloud.vpc", resource: "cloud-vpc"},
+		{selector: "cloud-vpc", resource: "cloud.vpc"},
+		{selector: "cloud/vpc", resource: "cloud-vpc"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.selector+" "+tt.resource, func(t *testing.T) {
+			config := &schema.DiagramConfig{
+				Diagram: schema.Diagram{
+					Pages: []schema.Page{
+						{
+							ID:   "network",
+							Name: "Network",
+							Elements: []schema.Element{
+								{
+									ID:       "vpc",
+									Name:     "VPC",
+									Resource: tt.resource,
+									Children: []schema.Element{{ID: "subnet", Resource: "cloud-subnet"}},
+								},
+							},
+						},
+					},
+				},
+			}
+
+			if err := ApplyDrillDown(config, []string{tt.selector}, resolve); err != nil {
+				t.Fatalf("ApplyDrillDown() error = %v", err)
+			}
+			if pages := config.Diagram.Pages; len(pages) != 2 || pages[1].ID != "network-vpc" {
+				t.Errorf("Expected selector %s to move resource %s to a detail page, got %d pages", tt.selector, tt.resource, len(pages))
+			}
+		})
+	}
+}
+
+func TestApplyDrillDown_Layers(t *testing.T) {
+	config := &schema.DiagramConfig{
+		Diagram: schema.Diagram{
+			Pages: []schema.Page{
+				{
+					ID:        "tenant",
+					Name:      "Tenant",
+					DrillDown: []string{"group"},
+					Layers: []schema.Layer{
+						{
+							ID:      "infra",
+							Name:    "Infrastructure",
+							Visible: true,
+							Elements: []schema.Element{
+								{
+									ID:   "platform",
+									Name: "Platform",
+									Type: schema.ElementTypeGroup,
+									Children: []schema.Element{
+										{ID: "hub", Type: schema.ElementTypeShape},
+										{ID: "firewall", Type: schema.ElementTypeShape},
+									},
+								},
+								{ID: "shop", Type: schema.ElementTypeShape},
+								{ID: "shop-to-hub", Type: schema.ElementTypeConnector, Properties: schema.ElementProperties{Source: "shop", Target: "hub"}},
+								{ID: "hub-to-firewall", Type: schema.ElementTypeConnector, Properties: schema.ElementProperties{Source: "hub", Target: "firewall"}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if err := ApplyDrillDown(config, nil, nil); err != nil {
+		t.Fatalf("ApplyDrillDown() error = %v", err)
+	}
+
+	pages := config.Diagram.Pages
+	if len(pages) != 2 || pages[1].ID != "tenant-platform" {
+		t.Fatalf("Expected a detail page for the container of the layer, got %d pages", len(pages))
+	}
+
+	layer := pages[0].Layers[0]
+	if platform := findElement(layer.Elements, "platform"); platform == nil || len(platform.Children) != 0 || platform.Properties.Link != "data:page/id,tenant-platform" {
+		t.Error("Expected platform to link to its detail page from the layer")
+	}
+	if connector := findElement(layer.Elements, "shop-to-hub"); connector == nil || connector.Properties.Target != "platform" {
+		t.Error("Expected shop-to-hub to be redirected to platform in the layer")
+	}
+	if findElement(pages[1].Elements, "hub-to-firewall") == nil {
+		t.Error("Expected connector within platform on its detail page")
+	}
+}
+
+func TestApplyDrillDown_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		pages []schema.Page
+	}{
+		{
+			name: "existing page ID",
+			pages: []schema.Page{
+				{
+					ID:        "tenant",
+					Name:      "Tenant",
+					DrillDown: []string{"group"},
+					Elements: []schema.Element{
+						{ID: "platform", Type: schema.ElementTypeGroup, Children: []schema.Element{{ID: "hub", Type: schema.ElementTypeShape}}},
+					},
+				},
+				{ID: "tenant-platform", Name: "Existing"},
+			},
+		},
+		{
+			name: "element with a breadcrumb ID",
+			pages: []schema.Page{
+				{
+					ID:        "tenant",
+					Name:      "Tenant",
+					DrillDown: []string{"group"},
+					Elements: []schema.Element{
+						{ID: "platform", Type: schema.ElementTypeGroup, Children: []schema.Element{{ID: "breadcrumb-tenant", Type: schema.ElementTypeShape}}},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &schema.DiagramConfig{Diagram: schema.Diagram{Pages: tt.pages}}
+
+			if err := ApplyDrillDown(config, nil, nil); err == nil {
+				t.Errorf("Expected %s to cause an error", tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Expression is a compiled tag expression such as "network && !internal"
+type Expression interface {
+	// Match reports whether the given set of tags satisfies the expression
+	Match(tags map[string]bool) bool
+	String() string
+}
+
+type tagExpression struct {
+	tag string
+}
+
+func (e *tagExpression) Match(tags map[string]bool) bool { return tags[e.tag] }
+func (e *tagExpression) String() string                  { return e.tag }
+
+type notExpression struct {
+	operand Expression
+}
+
+func (e *notExpression) Match(tags map[string]bool) bool { return !e.operand.Match(tags) }
+func (e *notExpression) String() string                  { return "!" + e.operand.String() }
+
+type binaryExpression struct {
+	operator    string
+	left, right Expression
+}
+
+func (e *binaryExpression) Match(tags map[string]bool) bool {
+	if e.operator == "&&" {
+		return e.left.Match(tags) && e.right.Match(tags)
+	}
+	return e.left.Match(tags) || e.right.Match(tags)
+}
+
+func (e *binaryExpression) String() string {
+	return fmt.Sprintf("(%s %s %s)", e.left, e.operator, e.right)
+}
+
+// ParseExpression parses a tag expression. Tags are combined with && (and), || (or),
+// ! (not) and parentheses, with the usual precedence: ! binds tighter than &&, which
+// binds tighter than ||.
+func ParseExpression(input string) (Expression, error) {
+	tokens, err := tokenize(input)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("empty tag expression")
+	}
+
+	p := &parser{tokens: tokens}
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, fmt.Errorf("invalid tag expression %q: %w", input, err)
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("invalid tag expression %q: unexpected %q", input, p.tokens[p.pos])
+	}
+
+	return expr, nil
+}
+
+// tokenize splits a tag expression into operators, parentheses and tags
+func tokenize(input string) ([]string, error) {
+	var tokens []string
+	runes := []rune(input)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(' || r == ')' || r == '!':
+			tokens = append(tokens, string(r))
+			i++
+		case r == '&' || r == '|':
+			if i+1 >= len(runes) || runes[i+1] != r {
+				return nil, fmt.Errorf("invalid operator %q in tag expression %q, use %q", string(r), input, strings.Repeat(string(r), 2))
+			}
+			tokens = append(tokens, string(runes[i:i+2]))
+			i += 2
+		case isTagRune(r):
+			start := i
+			for i < len(runes) && isTagRune(runes[i]) {
+				i++
+			}
+			tokens = append(tokens, string(runes[start:i]))
+		default:
+			return nil, fmt.Errorf("unexpected character %q in tag expression %q", string(r), input)
+		}
+	}
+
+	return tokens, nil
+}
+
+// isTagRune reports whether a rune can be part of a tag name
+func isTagRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("-_.:/", r)
+}
+
+// parser is a recursive descent parser for tag expressions
+type parser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *parser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *parser) parseOr() (Expression, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek() == "||" {
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryExpression{operator: "||", left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseAnd() (Expression, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek() == "&&" {
+		p.pos++
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryExpression{operator: "&&", left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseUnary() (Expression, error) {
+	switch token := p.peek(); token {
+	case "":
+		return nil, fmt.Errorf("unexpected end of expression")
+	case "!":
+		p.pos++
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &notExpression{operand: operand}, nil
+	case "(":
+		p.pos++
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ")" {
+			return nil, fmt.Errorf("missing closing parenthesis")
+		}
+		p.pos++
+		return expr, nil
+	case ")", "&&", "||":
+		return nil, fmt.Errorf("unexpected %q", token)
+	default:
+		p.pos++
+		return &tagExpression{tag: token}, nil
+	}
+}
@@ -0,0 +1,142 @@
+package views
+
+import (
+	"fmt"
+
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// Filter removes elements from pages based on tag expressions
+type Filter struct {
+	Include    Expression // Elements must match this expression (nil matches everything)
+	Exclude    Expression // Elements matching this expression are removed (nil removes nothing)
+	Connectors string     // How connectors touching removed elements are handled
+}
+
+// NewFilter compiles the tag expressions of a view
+func NewFilter(view *schema.View) (*Filter, error) {
+	filter := &Filter{Connectors: view.Connectors}
+
+	if filter.Connectors == "" {
+		filter.Connectors = schema.ViewConnectorsRedirect
+	}
+	if filter.Connectors != schema.ViewConnectorsRedirect && filter.Connectors != schema.ViewConnectorsDrop {
+		return nil, fmt.Errorf("invalid connector handling %q for view %s, expected %q or %q",
+			view.Connectors, view.ID, schema.ViewConnectorsRedirect, schema.ViewConnectorsDrop)
+	}
+
+	if view.Include != "" {
+		include, err := ParseExpression(view.Include)
+		if err != nil {
+			return nil, err
+		}
+		filter.Include = include
+	}
+
+	if view.Exclude != "" {
+		exclude, err := ParseExpression(view.Exclude)
+		if err != nil {
+			return nil, err
+		}
+		filter.Exclude = exclude
+	}
+
+	return filter, nil
+}
+
+// Matches reports whether an element with the given tags is visible
+func (f *Filter) Matches(tags map[string]bool) bool {
+	if f.Include != nil && !f.Include.Match(tags) {
+		return false
+	}
+	if f.Exclude != nil && f.Exclude.Match(tags) {
+		return false
+	}
+	return true
+}
+
+// ApplyPage returns a filtered copy of a page.
+//
+// Tags are inherited from ancestors. A leaf element is kept when it matches the filter,
+// while a container is kept when at least one of its children is kept, so containers
+// whose children were all filtered out are pruned. Connectors touching removed elements
+// are redirected to the nearest visible ancestor or dropped.
+func (f *Filter) ApplyPage(page *schema.Page) schema.Page {
+	state := newPageTree(page)
+
+	filtered := *page
+	filtered.Layers = make([]schema.Layer, len(page.Layers))
+	for i, layer := range page.Layers {
+		layer.Elements = f.filterElements(layer.Elements, nil, "", state)
+		filtered.Layers[i] = layer
+	}
+	filtered.Elements = f.filterElements(page.Elements, nil, "", state)
+
+	// Connector endpoints are handled once the visibility of every element is known
+	for i := range filtered.Layers {
+		filtered.Layers[i].Elements = state.filterConnectors(filtered.Layers[i].Elements, f.Connectors)
+	}
+	filtered.Elements = state.filterConnectors(filtered.Elements, f.Connectors)
+
+	return filtered
+}
+
+// filterElements filters a list of elements in scope, returning the kept elements
+func (f *Filter) filterElements(elements []schema.Element, inherited []string, scope string, state *prunedTree) []schema.Element {
+	kept := make([]schema.Element, 0, len(elements))
+	for i := range elements {
+		if element := f.filterElement(&elements[i], inherited, scope, state); element != nil {
+			kept = append(kept, *element)
+		}
+	}
+	return kept
+}
+
+// filterElement filters a single element and its children, returning nil when it is removed
+func (f *Filter) filterElement(element *schema.Element, inherited []string, scope string, state *prunedTree) *schema.Element {
+	tags := append(append([]string{}, inherited...), element.Tags...)
+
+	// Untagged connectors follow their endpoints, which are checked in a second pass
+	if element.Type == schema.ElementTypeConnector {
+		if len(element.Tags) > 0 && !f.Matches(tagSet(tags)) {
+			return nil
+		}
+		kept := *element
+		return &kept
+	}
+
+	path := childPath(scope, identifier(element))
+
+	children := make([]schema.Element, 0, len(element.Children))
+	hasNodeChildren := false
+	keepContainer := false
+	for i := range element.Children {
+		child := &element.Children[i]
+		if child.Type != schema.ElementTypeConnector {
+			hasNodeChildren = true
+		}
+
+		if kept := f.filterElement(child, tags, path, state); kept != nil {
+			if kept.Type != schema.ElementTypeConnector {
+				keepContainer = true
+			}
+			children = append(children, *kept)
+		}
+	}
+
+	visible := keepContainer
+	if !hasNodeChildren {
+		visible = f.Matches(tagSet(tags))
+	}
+
+	if !visible {
+		state.remove(path)
+		return nil
+	}
+
+	state.keep(path)
+
+	kept := *element
+	kept.Children = children
+	return &kept
+}
@@ -0,0 +1,306 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// prunedTree tracks which elements of a page were kept or removed by a transformation.
+// Elements are identified by their path, the identifiers of their ancestors and their
+// own joined by slashes, so elements sharing an ID in different containers are told apart.
+type prunedTree struct {
+	paths     map[string]bool   // Paths of all elements of the page
+	ids       map[string]string // Identifier to the path of the first element using it
+	kept      map[string]bool
+	removed   map[string]bool
+	collapsed map[string]bool // Kept containers whose hidden children are left as they are
+}
+
+// newPrunedTree indexes the elements of one or more element trees sharing a page
+func newPrunedTree(trees ...[]schema.Element) *prunedTree {
+	t := &prunedTree{
+		paths:     make(map[string]bool),
+		ids:       make(map[string]string),
+		kept:      make(map[string]bool),
+		removed:   make(map[string]bool),
+		collapsed: make(map[string]bool),
+	}
+	for _, elements := range trees {
+		t.index(elements, "")
+	}
+	return t
+}
+
+// newPageTree indexes the elements of a page, its layers first like the draw.io generator
+func newPageTree(page *schema.Page) *prunedTree {
+	trees := make([][]schema.Element, 0, len(page.Layers)+1)
+	for _, layer := range page.Layers {
+		trees = append(trees, layer.Elements)
+	}
+	return newPrunedTree(append(trees, page.Elements)...)
+}
+
+// index records the paths of a list of elements in scope and of their descendants
+func (t *prunedTree) index(elements []schema.Element, scope string) {
+	for i := range elements {
+		element := &elements[i]
+		if element.Type == schema.ElementTypeConnector {
+			continue
+		}
+
+		id := identifier(element)
+		path := childPath(scope, id)
+		t.paths[path] = true
+		if _, exists := t.ids[id]; !exists && id != "" {
+			t.ids[id] = path
+		}
+		t.index(element.Children, path)
+	}
+}
+
+// keep marks the element at path as visible
+func (t *prunedTree) keep(path string) {
+	t.kept[path] = true
+}
+
+// remove marks the element at path as removed, its connectors are redirected to its
+// nearest kept ancestor
+func (t *prunedTree) remove(path string) {
+	t.removed[path] = true
+}
+
+// collapse marks a kept container as collapsed, the connectors among its hidden children
+// are not redirected
+func (t *prunedTree) collapse(path string) {
+	t.collapsed[path] = true
+}
+
+// locate returns the path of the element a connector in scope refers to with an ID, name
+// or path. Like in the draw.io generator, endpoints are resolved relative to the
+// containers of the connector first, from the innermost one, then as the identifier of
+// the first element using it and finally relative to the page.
+func (t *prunedTree) locate(endpoint, scope string) (string, bool) {
+	if endpoint == "" {
+		return "", false
+	}
+	for ; scope != ""; scope = parentPath(scope) {
+		if path := scope + "/" + endpoint; t.paths[path] {
+			return path, true
+		}
+	}
+	if path, exists := t.ids[endpoint]; exists {
+		return path, true
+	}
+	if t.paths[endpoint] {
+		return endpoint, true
+	}
+	return "", false
+}
+
+// reference returns the endpoint a connector in scope uses for the element at path: its
+// identifier when that resolves to the element, its path otherwise
+func (t *prunedTree) reference(path, scope string) string {
+	if id := path[strings.LastIndex(path, "/")+1:]; id != path {
+		if located, ok := t.locate(id, scope); ok && located == path {
+			return id
+		}
+	}
+	return path
+}
+
+// moveConnector rewrites the endpoints of a connector moved from one scope to another,
+// so that they refer to the same elements
+func (t *prunedTree) moveConnector(connector *schema.Element, from, to string) {
+	for _, endpoint := range []*string{&connector.Properties.Source, &connector.Properties.Target} {
+		if path, ok := t.locate(*endpoint, from); ok {
+			*endpoint = t.reference(path, to)
+		}
+	}
+}
+
+// resolve maps an endpoint of a connector in scope to a visible element, returning the
+// endpoint to use and the path of the element. Endpoints that are not elements of the
+// page are returned unchanged.
+func (t *prunedTree) resolve(endpoint, scope, mode string) (string, string, bool) {
+	path, ok := t.locate(endpoint, scope)
+	if !ok {
+		return endpoint, endpoint, true
+	}
+	if !t.removed[path] {
+		return endpoint, path, true
+	}
+
+	if mode == schema.ViewConnectorsDrop {
+		return "", "", false
+	}
+
+	for ancestor := parentPath(path); ancestor != ""; ancestor = parentPath(ancestor) {
+		if t.kept[ancestor] {
+			return t.reference(ancestor, scope), ancestor, true
+		}
+	}
+
+	return "", "", false
+}
+
+// connectorGroup collects the connectors that share their endpoints after redirection
+type connectorGroup struct {
+	count      int
+	redirected bool   // At least one connector of the group was redirected
+	label      string // Label shared by all connectors of the group
+	mixed      bool   // Connectors of the group have different labels
+	emitted    bool
+}
+
+// filterConnectors drops or redirects connectors whose endpoints were removed.
+// Connectors that end up between the same elements because of a redirection are
+// merged into a single connector labeled with the number of merged connectors.
+func (t *prunedTree) filterConnectors(elements []schema.Element, mode string) []schema.Element {
+	groups := make(map[string]*connectorGroup)
+	t.groupConnectors(elements, "", mode, groups)
+	return t.rewriteConnectors(elements, "", mode, groups)
+}
+
+// resolveConnector resolves both endpoints of a connector in scope, returning the
+// endpoints to use, a key identifying the connected elements and whether it is kept
+func (t *prunedTree) resolveConnector(element *schema.Element, scope, mode string) (string, string, string, bool) {
+	source, sourcePath, ok := t.resolve(element.Properties.Source, scope, mode)
+	if !ok {
+		return "", "", "", false
+	}
+	target, targetPath, ok := t.resolve(element.Properties.Target, scope, mode)
+	if !ok {
+		return "", "", "", false
+	}
+
+	// A connector collapsed onto a single element carries no information
+	redirected := source != element.Properties.Source || target != element.Properties.Target
+	if sourcePath != "" && sourcePath == targetPath && redirected {
+		return "", "", "", false
+	}
+
+	return source, target, connectorKey(sourcePath, targetPath), true
+}
+
+// groupConnectors groups the kept connectors by the elements they connect
+func (t *prunedTree) groupConnectors(elements []schema.Element, scope, mode string, groups map[string]*connectorGroup) {
+	for i := range elements {
+		element := &elements[i]
+		if element.Type != schema.ElementTypeConnector {
+			if path := childPath(scope, identifier(element)); !t.collapsed[path] {
+				t.groupConnectors(element.Children, path, mode, groups)
+			}
+			continue
+		}
+
+		source, target, key, ok := t.resolveConnector(element, scope, mode)
+		if !ok {
+			continue
+		}
+
+		group, exists := groups[key]
+		if !exists {
+			group = &connectorGroup{label: element.Properties.Label}
+			groups[key] = group
+		}
+
+		group.count++
+		if source != element.Properties.Source || target != element.Properties.Target {
+			group.redirected = true
+		}
+		if element.Properties.Label != group.label {
+			group.mixed = true
+		}
+	}
+}
+
+// rewriteConnectors applies the resolved endpoints and merges redirected duplicates
+func (t *prunedTree) rewriteConnectors(elements []schema.Element, scope, mode string, groups map[string]*connectorGroup) []schema.Element {
+	kept := make([]schema.Element, 0, len(elements))
+	for _, element := range elements {
+		if element.Type != schema.ElementTypeConnector {
+			if path := childPath(scope, identifier(&element)); !t.collapsed[path] {
+				element.Children = t.rewriteConnectors(element.Children, path, mode, groups)
+			}
+			kept = append(kept, element)
+			continue
+		}
+
+		source, target, key, ok := t.resolveConnector(&element, scope, mode)
+		if !ok {
+			continue
+		}
+
+		group := groups[key]
+		if group.redirected && group.count > 1 {
+			if group.emitted {
+				continue
+			}
+			group.emitted = true
+			element.Properties.Label = group.mergedLabel()
+		}
+
+		element.Properties.Source = source
+		element.Properties.Target = target
+		kept = append(kept, element)
+	}
+	return kept
+}
+
+// mergedLabel returns the label of a connector standing in for the whole group
+func (g *connectorGroup) mergedLabel() string {
+	if g.label != "" && !g.mixed {
+		return fmt.Sprintf("%s (%d)", g.label, g.count)
+	}
+	return fmt.Sprintf("%d connections", g.count)
+}
+
+// connectorKey identifies the endpoints of a connector
+func connectorKey(source, target string) string {
+	return source + "\x00" + target
+}
+
+// identifier returns the identifier connectors use to reference an element
+func identifier(element *schema.Element) string {
+	if element.ID != "" {
+		return element.ID
+	}
+	return element.Name
+}
+
+// childPath returns the path of an element in scope, the path of its container
+func childPath(scope, id string) string {
+	if scope == "" {
+		return id
+	}
+	return scope + "/" + id
+}
+
+// parentPath returns the path of the container of the element at path, or "" at the top
+func parentPath(path string) string {
+	if i := strings.LastIndex(path, "/"); i >= 0 {
+		return path[:i]
+	}
+	return ""
+}
+
+// within reports whether path is the path of a descendant of the element at container
+func within(path, container string) bool {
+	return strings.HasPrefix(path, container+"/")
+}
+
+// tagSet converts a list of tags to a set
+func tagSet(tags []string) map[string]bool {
+	set := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		set[tag] = true
+	}
+	return set
+}
+
+// viewPageID returns the ID of a page rendered for a view
+func viewPageID(pageID, viewID string) string {
+	return fmt.Sprintf("%s-%s", pageID, viewID)
+}
@@ -0,0 +1,123 @@
+package views
+
+import (
+	"fmt"
+
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// Render returns the filtered pages of a view
+func Render(config *schema.DiagramConfig, view *schema.View) ([]schema.Page, error) {
+	if view.ID == "" {
+		return nil, fmt.Errorf("view ID is required")
+	}
+
+	filter, err := NewFilter(view)
+	if err != nil {
+		return nil, fmt.Errorf("invalid view %s: %w", view.ID, err)
+	}
+
+	selected, err := selectPages(config.Diagram.Pages, view.Pages)
+	if err != nil {
+		return nil, fmt.Errorf("invalid view %s: %w", view.ID, err)
+	}
+
+	pages := make([]schema.Page, 0, len(selected))
+	for i := range selected {
+		pages = append(pages, filter.ApplyPage(&selected[i]))
+	}
+
+	return pages, nil
+}
+
+// Expand renders the views declared in a diagram. Views without an output file are
+// appended to the diagram as additional pages, while views with an output file are
+// returned as separate configurations keyed by their output path.
+func Expand(config *schema.DiagramConfig) (map[string]*schema.DiagramConfig, error) {
+	outputs := make(map[string]*schema.DiagramConfig)
+	var viewPages []schema.Page
+
+	for i := range config.Diagram.Views {
+		view := &config.Diagram.Views[i]
+
+		pages, err := Render(config, view)
+		if err != nil {
+			return nil, err
+		}
+
+		if view.Output != "" {
+			if _, exists := outputs[view.Output]; exists {
+				return nil, fmt.Errorf("multiple views write to %s", view.Output)
+			}
+
+			output := *config
+			output.Diagram.Pages = pages
+			output.Diagram.Views = nil
+			outputs[view.Output] = &output
+			continue
+		}
+
+		// Views rendered into the main output get their own page IDs
+		for _, page := range pages {
+			page.ID = viewPageID(page.ID, view.ID)
+			page.Name = fmt.Sprintf("%s (%s)", page.Name, viewName(view))
+			viewPages = append(viewPages, page)
+		}
+	}
+
+	config.Diagram.Pages = append(config.Diagram.Pages, viewPages...)
+	return outputs, nil
+}
+
+// Select replaces the diagram's pages with the pages of a single view. The selector
+// is either the ID of a declared view or a tag expression.
+func Select(config *schema.DiagramConfig, selector string) error {
+	view := &schema.View{ID: "selected", Include: selector}
+	for i := range config.Diagram.Views {
+		if config.Diagram.Views[i].ID == selector {
+			view = &config.Diagram.Views[i]
+			break
+		}
+	}
+
+	pages, err := Render(config, view)
+	if err != nil {
+		return err
+	}
+
+	config.Diagram.Pages = pages
+	config.Diagram.Views = nil
+	return nil
+}
+
+// selectPages returns the pages with the given IDs, or all pages when no IDs are given
+func selectPages(pages []schema.Page, pageIDs []string) ([]schema.Page, error) {
+	if len(pageIDs) == 0 {
+		return pages, nil
+	}
+
+	selected := make([]schema.Page, 0, len(pageIDs))
+	for _, pageID := range pageIDs {
+		found := false
+		for _, page := range pages {
+			if page.ID == pageID {
+				selected = append(selected, page)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("page %s not found", pageID)
+		}
+	}
+
+	return selected, nil
+}
+
+// viewName returns the display name of a view
+func viewName(view *schema.View) string {
+	if view.Name != "" {
+		return view.Name
+	}
+	return view.ID
+}
@@ -0,0 +1,226 @@
+package views
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+func TestParseExpression(t *testing.T) {
+	tests := []struct {
+		expr    string
+		tags    []string
+		want    bool
+		wantErr bool
+	}{
+		{expr: "network", tags: []string{"network"}, want: true},
+		{expr: "network", tags: []string{"data"}, want: false},
+		{expr: "network && !internal", tags: []string{"network"}, want: true},
+		{expr: "network && !internal", tags: []string{"network", "internal"}, want: false},
+		{expr: "network || data", tags: []string{"data"}, want: true},
+		{expr: "!(network || data)", tags: []string{"data"}, want: false},
+		{expr: "a || b && c", tags: []string{"a"}, want: true},
+		{expr: "(a || b) && c", tags: []string{"a"}, want: false},
+		{expr: "env:prod && team/platform", tags: []string{"env:prod", "team/platform"}, want: true},
+		{expr: "", wantErr: true},
+		{expr: "network &", wantErr: true},
+		{expr: "network &&", wantErr: true},
+		{expr: "(network", wantErr: true},
+		{expr: "network data", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			expr, err := ParseExpression(tt.expr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseExpression() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if got := expr.Match(tagSet(tt.tags)); got != tt.want {
+				t.Errorf("Match(%v) = %v, want %v", tt.tags, got, tt.want)
+			}
+		})
+	}
+}
+
+// findElement finds an element by ID in a tree of elements
+func findElement(elements []schema.Element, id string) *schema.Element {
+	for i := range elements {
+		if elements[i].ID == id {
+			return &elements[i]
+		}
+		if found := findElement(elements[i].Children, id); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+func TestFilter_ApplyPage(t *testing.T) {
+	elements := []schema.Element{
+		{
+			ID:   "vpc",
+			Type: schema.ElementTypeShape,
+			Tags: []string{"network"},
+			Children: []schema.Element{
+				{ID: "web", Type: schema.ElementTypeShape},
+				{ID: "admin", Type: schema.ElementTypeShape, Tags: []string{"internal"}},
+			},
+		},
+		{
+			ID:       "ops",
+			Type:     schema.ElementTypeShape,
+			Tags:     []string{"network", "internal"},
+			Children: []schema.Element{{ID: "bastion", Type: schema.ElementTypeShape}},
+		},
+		{ID: "db", Type: schema.ElementTypeShape, Tags: []string{"data"}},
+		{ID: "web-to-admin", Type: schema.ElementTypeConnector, Properties: schema.ElementProperties{Source: "web", Target: "admin"}},
+		{ID: "web-to-db", Type: schema.ElementTypeConnector, Properties: schema.ElementProperties{Source: "web", Target: "db"}},
+		{ID: "bastion-to-web", Type: schema.ElementTypeConnector, Properties: schema.ElementProperties{Source: "bastion", Target: "web"}},
+	}
+
+	tests := []struct {
+		name    string
+		view    schema.View
+		kept    []string
+		removed []string
+		targets map[string]string // Targets of the kept connectors
+	}{
+		{
+			// vpc and web inherit the network tag, ops is pruned once its children are
+			// removed and web-to-db and bastion-to-web have no visible endpoint
+			name:    "redirect connectors",
+			view:    schema.View{ID: "net", Include: "network && !internal"},
+			kept:    []string{"vpc", "web", "web-to-admin"},
+			removed: []string{"admin", "ops", "bastion", "db", "web-to-db", "bastion-to-web"},
+			targets: map[string]string{"web-to-admin": "vpc"},
+		},
+		{
+			name:    "drop connectors",
+			view:    schema.View{ID: "net", Include: "network", Exclude: "internal", Connectors: schema.ViewConnectorsDrop},
+			kept:    []string{"vpc", "web"},
+			removed: []string{"admin", "ops", "web-to-admin"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := NewFilter(&tt.view)
+			if err != nil {
+				t.Fatalf("NewFilter() error = %v", err)
+			}
+
+			page := schema.Page{ID: "main", Name: "Main", Elements: elements}
+			filtered := filter.ApplyPage(&page)
+
+			for _, id := range tt.kept {
+				if findElement(filtered.Elements, id) == nil {
+					t.Errorf("Expected %s to be kept", id)
+				}
+			}
+			for _, id := range tt.removed {
+				if findElement(filtered.Elements, id) != nil {
+					t.Errorf("Expected %s to be removed", id)
+				}
+			}
+			for id, target := range tt.targets {
+				if connector := findElement(filtered.Elements, id); connector != nil && connector.Properties.Target != target {
+					t.Errorf("Expected connector %s to be redirected to %s, got '%s'", id, target, connector.Properties.Target)
+				}
+			}
+
+			// The original page is left untouched
+			if len(page.Elements[0].Children) != 2 {
+				t.Error("Expected original page to keep its elements")
+			}
+		})
+	}
+}
+
+func TestExpand(t *testing.T) {
+	config := &schema.DiagramConfig{
+		Version: "1.0",
+		Diagram: schema.Diagram{
+			Pages: []schema.Page{{
+				ID:   "main",
+				Name: "Main",
+				Elements: []schema.Element{
+					{ID: "vpc", Type: schema.ElementTypeShape, Tags: []string{"network"}},
+					{ID: "db", Type: schema.ElementTypeShape, Tags: []string{"data"}},
+				},
+			}},
+			Views: []schema.View{
+				{ID: "network", Name: "Network", Include: "network"},
+				{ID: "data", Include: "data", Output: "data.drawio"},
+			},
+		},
+	}
+	outputs, err := Expand(config)
+	if err != nil {
+		t.Fatalf("Expand() error = %v", err)
+	}
+
+	if len(config.Diagram.Pages) != 2 {
+		t.Fatalf("Expected view page to be appended, got %d pages", len(config.Diagram.Pages))
+	}
+
+	if config.Diagram.Pages[1].ID != "main-network" || config.Diagram.Pages[1].Name != "Main (Network)" {
+		t.Errorf("Unexpected view page %s (%s)", config.Diagram.Pages[1].ID, config.Diagram.Pages[1].Name)
+	}
+
+	output, exists := outputs["data.drawio"]
+	if !exists {
+		t.Fatal("Expected separate output for data view")
+	}
+
+	if len(output.Diagram.Pages) != 1 || findElement(output.Diagram.Pages[0].Elements, "db") == nil {
+		t.Error("Expected data output to contain the db element")
+	}
+}
+
+func TestSelect(t *testing.T) {
+	tests := []struct {
+		selector string
+		kept     []string
+		removed  []string
+		wantErr  bool
+	}{
+		{selector: "data", kept: []string{"db"}, removed: []string{"vpc"}},
+		{selector: "network || data", kept: []string{"vpc", "db"}},
+		{selector: "data &&", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.selector, func(t *testing.T) {
+			config := &schema.DiagramConfig{
+				Diagram: schema.Diagram{Pages: []schema.Page{{
+					ID:   "main",
+					Name: "Main",
+					Elements: []schema.Element{
+						{ID: "vpc", Type: schema.ElementTypeShape, Tags: []string{"network"}},
+						{ID: "db", Type: schema.ElementTypeShape, Tags: []string{"data"}},
+					},
+				}}},
+			}
+
+			err := Select(config, tt.selector)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Select() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			for _, id := range tt.kept {
+				if findElement(config.Diagram.Pages[0].Elements, id) == nil {
+					t.Errorf("Expected %s to be kept", id)
+				}
+			}
+			for _, id := range tt.removed {
+				if findElement(config.Diagram.Pages[0].Elements, id) != nil {
+					t.Errorf("Expected %s to be removed", id)
+				}
+			}
+		})
+	}
+}
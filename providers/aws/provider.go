@@ -0,0 +1,258 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/LederWorks/hippodamus/pkg/providers"
+	"github.com/LederWorks/hippodamus/pkg/schema"
+	"github.com/LederWorks/hippodamus/providers/aws/resources"
+	"github.com/LederWorks/hippodamus/providers/aws/templates"
+)
+
+// AWSProvider implements the Provider interface for AWS architecture elements
+type AWSProvider struct {
+	version string
+	theme   templates.Theme // Set from the settings of the provider declaration
+	// Resource instances
+	organizationResource       *resources.OrganizationResource
+	organizationalUnitResource *resources.OrganizationalUnitResource
+	accountResource            *resources.AccountResource
+	regionResource             *resources.RegionResource
+	availabilityZoneResource   *resources.AvailabilityZoneResource
+	vpcResource                *resources.VPCResource
+	subnetResource             *resources.SubnetResource
+	eksResource                *resources.EKSResource
+	rdsResource                *resources.RDSResource
+	s3Resource                 *resources.S3Resource
+	lambdaResource             *resources.LambdaResource
+	albResource                *resources.ALBResource
+	iamResource                *resources.IAMResource
+	// Template instances
+	organizationTemplate       *templates.OrganizationTemplate
+	organizationalUnitTemplate *templates.OrganizationalUnitTemplate
+	accountTemplate            *templates.AccountTemplate
+	regionTemplate             *templates.RegionTemplate
+	availabilityZoneTemplate   *templates.AvailabilityZoneTemplate
+	vpcTemplate                *templates.VPCTemplate
+	subnetTemplate             *templates.SubnetTemplate
+	eksTemplate                *templates.EKSTemplate
+	rdsTemplate                *templates.RDSTemplate
+	s3Template                 *templates.S3Template
+	lambdaTemplate             *templates.LambdaTemplate
+	albTemplate                *templates.ALBTemplate
+	iamTemplate                *templates.IAMTemplate
+}
+
+// NewAWSProvider creates a new AWS provider instance
+func NewAWSProvider() *AWSProvider {
+	return NewAWSProviderWithVersion("dev")
+}
+
+// NewAWSProviderWithVersion creates a new AWS provider instance with a specific version
+func NewAWSProviderWithVersion(version string) *AWSProvider {
+	return &AWSProvider{
+		version:                    version,
+		organizationResource:       resources.NewOrganizationResource(),
+		organizationalUnitResource: resources.NewOrganizationalUnitResource(),
+		accountResource:            resources.NewAccountResource(),
+		regionResource:             resources.NewRegionResource(),
+		availabilityZoneResource:   resources.NewAvailabilityZoneResource(),
+		vpcResource:                resources.NewVPCResource(),
+		subnetResource:             resources.NewSubnetResource(),
+		eksResource:                resources.NewEKSResource(),
+		rdsResource:                resources.NewRDSResource(),
+		s3Resource:                 resources.NewS3Resource(),
+		lambdaResource:             resources.NewLambdaResource(),
+		albResource:                resources.NewALBResource(),
+		iamResource:                resources.NewIAMResource(),
+		organizationTemplate:       templates.NewOrganizationTemplate(),
+		organizationalUnitTemplate: templates.NewOrganizationalUnitTemplate(),
+		accountTemplate:            templates.NewAccountTemplate(),
+		regionTemplate:             templates.NewRegionTemplate(),
+		availabilityZoneTemplate:   templates.NewAvailabilityZoneTemplate(),
+		vpcTemplate:                templates.NewVPCTemplate(),
+		subnetTemplate:             templates.NewSubnetTemplate(),
+		eksTemplate:                templates.NewEKSTemplate(),
+		rdsTemplate:                templates.NewRDSTemplate(),
+		s3Template:                 templates.NewS3Template(),
+		lambdaTemplate:             templates.NewLambdaTemplate(),
+		albTemplate:                templates.NewALBTemplate(),
+		iamTemplate:                templates.NewIAMTemplate(),
+	}
+}
+
+// Name returns the provider name
+func (p *AWSProvider) Name() string {
+	return "aws"
+}
+
+// Version returns the provider version
+func (p *AWSProvider) Version() string {
+	return p.version
+}
+
+// Resources returns the list of supported AWS resources
+func (p *AWSProvider) Resources() []providers.ResourceDefinition {
+	return []providers.ResourceDefinition{
+		p.organizationResource.Definition(),
+		p.organizationalUnitResource.Definition(),
+		p.accountResource.Definition(),
+		p.regionResource.Definition(),
+		p.availabilityZoneResource.Definition(),
+		p.vpcResource.Definition(),
+		p.subnetResource.Definition(),
+		p.eksResource.Definition(),
+		p.rdsResource.Definition(),
+		p.s3Resource.Definition(),
+		p.lambdaResource.Definition(),
+		p.albResource.Definition(),
+		p.iamResource.Definition(),
+	}
+}
+
+// Validate validates AWS resource parameters
+func (p *AWSProvider) Validate(resourceType string, params map[string]interface{}) error {
+	switch resourceType {
+	case "organization":
+		return p.organizationResource.Validate(params)
+	case "ou":
+		return p.organizationalUnitResource.Validate(params)
+	case "account":
+		return p.accountResource.Validate(params)
+	case "region":
+		return p.regionResource.Validate(params)
+	case "az":
+		return p.availabilityZoneResource.Validate(params)
+	case "vpc":
+		return p.vpcResource.Validate(params)
+	case "subnet":
+		return p.subnetResource.Validate(params)
+	case "eks":
+		return p.eksResource.Validate(params)
+	case "rds":
+		return p.rdsResource.Validate(params)
+	case "s3":
+		return p.s3Resource.Validate(params)
+	case "lambda":
+		return p.lambdaResource.Validate(params)
+	case "alb":
+		return p.albResource.Validate(params)
+	case "iam":
+		return p.iamResource.Validate(params)
+	default:
+		return &providers.ProviderError{
+			Provider: p.Name(),
+			Resource: resourceType,
+			Message:  fmt.Sprintf("unsupported resource type: %s", resourceType),
+			Code:     "UNSUPPORTED_RESOURCE",
+		}
+	}
+}
+
+// settingsSchema is the JSON schema of the settings accepted by Configure
+var settingsSchema = map[string]interface{}{
+	"type": "object",
+	"properties": map[string]interface{}{
+		"iconStyle": map[string]interface{}{
+			"type":        "string",
+			"enum":        []string{templates.IconStyleColor, templates.IconStyleFlat},
+			"default":     templates.IconStyleColor,
+			"description": "Icons on their category color, or flat icons drawn in it",
+		},
+		"palette": map[string]interface{}{
+			"type":        "string",
+			"enum":        []string{templates.PaletteLight, templates.PaletteDark},
+			"default":     templates.PaletteLight,
+			"description": "Colors for light or dark page backgrounds",
+		},
+	},
+	"additionalProperties": false,
+}
+
+// Configure applies the iconStyle and palette settings of a provider declaration
+func (p *AWSProvider) Configure(config providers.ProviderConfig) error {
+	if err := providers.ValidateParams(settingsSchema, config.Settings); err != nil {
+		return err
+	}
+
+	settings := providers.ApplyDefaults(settingsSchema, config.Settings)
+	p.theme = templates.Theme{
+		IconStyle: settings["iconStyle"].(string),
+		Palette:   settings["palette"].(string),
+	}
+	return nil
+}
+
+// GenerateTemplate generates AWS resource templates
+func (p *AWSProvider) GenerateTemplate(resourceType string, params map[string]interface{}) (*schema.Element, error) {
+	if err := p.Validate(resourceType, params); err != nil {
+		return nil, err
+	}
+
+	element, err := p.generate(resourceType, params)
+	if err != nil {
+		return nil, err
+	}
+	p.theme.Apply(element, params)
+	return element, nil
+}
+
+// generate creates the element of a resource with the template of its type
+func (p *AWSProvider) generate(resourceType string, params map[string]interface{}) (*schema.Element, error) {
+	switch resourceType {
+	case "organization":
+		return p.organizationTemplate.Generate(params)
+	case "ou":
+		return p.organizationalUnitTemplate.Generate(params)
+	case "account":
+		return p.accountTemplate.Generate(params)
+	case "region":
+		return p.regionTemplate.Generate(params)
+	case "az":
+		return p.availabilityZoneTemplate.Generate(params)
+	case "vpc":
+		return p.vpcTemplate.Generate(params)
+	case "subnet":
+		return p.subnetTemplate.Generate(params)
+	case "eks":
+		return p.eksTemplate.Generate(params)
+	case "rds":
+		return p.rdsTemplate.Generate(params)
+	case "s3":
+		return p.s3Template.Generate(params)
+	case "lambda":
+		return p.lambdaTemplate.Generate(params)
+	case "alb":
+		return p.albTemplate.Generate(params)
+	case "iam":
+		return p.iamTemplate.Generate(params)
+	default:
+		return nil, &providers.ProviderError{
+			Provider: p.Name(),
+			Resource: resourceType,
+			Message:  fmt.Sprintf("unsupported resource type: %s", resourceType),
+			Code:     "UNSUPPORTED_RESOURCE",
+		}
+	}
+}
+
+// GetSchema returns the JSON schema for a resource type
+func (p *AWSProvider) GetSchema(resourceType string) (map[string]interface{}, error) {
+	for _, resource := range p.Resources() {
+		if resource.Type == resourceType {
+			return resource.Schema, nil
+		}
+	}
+
+	return nil, &providers.ProviderError{
+		Provider: p.Name(),
+		Resource: resourceType,
+		Message:  fmt.Sprintf("schema not found for resource type: %s", resourceType),
+		Code:     "SCHEMA_NOT_FOUND",
+	}
+}
+
+// GetVersion returns the provider version
+func (p *AWSProvider) GetVersion() string {
+	return p.version
+}
@@ -0,0 +1,163 @@
+package aws
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers"
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+func TestAWSProvider_Basic(t *testing.T) {
+	provider := NewAWSProvider()
+
+	if provider.Name() != "aws" {
+		t.Errorf("Expected provider name 'aws', got '%s'", provider.Name())
+	}
+
+	if provider.Version() != "dev" {
+		t.Errorf("Expected version 'dev', got '%s'", provider.Version())
+	}
+
+	expected := []string{"organization", "ou", "account", "region", "az", "vpc", "subnet", "eks", "rds", "s3", "lambda", "alb", "iam"}
+	resources := provider.Resources()
+	if len(resources) != len(expected) {
+		t.Fatalf("Expected %d resources, got %d", len(expected), len(resources))
+	}
+
+	for i, resourceType := range expected {
+		if resources[i].Type != resourceType {
+			t.Errorf("Expected resource %d to be '%s', got '%s'", i, resourceType, resources[i].Type)
+		}
+	}
+}
+
+func TestAWSProvider_Conformance(t *testing.T) {
+	providertest.Run(t, NewAWSProvider())
+}
+
+func TestAWSProvider_Examples(t *testing.T) {
+	provider := NewAWSProvider()
+
+	for _, resource := range provider.Resources() {
+		t.Run(resource.Type, func(t *testing.T) {
+			if len(resource.Examples) == 0 {
+				t.Fatal("Expected at least one example")
+			}
+
+			element, err := provider.GenerateTemplate(resource.Type, resource.Examples[0].Config)
+			if err != nil {
+				t.Fatalf("GenerateTemplate() error = %v", err)
+			}
+
+			if !strings.HasPrefix(element.Properties.Shape, "mxgraph.aws4.") && resource.Type != "az" {
+				t.Errorf("Expected mxgraph.aws4 shape, got '%s'", element.Properties.Shape)
+			}
+
+			if element.Properties.Width <= 0 || element.Properties.Height <= 0 {
+				t.Error("Expected element to have a default size")
+			}
+		})
+	}
+}
+
+func TestAWSProvider_ContainerTypes(t *testing.T) {
+	provider := NewAWSProvider()
+
+	containers := map[string]bool{"organization": true, "ou": true, "account": true, "region": true, "az": true, "vpc": true, "subnet": true}
+	for _, resource := range provider.Resources() {
+		element, err := provider.GenerateTemplate(resource.Type, map[string]interface{}{})
+		if err != nil {
+			t.Fatalf("GenerateTemplate(%s) error = %v", resource.Type, err)
+		}
+
+		if containers[resource.Type] && element.Type != schema.ElementTypeGroup {
+			t.Errorf("Expected %s to be a group, got %s", resource.Type, element.Type)
+		}
+		if !containers[resource.Type] && element.Type != schema.ElementTypeShape {
+			t.Errorf("Expected %s to be a shape, got %s", resource.Type, element.Type)
+		}
+	}
+}
+
+func TestAWSProvider_Validation(t *testing.T) {
+	provider := NewAWSProvider()
+
+	err := provider.Validate("vpc", map[string]interface{}{"cidr": "10.0.0.0/33"})
+	if err == nil {
+		t.Fatal("Expected invalid CIDR to cause a validation error")
+	}
+
+	var validationErr *providers.ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("Expected ValidationError, got %T", err)
+	}
+	if validationErr.Field != "cidr" {
+		t.Errorf("Expected error for field 'cidr', got '%s'", validationErr.Field)
+	}
+
+	if _, err := provider.GenerateTemplate("vpc", map[string]interface{}{"cidr": "invalid"}); err == nil {
+		t.Error("Expected GenerateTemplate to validate parameters")
+	}
+}
+
+func TestAWSProvider_UnsupportedResource(t *testing.T) {
+	provider := NewAWSProvider()
+
+	if err := provider.Validate("unsupported", nil); err == nil {
+		t.Error("Expected error for unsupported resource type")
+	}
+
+	if _, err := provider.GenerateTemplate("unsupported", nil); err == nil {
+		t.Error("Expected error for unsupported resource type")
+	}
+
+	if _, err := provider.GetSchema("unsupported"); err == nil {
+		t.Error("Expected error for unsupported resource schema")
+	}
+
+	if schema, err := provider.GetSchema("subnet"); err != nil || schema == nil {
+		t.Errorf("Expected subnet schema, got error %v", err)
+	}
+}
+
+func TestAWSProvider_Configure(t *testing.T) {
+	provider := NewAWSProvider()
+	err := provider.Configure(providers.ProviderConfig{
+		Name:     "aws-light",
+		Settings: map[string]interface{}{"iconStyle": "flat", "palette": "dark"},
+	})
+	if err != nil {
+		t.Fatalf("Configure() error = %v", err)
+	}
+
+	icon, err := provider.GenerateTemplate("s3", map[string]interface{}{})
+	if err != nil {
+		t.Fatalf("GenerateTemplate() error = %v", err)
+	}
+	if icon.Style.FillColor != "none" || icon.Style.StrokeColor != "#7AA116" || icon.Style.FontColor != "#FFFFFF" {
+		t.Errorf("Expected a flat storage icon with a white label, got %+v", icon.Style)
+	}
+
+	group, err := provider.GenerateTemplate("subnet", map[string]interface{}{"fillColor": "#101010"})
+	if err != nil {
+		t.Fatalf("GenerateTemplate() error = %v", err)
+	}
+	if group.Style.FillColor != "#101010" || group.Style.FontColor != "#FFFFFF" {
+		t.Errorf("Expected the fill parameter to be kept on a dark subnet, got %+v", group.Style)
+	}
+
+	// Other instances keep the default icon style and palette
+	icon, _ = NewAWSProvider().GenerateTemplate("s3", map[string]interface{}{})
+	if icon.Style.FillColor != "#7AA116" || icon.Style.StrokeColor != "#ffffff" {
+		t.Errorf("Expected a colored storage icon, got %+v", icon.Style)
+	}
+
+	err = provider.Configure(providers.ProviderConfig{Settings: map[string]interface{}{"palette": "sepia", "icons": "flat"}})
+	var validationErrs providers.ValidationErrors
+	if !errors.As(err, &validationErrs) || len(validationErrs) != 2 {
+		t.Errorf("Expected errors for palette and the unknown icons setting, got %v", err)
+	}
+}
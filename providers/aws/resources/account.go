@@ -0,0 +1,48 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// accountIDPattern matches 12-digit AWS account IDs
+const accountIDPattern = `^[0-9]{12}$`
+
+// AccountResource defines the AWS account resource
+type AccountResource struct{}
+
+// NewAccountResource creates a new Account resource instance
+func NewAccountResource() *AccountResource {
+	return &AccountResource{}
+}
+
+// Definition returns the resource definition for Account elements
+func (r *AccountResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "account",
+		Name:        "Account",
+		Description: "AWS account container",
+		Category:    "management",
+		Schema: providers.ResourceSchema("AWS Account", 500, 350, map[string]interface{}{
+			"accountId": map[string]interface{}{
+				"type":        "string",
+				"description": "12-digit AWS account ID",
+				"pattern":     accountIDPattern,
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Account",
+				Description: "Basic Account container",
+				Config: map[string]interface{}{
+					"label":     "Production",
+					"accountId": "123456789012",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates Account parameters
+func (r *AccountResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
@@ -0,0 +1,34 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestAccountResource_AccountID(t *testing.T) {
+	resource := NewAccountResource()
+
+	if err := resource.Validate(map[string]interface{}{"accountId": "123456789012"}); err != nil {
+		t.Errorf("Expected a 12-digit account ID to be valid, got %v", err)
+	}
+
+	tests := map[string]struct {
+		accountID interface{}
+		code      string
+	}{
+		"too short":          {accountID: "1234", code: "INVALID_FORMAT"},
+		"not only digits":    {accountID: "12345678901a", code: "INVALID_FORMAT"},
+		"number not string":  {accountID: 123456789012, code: "INVALID_TYPE"},
+		"leading zeros kept": {accountID: "012345678901"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := resource.Validate(map[string]interface{}{"accountId": tt.accountID})
+			if code := providertest.ValidationCode(err, "accountId"); code != tt.code {
+				t.Errorf("Expected code %q for account ID %v, got %q", tt.code, tt.accountID, code)
+			}
+		})
+	}
+}
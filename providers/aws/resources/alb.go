@@ -0,0 +1,49 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// albSchemes are the supported load balancer schemes
+var albSchemes = []string{"internet-facing", "internal"}
+
+// ALBResource defines the AWS application load balancer resource
+type ALBResource struct{}
+
+// NewALBResource creates a new Application Load Balancer resource instance
+func NewALBResource() *ALBResource {
+	return &ALBResource{}
+}
+
+// Definition returns the resource definition for Application Load Balancer elements
+func (r *ALBResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "alb",
+		Name:        "Application Load Balancer",
+		Description: "Elastic Load Balancing application load balancer",
+		Category:    "networking",
+		Schema: providers.ResourceSchema("Application Load Balancer", 78, 78, map[string]interface{}{
+			"scheme": map[string]interface{}{
+				"type":        "string",
+				"description": "Load balancer scheme",
+				"default":     "internet-facing",
+				"enum":        albSchemes,
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Application Load Balancer",
+				Description: "Basic Application Load Balancer",
+				Config: map[string]interface{}{
+					"label":  "Public ALB",
+					"scheme": "internet-facing",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates Application Load Balancer parameters
+func (r *ALBResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
@@ -0,0 +1,22 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestALBResource_Scheme(t *testing.T) {
+	resource := NewALBResource()
+
+	for _, scheme := range []string{"internet-facing", "internal"} {
+		if err := resource.Validate(map[string]interface{}{"scheme": scheme}); err != nil {
+			t.Errorf("Expected scheme %s to be valid, got %v", scheme, err)
+		}
+	}
+
+	err := resource.Validate(map[string]interface{}{"scheme": "public"})
+	if code := providertest.ValidationCode(err, "scheme"); code != "INVALID_ENUM" {
+		t.Errorf("Expected INVALID_ENUM for scheme public, got %q", code)
+	}
+}
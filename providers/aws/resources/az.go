@@ -0,0 +1,48 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// zonePattern matches AWS availability zone names
+const zonePattern = `^[a-z]{2}(-gov|-iso[a-z]*)?-[a-z]+-[0-9]+[a-z]$`
+
+// AvailabilityZoneResource defines the AWS availability zone resource
+type AvailabilityZoneResource struct{}
+
+// NewAvailabilityZoneResource creates a new Availability Zone resource instance
+func NewAvailabilityZoneResource() *AvailabilityZoneResource {
+	return &AvailabilityZoneResource{}
+}
+
+// Definition returns the resource definition for Availability Zone elements
+func (r *AvailabilityZoneResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "az",
+		Name:        "Availability Zone",
+		Description: "Availability zone within a region",
+		Category:    "networking",
+		Schema: providers.ResourceSchema("Availability Zone", 250, 250, map[string]interface{}{
+			"zone": map[string]interface{}{
+				"type":        "string",
+				"description": "Availability zone name",
+				"pattern":     zonePattern,
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Availability Zone",
+				Description: "Basic Availability Zone container",
+				Config: map[string]interface{}{
+					"label": "Availability Zone A",
+					"zone":  "us-east-1a",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates Availability Zone parameters
+func (r *AvailabilityZoneResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
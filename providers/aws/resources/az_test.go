@@ -0,0 +1,25 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestAvailabilityZoneResource_Zone(t *testing.T) {
+	resource := NewAvailabilityZoneResource()
+
+	for _, zone := range []string{"eu-central-1b", "us-gov-west-1a"} {
+		if err := resource.Validate(map[string]interface{}{"zone": zone}); err != nil {
+			t.Errorf("Expected zone %s to be valid, got %v", zone, err)
+		}
+	}
+
+	// A zone is a region followed by a zone letter
+	for _, zone := range []string{"eu-central-1", "eu-central-1-b"} {
+		err := resource.Validate(map[string]interface{}{"zone": zone})
+		if code := providertest.ValidationCode(err, "zone"); code != "INVALID_FORMAT" {
+			t.Errorf("Expected INVALID_FORMAT for zone %s, got %q", zone, code)
+		}
+	}
+}
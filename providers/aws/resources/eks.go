@@ -0,0 +1,55 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// kubernetesVersionPattern matches Kubernetes minor versions
+const kubernetesVersionPattern = `^[0-9]+\.[0-9]+$`
+
+// EKSResource defines the Amazon EKS cluster resource
+type EKSResource struct{}
+
+// NewEKSResource creates a new EKS Cluster resource instance
+func NewEKSResource() *EKSResource {
+	return &EKSResource{}
+}
+
+// Definition returns the resource definition for EKS Cluster elements
+func (r *EKSResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "eks",
+		Name:        "EKS Cluster",
+		Description: "Amazon Elastic Kubernetes Service cluster",
+		Category:    "containers",
+		Schema: providers.ResourceSchema("EKS Cluster", 78, 78, map[string]interface{}{
+			"version": map[string]interface{}{
+				"type":        "string",
+				"description": "Kubernetes version",
+				"pattern":     kubernetesVersionPattern,
+			},
+			"nodeCount": map[string]interface{}{
+				"type":        "integer",
+				"description": "Number of worker nodes",
+				"minimum":     0,
+				"maximum":     1000,
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "EKS Cluster",
+				Description: "Basic EKS Cluster",
+				Config: map[string]interface{}{
+					"label":     "Platform Cluster",
+					"version":   "1.29",
+					"nodeCount": 3,
+				},
+			},
+		},
+	}
+}
+
+// Validate validates EKS Cluster parameters
+func (r *EKSResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
@@ -0,0 +1,36 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestEKSResource_Validate(t *testing.T) {
+	resource := NewEKSResource()
+
+	if err := resource.Validate(map[string]interface{}{"version": "1.29", "nodeCount": 0}); err != nil {
+		t.Errorf("Expected cluster without nodes to be valid, got %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		params map[string]interface{}
+		field  string
+		code   string
+	}{
+		{name: "version with v prefix", params: map[string]interface{}{"version": "v1.29"}, field: "version", code: "INVALID_FORMAT"},
+		{name: "patch version", params: map[string]interface{}{"version": "1.29.3"}, field: "version", code: "INVALID_FORMAT"},
+		{name: "fractional node count", params: map[string]interface{}{"nodeCount": 2.5}, field: "nodeCount", code: "INVALID_TYPE"},
+		{name: "too many nodes", params: map[string]interface{}{"nodeCount": 1001}, field: "nodeCount", code: "OUT_OF_RANGE"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := resource.Validate(tt.params)
+			if code := providertest.ValidationCode(err, tt.field); code != tt.code {
+				t.Errorf("Expected code %s for %s, got %q", tt.code, tt.field, code)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// iamTypes are the supported IAM entity types
+var iamTypes = []string{"role", "user", "group", "policy"}
+
+// IAMResource defines the AWS IAM entity resource
+type IAMResource struct{}
+
+// NewIAMResource creates a new IAM Entity resource instance
+func NewIAMResource() *IAMResource {
+	return &IAMResource{}
+}
+
+// Definition returns the resource definition for IAM Entity elements
+func (r *IAMResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "iam",
+		Name:        "IAM Entity",
+		Description: "AWS Identity and Access Management role, user, group or policy",
+		Category:    "security",
+		Schema: providers.ResourceSchema("IAM", 78, 78, map[string]interface{}{
+			"iamType": map[string]interface{}{
+				"type":        "string",
+				"description": "IAM entity type",
+				"default":     "role",
+				"enum":        iamTypes,
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "IAM Entity",
+				Description: "Basic IAM Entity",
+				Config: map[string]interface{}{
+					"label":   "Deploy Role",
+					"iamType": "role",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates IAM Entity parameters
+func (r *IAMResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
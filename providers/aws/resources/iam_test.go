@@ -0,0 +1,22 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestIAMResource_IAMType(t *testing.T) {
+	resource := NewIAMResource()
+
+	for _, iamType := range iamTypes {
+		if err := resource.Validate(map[string]interface{}{"iamType": iamType}); err != nil {
+			t.Errorf("Expected IAM type %s to be valid, got %v", iamType, err)
+		}
+	}
+
+	err := resource.Validate(map[string]interface{}{"iamType": "account"})
+	if code := providertest.ValidationCode(err, "iamType"); code != "INVALID_ENUM" {
+		t.Errorf("Expected INVALID_ENUM for IAM type account, got %q", code)
+	}
+}
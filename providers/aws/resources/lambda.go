@@ -0,0 +1,51 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// LambdaResource defines the AWS Lambda function resource
+type LambdaResource struct{}
+
+// NewLambdaResource creates a new Lambda Function resource instance
+func NewLambdaResource() *LambdaResource {
+	return &LambdaResource{}
+}
+
+// Definition returns the resource definition for Lambda Function elements
+func (r *LambdaResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "lambda",
+		Name:        "Lambda Function",
+		Description: "AWS Lambda function",
+		Category:    "compute",
+		Schema: providers.ResourceSchema("Lambda", 78, 78, map[string]interface{}{
+			"runtime": map[string]interface{}{
+				"type":        "string",
+				"description": "Function runtime (e.g. python3.12, nodejs20.x)",
+			},
+			"memory": map[string]interface{}{
+				"type":        "integer",
+				"description": "Memory in MB",
+				"minimum":     128,
+				"maximum":     10240,
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Lambda Function",
+				Description: "Basic Lambda Function",
+				Config: map[string]interface{}{
+					"label":   "Resize Images",
+					"runtime": "python3.12",
+					"memory":  512,
+				},
+			},
+		},
+	}
+}
+
+// Validate validates Lambda Function parameters
+func (r *LambdaResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
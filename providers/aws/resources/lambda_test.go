@@ -0,0 +1,24 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestLambdaResource_Memory(t *testing.T) {
+	resource := NewLambdaResource()
+
+	for _, memory := range []int{128, 1024, 10240} {
+		if err := resource.Validate(map[string]interface{}{"memory": memory}); err != nil {
+			t.Errorf("Expected %d MB of memory to be valid, got %v", memory, err)
+		}
+	}
+
+	for _, memory := range []int{64, 10241} {
+		err := resource.Validate(map[string]interface{}{"memory": memory})
+		if code := providertest.ValidationCode(err, "memory"); code != "OUT_OF_RANGE" {
+			t.Errorf("Expected OUT_OF_RANGE for %d MB of memory, got %q", memory, code)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// organizationIDPattern matches AWS Organizations organization IDs
+const organizationIDPattern = `^o-[a-z0-9]{10,32}$`
+
+// OrganizationResource defines the AWS organization resource
+type OrganizationResource struct{}
+
+// NewOrganizationResource creates a new Organization resource instance
+func NewOrganizationResource() *OrganizationResource {
+	return &OrganizationResource{}
+}
+
+// Definition returns the resource definition for Organization elements
+func (r *OrganizationResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "organization",
+		Name:        "Organization",
+		Description: "AWS Organizations root container grouping organizational units and accounts",
+		Category:    "management",
+		Schema: providers.ResourceSchema("AWS Organization", 800, 500, map[string]interface{}{
+			"organizationId": map[string]interface{}{
+				"type":        "string",
+				"description": "Organization ID",
+				"pattern":     organizationIDPattern,
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Organization",
+				Description: "Basic Organization container",
+				Config: map[string]interface{}{
+					"label":          "Example Corp",
+					"organizationId": "o-a1b2c3d4e5",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates Organization parameters
+func (r *OrganizationResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
@@ -0,0 +1,23 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestOrganizationResource_OrganizationID(t *testing.T) {
+	resource := NewOrganizationResource()
+
+	if err := resource.Validate(map[string]interface{}{"organizationId": "o-a1b2c3d4e5"}); err != nil {
+		t.Errorf("Expected organization ID o-a1b2c3d4e5 to be valid, got %v", err)
+	}
+
+	// Organization IDs have at least ten characters after the prefix
+	for _, organizationID := range []string{"org-a1b2c3d4e5", "o-a1b2", "o-A1B2C3D4E5"} {
+		err := resource.Validate(map[string]interface{}{"organizationId": organizationID})
+		if code := providertest.ValidationCode(err, "organizationId"); code != "INVALID_FORMAT" {
+			t.Errorf("Expected INVALID_FORMAT for organization ID %s, got %q", organizationID, code)
+		}
+	}
+}
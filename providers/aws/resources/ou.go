@@ -0,0 +1,48 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// ouIDPattern matches AWS Organizations organizational unit IDs
+const ouIDPattern = `^ou-[0-9a-z]{4,32}-[a-z0-9]{8,32}$`
+
+// OrganizationalUnitResource defines the AWS organizational unit resource
+type OrganizationalUnitResource struct{}
+
+// NewOrganizationalUnitResource creates a new Organizational Unit resource instance
+func NewOrganizationalUnitResource() *OrganizationalUnitResource {
+	return &OrganizationalUnitResource{}
+}
+
+// Definition returns the resource definition for Organizational Unit elements
+func (r *OrganizationalUnitResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "ou",
+		Name:        "Organizational Unit",
+		Description: "Organizational unit grouping accounts within an organization",
+		Category:    "management",
+		Schema: providers.ResourceSchema("Organizational Unit", 600, 400, map[string]interface{}{
+			"ouId": map[string]interface{}{
+				"type":        "string",
+				"description": "Organizational unit ID",
+				"pattern":     ouIDPattern,
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Organizational Unit",
+				Description: "Basic Organizational Unit container",
+				Config: map[string]interface{}{
+					"label": "Workloads",
+					"ouId":  "ou-ab12-cd34ef56",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates Organizational Unit parameters
+func (r *OrganizationalUnitResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
@@ -0,0 +1,23 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestOrganizationalUnitResource_OUID(t *testing.T) {
+	resource := NewOrganizationalUnitResource()
+
+	if err := resource.Validate(map[string]interface{}{"ouId": "ou-ab12-cd34ef56"}); err != nil {
+		t.Errorf("Expected OU ID ou-ab12-cd34ef56 to be valid, got %v", err)
+	}
+
+	// OU IDs name the root they belong to before the unit itself
+	for _, ouID := range []string{"ou-cd34ef56", "ou-ab-cd34ef56", "r-ab12-cd34ef56"} {
+		err := resource.Validate(map[string]interface{}{"ouId": ouID})
+		if code := providertest.ValidationCode(err, "ouId"); code != "INVALID_FORMAT" {
+			t.Errorf("Expected INVALID_FORMAT for OU ID %s, got %q", ouID, code)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// rdsEngines are the supported RDS database engines
+var rdsEngines = []string{"mysql", "postgres", "mariadb", "oracle", "sqlserver", "aurora-mysql", "aurora-postgresql"}
+
+// RDSResource defines the Amazon RDS database resource
+type RDSResource struct{}
+
+// NewRDSResource creates a new RDS Database resource instance
+func NewRDSResource() *RDSResource {
+	return &RDSResource{}
+}
+
+// Definition returns the resource definition for RDS Database elements
+func (r *RDSResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "rds",
+		Name:        "RDS Database",
+		Description: "Amazon Relational Database Service instance",
+		Category:    "database",
+		Schema: providers.ResourceSchema("RDS", 78, 78, map[string]interface{}{
+			"engine": map[string]interface{}{
+				"type":        "string",
+				"description": "Database engine",
+				"enum":        rdsEngines,
+			},
+			"multiAZ": map[string]interface{}{
+				"type":        "boolean",
+				"description": "Whether the instance is deployed across availability zones",
+				"default":     false,
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "RDS Database",
+				Description: "Basic RDS Database",
+				Config: map[string]interface{}{
+					"label":   "Orders DB",
+					"engine":  "postgres",
+					"multiAZ": true,
+				},
+			},
+		},
+	}
+}
+
+// Validate validates RDS Database parameters
+func (r *RDSResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
@@ -0,0 +1,32 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestRDSResource_Engine(t *testing.T) {
+	resource := NewRDSResource()
+
+	for _, engine := range rdsEngines {
+		if err := resource.Validate(map[string]interface{}{"engine": engine}); err != nil {
+			t.Errorf("Expected engine %s to be valid, got %v", engine, err)
+		}
+	}
+
+	// DynamoDB and DocumentDB are not RDS engines
+	for _, engine := range []string{"mongodb", "dynamodb", "Postgres"} {
+		err := resource.Validate(map[string]interface{}{"engine": engine})
+		if code := providertest.ValidationCode(err, "engine"); code != "INVALID_ENUM" {
+			t.Errorf("Expected INVALID_ENUM for engine %s, got %q", engine, code)
+		}
+	}
+}
+
+func TestRDSResource_MultiAZ(t *testing.T) {
+	err := NewRDSResource().Validate(map[string]interface{}{"multiAZ": "yes"})
+	if code := providertest.ValidationCode(err, "multiAZ"); code != "INVALID_TYPE" {
+		t.Errorf("Expected INVALID_TYPE for multiAZ yes, got %q", code)
+	}
+}
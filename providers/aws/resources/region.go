@@ -0,0 +1,48 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// regionPattern matches AWS region codes
+const regionPattern = `^[a-z]{2}(-gov|-iso[a-z]*)?-[a-z]+-[0-9]+$`
+
+// RegionResource defines the AWS region resource
+type RegionResource struct{}
+
+// NewRegionResource creates a new Region resource instance
+func NewRegionResource() *RegionResource {
+	return &RegionResource{}
+}
+
+// Definition returns the resource definition for Region elements
+func (r *RegionResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "region",
+		Name:        "Region",
+		Description: "AWS region container",
+		Category:    "networking",
+		Schema: providers.ResourceSchema("Region", 450, 300, map[string]interface{}{
+			"region": map[string]interface{}{
+				"type":        "string",
+				"description": "Region code",
+				"pattern":     regionPattern,
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Region",
+				Description: "Basic Region container",
+				Config: map[string]interface{}{
+					"label":  "US East (N. Virginia)",
+					"region": "us-east-1",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates Region parameters
+func (r *RegionResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
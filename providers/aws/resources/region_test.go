@@ -0,0 +1,25 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestRegionResource_Region(t *testing.T) {
+	resource := NewRegionResource()
+
+	for _, region := range []string{"us-east-1", "eu-central-2", "us-gov-west-1", "us-isob-east-1"} {
+		if err := resource.Validate(map[string]interface{}{"region": region}); err != nil {
+			t.Errorf("Expected region %s to be valid, got %v", region, err)
+		}
+	}
+
+	// Availability zones and region names without their number are not regions
+	for _, region := range []string{"us-east-1a", "us-east", "US-EAST-1"} {
+		err := resource.Validate(map[string]interface{}{"region": region})
+		if code := providertest.ValidationCode(err, "region"); code != "INVALID_FORMAT" {
+			t.Errorf("Expected INVALID_FORMAT for region %s, got %q", region, code)
+		}
+	}
+}
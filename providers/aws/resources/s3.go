@@ -0,0 +1,54 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// bucketNamePattern matches S3 bucket names
+const bucketNamePattern = `^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`
+
+// S3Resource defines the Amazon S3 bucket resource
+type S3Resource struct{}
+
+// NewS3Resource creates a new S3 Bucket resource instance
+func NewS3Resource() *S3Resource {
+	return &S3Resource{}
+}
+
+// Definition returns the resource definition for S3 Bucket elements
+func (r *S3Resource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "s3",
+		Name:        "S3 Bucket",
+		Description: "Amazon Simple Storage Service bucket",
+		Category:    "storage",
+		Schema: providers.ResourceSchema("S3 Bucket", 78, 78, map[string]interface{}{
+			"bucketName": map[string]interface{}{
+				"type":        "string",
+				"description": "Bucket name",
+				"pattern":     bucketNamePattern,
+			},
+			"versioning": map[string]interface{}{
+				"type":        "boolean",
+				"description": "Whether object versioning is enabled",
+				"default":     false,
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "S3 Bucket",
+				Description: "Basic S3 Bucket",
+				Config: map[string]interface{}{
+					"label":      "Assets",
+					"bucketName": "example-assets",
+					"versioning": true,
+				},
+			},
+		},
+	}
+}
+
+// Validate validates S3 Bucket parameters
+func (r *S3Resource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
@@ -0,0 +1,26 @@
+package resources
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestS3Resource_BucketName(t *testing.T) {
+	resource := NewS3Resource()
+
+	for _, bucketName := range []string{"example-assets", "logs.example.com", "abc"} {
+		if err := resource.Validate(map[string]interface{}{"bucketName": bucketName}); err != nil {
+			t.Errorf("Expected bucket name %s to be valid, got %v", bucketName, err)
+		}
+	}
+
+	// Bucket names are 3 to 63 lowercase characters that start and end with a letter or digit
+	for _, bucketName := range []string{"Example_Assets", "ab", "-assets", "assets.", strings.Repeat("a", 64)} {
+		err := resource.Validate(map[string]interface{}{"bucketName": bucketName})
+		if code := providertest.ValidationCode(err, "bucketName"); code != "INVALID_FORMAT" {
+			t.Errorf("Expected INVALID_FORMAT for bucket name %s, got %q", bucketName, code)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// subnetTypes are the supported subnet visibilities
+var subnetTypes = []string{"public", "private"}
+
+// SubnetResource defines the AWS subnet resource
+type SubnetResource struct{}
+
+// NewSubnetResource creates a new Subnet resource instance
+func NewSubnetResource() *SubnetResource {
+	return &SubnetResource{}
+}
+
+// Definition returns the resource definition for Subnet elements
+func (r *SubnetResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "subnet",
+		Name:        "Subnet",
+		Description: "Public or private subnet within a VPC",
+		Category:    "networking",
+		Schema: providers.ResourceSchema("Subnet", 200, 150, map[string]interface{}{
+			"cidr": map[string]interface{}{
+				"type":        "string",
+				"description": "IPv4 or IPv6 CIDR block",
+			},
+			"subnetType": map[string]interface{}{
+				"type":        "string",
+				"description": "Subnet visibility",
+				"default":     "private",
+				"enum":        subnetTypes,
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Subnet",
+				Description: "Basic Subnet container",
+				Config: map[string]interface{}{
+					"label":      "Public Subnet",
+					"cidr":       "10.0.1.0/24",
+					"subnetType": "public",
+				},
+			},
+		},
+		RequiredAncestors: []string{"vpc"},
+	}
+}
+
+// Validate validates Subnet parameters
+func (r *SubnetResource) Validate(params map[string]interface{}) error {
+	if err := providers.ValidateParams(r.Definition().Schema, params); err != nil {
+		return err
+	}
+	return providers.ValidateCIDR(params, "cidr")
+}
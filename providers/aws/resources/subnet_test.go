@@ -0,0 +1,34 @@
+package resources
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestSubnetResource_Validate(t *testing.T) {
+	resource := NewSubnetResource()
+
+	if err := resource.Validate(map[string]interface{}{"cidr": "10.0.1.0/24", "subnetType": "public"}); err != nil {
+		t.Errorf("Expected public subnet to be valid, got %v", err)
+	}
+
+	err := resource.Validate(map[string]interface{}{"cidr": "10.0.1.0/24", "subnetType": "dmz"})
+	if code := providertest.ValidationCode(err, "subnetType"); code != "INVALID_ENUM" {
+		t.Errorf("Expected INVALID_ENUM for subnet type dmz, got %q", code)
+	}
+
+	err = resource.Validate(map[string]interface{}{"cidr": "10.0.1.0/40"})
+	if code := providertest.ValidationCode(err, "cidr"); code != "INVALID_FORMAT" {
+		t.Errorf("Expected INVALID_FORMAT for CIDR 10.0.1.0/40, got %q", code)
+	}
+}
+
+func TestSubnetResource_RequiresVPC(t *testing.T) {
+	def := NewSubnetResource().Definition()
+
+	if !reflect.DeepEqual(def.RequiredAncestors, []string{"vpc"}) {
+		t.Errorf("Expected subnets to require an enclosing vpc, got %v", def.RequiredAncestors)
+	}
+}
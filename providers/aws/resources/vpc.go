@@ -0,0 +1,47 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// VPCResource defines the AWS VPC resource
+type VPCResource struct{}
+
+// NewVPCResource creates a new VPC resource instance
+func NewVPCResource() *VPCResource {
+	return &VPCResource{}
+}
+
+// Definition returns the resource definition for VPC elements
+func (r *VPCResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "vpc",
+		Name:        "VPC",
+		Description: "Virtual private cloud container",
+		Category:    "networking",
+		Schema: providers.ResourceSchema("VPC", 400, 300, map[string]interface{}{
+			"cidr": map[string]interface{}{
+				"type":        "string",
+				"description": "IPv4 or IPv6 CIDR block",
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "VPC",
+				Description: "Basic VPC container",
+				Config: map[string]interface{}{
+					"label": "Production VPC",
+					"cidr":  "10.0.0.0/16",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates VPC parameters
+func (r *VPCResource) Validate(params map[string]interface{}) error {
+	if err := providers.ValidateParams(r.Definition().Schema, params); err != nil {
+		return err
+	}
+	return providers.ValidateCIDR(params, "cidr")
+}
@@ -0,0 +1,33 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestVPCResource_CIDR(t *testing.T) {
+	resource := NewVPCResource()
+
+	if err := resource.Validate(map[string]interface{}{"cidr": "10.0.0.0/16"}); err != nil {
+		t.Errorf("Expected CIDR block 10.0.0.0/16 to be valid, got %v", err)
+	}
+
+	tests := map[string]struct {
+		cidr interface{}
+		code string
+	}{
+		"address without prefix": {cidr: "10.0.0.0", code: "INVALID_FORMAT"},
+		"prefix out of range":    {cidr: "10.0.0.0/33", code: "INVALID_FORMAT"},
+		"not a string":           {cidr: 16, code: "INVALID_TYPE"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := resource.Validate(map[string]interface{}{"cidr": tt.cidr})
+			if code := providertest.ValidationCode(err, "cidr"); code != tt.code {
+				t.Errorf("Expected code %q for CIDR %v, got %q", tt.code, tt.cidr, code)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// AccountTemplate handles template generation for Account elements
+type AccountTemplate struct{}
+
+// NewAccountTemplate creates a new Account template generator
+func NewAccountTemplate() *AccountTemplate {
+	return &AccountTemplate{}
+}
+
+// Generate creates a schema.Element from Account parameters
+func (t *AccountTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	return newGroup(params, groupSpec{
+		label:     "AWS Account",
+		icon:      "group_account",
+		stroke:    colorAccount,
+		fontColor: colorAccount,
+		width:     500,
+		height:    350,
+	}, getStringParam(params, "accountId", "")), nil
+}
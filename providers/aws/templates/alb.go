@@ -0,0 +1,22 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// ALBTemplate handles template generation for Application Load Balancer elements
+type ALBTemplate struct{}
+
+// NewALBTemplate creates a new Application Load Balancer template generator
+func NewALBTemplate() *ALBTemplate {
+	return &ALBTemplate{}
+}
+
+// Generate creates a schema.Element from Application Load Balancer parameters
+func (t *ALBTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	return newIcon(params, iconSpec{
+		label: "Application Load Balancer",
+		icon:  "elastic_load_balancing",
+		fill:  colorNetworking,
+	}, getStringParam(params, "scheme", "")), nil
+}
@@ -0,0 +1,25 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// AvailabilityZoneTemplate handles template generation for Availability Zone elements
+type AvailabilityZoneTemplate struct{}
+
+// NewAvailabilityZoneTemplate creates a new Availability Zone template generator
+func NewAvailabilityZoneTemplate() *AvailabilityZoneTemplate {
+	return &AvailabilityZoneTemplate{}
+}
+
+// Generate creates a schema.Element from Availability Zone parameters
+func (t *AvailabilityZoneTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	return newGroup(params, groupSpec{
+		label:     "Availability Zone",
+		stroke:    colorZone,
+		fontColor: colorZone,
+		dashed:    true,
+		width:     250,
+		height:    250,
+	}, getStringParam(params, "zone", "")), nil
+}
@@ -0,0 +1,186 @@
+package templates
+
+import (
+	"fmt"
+
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// AWS architecture icon category colors
+const (
+	colorCompute     = "#ED7100"
+	colorContainers  = "#ED7100"
+	colorDatabase    = "#C925D1"
+	colorStorage     = "#7AA116"
+	colorNetworking  = "#8C4FFF"
+	colorSecurity    = "#DD344C"
+	colorManagement  = "#E7157B"
+	colorCloud       = "#232F3E"
+	colorAccount     = "#CD2264"
+	colorRegion      = "#00A4A6"
+	colorZone        = "#147EBA"
+	colorPublicZone  = "#7AA116"
+	colorPrivateZone = "#00A4A6"
+	colorGroupLabel  = "#AAB7B8"
+)
+
+// groupSpec describes the appearance of an AWS group container
+type groupSpec struct {
+	label     string
+	icon      string // mxgraph.aws4 group icon, empty for plain dashed groups
+	stroke    string
+	fill      string
+	fontColor string
+	dashed    bool
+	width     float64
+	height    float64
+}
+
+// iconSpec describes the appearance of an AWS resource icon
+type iconSpec struct {
+	label string
+	icon  string // mxgraph.aws4 resource icon
+	fill  string // Category color
+	size  float64
+}
+
+// newGroup creates an AWS group container element
+func newGroup(params map[string]interface{}, spec groupSpec, detail string) *schema.Element {
+	fill := spec.fill
+	if fill == "" {
+		fill = "none"
+	}
+
+	custom := map[string]string{
+		"html":            "1",
+		"whiteSpace":      "wrap",
+		"container":       "1",
+		"pointerEvents":   "0",
+		"collapsible":     "0",
+		"recursiveResize": "0",
+		"spacingLeft":     "30",
+	}
+	shape := ""
+	if spec.icon != "" {
+		shape = "mxgraph.aws4.group"
+		custom["grIcon"] = "mxgraph.aws4." + spec.icon
+		if spec.fill != "" {
+			custom["grStroke"] = "0"
+		}
+	}
+
+	style := schema.Style{
+		FillColor:     getStringParam(params, "fillColor", fill),
+		StrokeColor:   getStringParam(params, "strokeColor", spec.stroke),
+		FontColor:     getStringParam(params, "fontColor", spec.fontColor),
+		FontSize:      12,
+		TextAlign:     "left",
+		VerticalAlign: "top",
+		Custom:        custom,
+	}
+	if spec.dashed {
+		style.StrokeDashArray = "8 4"
+	}
+
+	return &schema.Element{
+		Type: schema.ElementTypeGroup,
+		Properties: schema.ElementProperties{
+			X:      getFloatParam(params, "x", 0),
+			Y:      getFloatParam(params, "y", 0),
+			Width:  getFloatParam(params, "width", spec.width),
+			Height: getFloatParam(params, "height", spec.height),
+			Label:  formatLabel(getStringParam(params, "label", spec.label), detail),
+			Shape:  shape,
+		},
+		Style: style,
+	}
+}
+
+// newIcon creates an AWS resource icon element with its label below the icon
+func newIcon(params map[string]interface{}, spec iconSpec, detail string) *schema.Element {
+	size := spec.size
+	if size == 0 {
+		size = 78
+	}
+
+	return &schema.Element{
+		Type: schema.ElementTypeShape,
+		Properties: schema.ElementProperties{
+			X:      getFloatParam(params, "x", 0),
+			Y:      getFloatParam(params, "y", 0),
+			Width:  getFloatParam(params, "width", size),
+			Height: getFloatParam(params, "height", size),
+			Label:  formatLabel(getStringParam(params, "label", spec.label), detail),
+			Shape:  "mxgraph.aws4.resourceIcon",
+		},
+		Style: schema.Style{
+			FillColor:             getStringParam(params, "fillColor", spec.fill),
+			StrokeColor:           "#ffffff",
+			FontColor:             colorCloud,
+			FontSize:              12,
+			VerticalLabelPosition: "bottom",
+			VerticalAlign:         "top",
+			TextAlign:             "center",
+			Custom: map[string]string{
+				"resIcon":        "mxgraph.aws4." + spec.icon,
+				"html":           "1",
+				"outlineConnect": "0",
+				"aspect":         "fixed",
+			},
+		},
+	}
+}
+
+// formatLabel appends a detail such as an ID or CIDR block to a label
+func formatLabel(label, detail string) string {
+	if detail == "" {
+		return label
+	}
+	return fmt.Sprintf("%s (%s)", label, detail)
+}
+
+func getStringParam(params map[string]interface{}, key, defaultValue string) string {
+	if val, ok := params[key]; ok {
+		if str, ok := val.(string); ok {
+			return str
+		}
+	}
+	return defaultValue
+}
+
+func getFloatParam(params map[string]interface{}, key string, defaultValue float64) float64 {
+	if val, ok := params[key]; ok {
+		switch v := val.(type) {
+		case float64:
+			return v
+		case int:
+			return float64(v)
+		case int64:
+			return float64(v)
+		}
+	}
+	return defaultValue
+}
+
+func getIntParam(params map[string]interface{}, key string, defaultValue int) int {
+	if val, ok := params[key]; ok {
+		switch v := val.(type) {
+		case int:
+			return v
+		case int64:
+			return int(v)
+		case float64:
+			return int(v)
+		}
+	}
+	return defaultValue
+}
+
+func getBoolParam(params map[string]interface{}, key string, defaultValue bool) bool {
+	if val, ok := params[key]; ok {
+		if b, ok := val.(bool); ok {
+			return b
+		}
+	}
+	return defaultValue
+}
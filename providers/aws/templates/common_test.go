@@ -0,0 +1,60 @@
+package templates
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+func TestNewIcon(t *testing.T) {
+	element := newIcon(map[string]interface{}{"x": 10, "y": 20.5, "label": "Orders"}, iconSpec{
+		label: "RDS",
+		icon:  "rds",
+		fill:  colorDatabase,
+	}, "postgres")
+
+	if element.Type != schema.ElementTypeShape {
+		t.Errorf("Expected shape element, got %s", element.Type)
+	}
+	if element.Properties.X != 10 || element.Properties.Y != 20.5 {
+		t.Errorf("Expected position (10, 20.5), got (%v, %v)", element.Properties.X, element.Properties.Y)
+	}
+	if element.Properties.Width != 78 || element.Properties.Height != 78 {
+		t.Errorf("Expected default icon size 78, got %vx%v", element.Properties.Width, element.Properties.Height)
+	}
+	if element.Properties.Label != "Orders (postgres)" {
+		t.Errorf("Expected label 'Orders (postgres)', got '%s'", element.Properties.Label)
+	}
+	if element.Properties.Shape != "mxgraph.aws4.resourceIcon" || element.Style.Custom["resIcon"] != "mxgraph.aws4.rds" {
+		t.Error("Expected mxgraph.aws4 resource icon for RDS")
+	}
+	if element.Style.FillColor != colorDatabase {
+		t.Errorf("Expected database category color, got %s", element.Style.FillColor)
+	}
+}
+
+func TestNewGroup(t *testing.T) {
+	element := newGroup(map[string]interface{}{"width": 640}, groupSpec{
+		label:  "Availability Zone",
+		stroke: colorZone,
+		dashed: true,
+		width:  250,
+		height: 250,
+	}, "")
+
+	if element.Type != schema.ElementTypeGroup {
+		t.Errorf("Expected group element, got %s", element.Type)
+	}
+	if element.Properties.Width != 640 || element.Properties.Height != 250 {
+		t.Errorf("Expected size 640x250, got %vx%v", element.Properties.Width, element.Properties.Height)
+	}
+	if element.Properties.Shape != "" {
+		t.Errorf("Expected plain group without group icon, got shape %s", element.Properties.Shape)
+	}
+	if element.Style.StrokeDashArray == "" {
+		t.Error("Expected dashed group border")
+	}
+	if element.Style.FillColor != "none" {
+		t.Errorf("Expected transparent group, got %s", element.Style.FillColor)
+	}
+}
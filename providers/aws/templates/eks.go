@@ -0,0 +1,33 @@
+package templates
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// EKSTemplate handles template generation for EKS Cluster elements
+type EKSTemplate struct{}
+
+// NewEKSTemplate creates a new EKS Cluster template generator
+func NewEKSTemplate() *EKSTemplate {
+	return &EKSTemplate{}
+}
+
+// Generate creates a schema.Element from EKS Cluster parameters
+func (t *EKSTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	var details []string
+	if version := getStringParam(params, "version", ""); version != "" {
+		details = append(details, "v"+version)
+	}
+	if nodeCount := getIntParam(params, "nodeCount", 0); nodeCount > 0 {
+		details = append(details, fmt.Sprintf("%d nodes", nodeCount))
+	}
+
+	return newIcon(params, iconSpec{
+		label: "EKS Cluster",
+		icon:  "eks",
+		fill:  colorContainers,
+	}, strings.Join(details, ", ")), nil
+}
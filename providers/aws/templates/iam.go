@@ -0,0 +1,22 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// IAMTemplate handles template generation for IAM Entity elements
+type IAMTemplate struct{}
+
+// NewIAMTemplate creates a new IAM Entity template generator
+func NewIAMTemplate() *IAMTemplate {
+	return &IAMTemplate{}
+}
+
+// Generate creates a schema.Element from IAM Entity parameters
+func (t *IAMTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	return newIcon(params, iconSpec{
+		label: "IAM",
+		icon:  "identity_and_access_management",
+		fill:  colorSecurity,
+	}, getStringParam(params, "iamType", "")), nil
+}
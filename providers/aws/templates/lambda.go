@@ -0,0 +1,22 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// LambdaTemplate handles template generation for Lambda Function elements
+type LambdaTemplate struct{}
+
+// NewLambdaTemplate creates a new Lambda Function template generator
+func NewLambdaTemplate() *LambdaTemplate {
+	return &LambdaTemplate{}
+}
+
+// Generate creates a schema.Element from Lambda Function parameters
+func (t *LambdaTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	return newIcon(params, iconSpec{
+		label: "Lambda",
+		icon:  "lambda",
+		fill:  colorCompute,
+	}, getStringParam(params, "runtime", "")), nil
+}
@@ -0,0 +1,25 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// OrganizationTemplate handles template generation for Organization elements
+type OrganizationTemplate struct{}
+
+// NewOrganizationTemplate creates a new Organization template generator
+func NewOrganizationTemplate() *OrganizationTemplate {
+	return &OrganizationTemplate{}
+}
+
+// Generate creates a schema.Element from Organization parameters
+func (t *OrganizationTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	return newGroup(params, groupSpec{
+		label:     "AWS Organization",
+		icon:      "group_aws_cloud_alt",
+		stroke:    colorCloud,
+		fontColor: colorCloud,
+		width:     800,
+		height:    500,
+	}, getStringParam(params, "organizationId", "")), nil
+}
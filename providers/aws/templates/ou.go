@@ -0,0 +1,26 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// OrganizationalUnitTemplate handles template generation for Organizational Unit elements
+type OrganizationalUnitTemplate struct{}
+
+// NewOrganizationalUnitTemplate creates a new Organizational Unit template generator
+func NewOrganizationalUnitTemplate() *OrganizationalUnitTemplate {
+	return &OrganizationalUnitTemplate{}
+}
+
+// Generate creates a schema.Element from Organizational Unit parameters
+func (t *OrganizationalUnitTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	return newGroup(params, groupSpec{
+		label:     "Organizational Unit",
+		icon:      "group_aws_cloud",
+		stroke:    colorManagement,
+		fontColor: colorManagement,
+		dashed:    true,
+		width:     600,
+		height:    400,
+	}, getStringParam(params, "ouId", "")), nil
+}
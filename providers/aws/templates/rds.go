@@ -0,0 +1,32 @@
+package templates
+
+import (
+	"strings"
+
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// RDSTemplate handles template generation for RDS Database elements
+type RDSTemplate struct{}
+
+// NewRDSTemplate creates a new RDS Database template generator
+func NewRDSTemplate() *RDSTemplate {
+	return &RDSTemplate{}
+}
+
+// Generate creates a schema.Element from RDS Database parameters
+func (t *RDSTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	var details []string
+	if engine := getStringParam(params, "engine", ""); engine != "" {
+		details = append(details, engine)
+	}
+	if getBoolParam(params, "multiAZ", false) {
+		details = append(details, "Multi-AZ")
+	}
+
+	return newIcon(params, iconSpec{
+		label: "RDS",
+		icon:  "rds",
+		fill:  colorDatabase,
+	}, strings.Join(details, ", ")), nil
+}
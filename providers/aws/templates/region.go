@@ -0,0 +1,26 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// RegionTemplate handles template generation for Region elements
+type RegionTemplate struct{}
+
+// NewRegionTemplate creates a new Region template generator
+func NewRegionTemplate() *RegionTemplate {
+	return &RegionTemplate{}
+}
+
+// Generate creates a schema.Element from Region parameters
+func (t *RegionTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	return newGroup(params, groupSpec{
+		label:     "Region",
+		icon:      "group_region",
+		stroke:    colorRegion,
+		fontColor: colorRegion,
+		dashed:    true,
+		width:     450,
+		height:    300,
+	}, getStringParam(params, "region", "")), nil
+}
@@ -0,0 +1,22 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// S3Template handles template generation for S3 Bucket elements
+type S3Template struct{}
+
+// NewS3Template creates a new S3 Bucket template generator
+func NewS3Template() *S3Template {
+	return &S3Template{}
+}
+
+// Generate creates a schema.Element from S3 Bucket parameters
+func (t *S3Template) Generate(params map[string]interface{}) (*schema.Element, error) {
+	return newIcon(params, iconSpec{
+		label: "S3 Bucket",
+		icon:  "s3",
+		fill:  colorStorage,
+	}, getStringParam(params, "bucketName", "")), nil
+}
@@ -0,0 +1,34 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// SubnetTemplate handles template generation for Subnet elements
+type SubnetTemplate struct{}
+
+// NewSubnetTemplate creates a new Subnet template generator
+func NewSubnetTemplate() *SubnetTemplate {
+	return &SubnetTemplate{}
+}
+
+// Generate creates a schema.Element from Subnet parameters
+func (t *SubnetTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	spec := groupSpec{
+		label:     "Private Subnet",
+		icon:      "group_security_group",
+		stroke:    colorPrivateZone,
+		fill:      "#E6F6F7",
+		fontColor: colorZone,
+		width:     200,
+		height:    150,
+	}
+	if getStringParam(params, "subnetType", "private") == "public" {
+		spec.label = "Public Subnet"
+		spec.stroke = colorPublicZone
+		spec.fill = "#F2F6E8"
+		spec.fontColor = "#248814"
+	}
+
+	return newGroup(params, spec, getStringParam(params, "cidr", "")), nil
+}
@@ -0,0 +1,60 @@
+package templates
+
+import (
+	"testing"
+)
+
+func TestSubnetTemplate_Generate(t *testing.T) {
+	template := NewSubnetTemplate()
+
+	tests := []struct {
+		name       string
+		params     map[string]interface{}
+		label      string
+		stroke     string
+		fill       string
+		groupShape string
+	}{
+		{
+			name:       "private subnet by default",
+			params:     map[string]interface{}{"cidr": "10.0.2.0/24"},
+			label:      "Private Subnet (10.0.2.0/24)",
+			stroke:     colorPrivateZone,
+			fill:       "#E6F6F7",
+			groupShape: "mxgraph.aws4.group",
+		},
+		{
+			name:       "public subnet",
+			params:     map[string]interface{}{"subnetType": "public", "label": "Web"},
+			label:      "Web",
+			stroke:     colorPublicZone,
+			fill:       "#F2F6E8",
+			groupShape: "mxgraph.aws4.group",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			element, err := template.Generate(tt.params)
+			if err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+
+			if element.Properties.Label != tt.label {
+				t.Errorf("Expected label '%s', got '%s'", tt.label, element.Properties.Label)
+			}
+			if element.Style.StrokeColor != tt.stroke {
+				t.Errorf("Expected stroke color %s, got %s", tt.stroke, element.Style.StrokeColor)
+			}
+			if element.Style.FillColor != tt.fill {
+				t.Errorf("Expected fill color %s, got %s", tt.fill, element.Style.FillColor)
+			}
+			if element.Properties.Shape != tt.groupShape {
+				t.Errorf("Expected shape %s, got %s", tt.groupShape, element.Properties.Shape)
+			}
+			if element.Style.Custom["grIcon"] != "mxgraph.aws4.group_security_group" {
+				t.Errorf("Expected subnet group icon, got %s", element.Style.Custom["grIcon"])
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package templates
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+func TestTemplates_Generate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template interface {
+			Generate(map[string]interface{}) (*schema.Element, error)
+		}
+		params map[string]interface{}
+		label  string
+		icon   string // resIcon of icons, grIcon of groups
+		color  string // Fill color of icons, stroke color of groups
+	}{
+		{name: "organization", template: NewOrganizationTemplate(), params: map[string]interface{}{"organizationId": "o-a1b2c3d4e5"}, label: "AWS Organization (o-a1b2c3d4e5)", icon: "mxgraph.aws4.group_aws_cloud_alt", color: colorCloud},
+		{name: "ou", template: NewOrganizationalUnitTemplate(), params: map[string]interface{}{"label": "Workloads"}, label: "Workloads", icon: "mxgraph.aws4.group_aws_cloud", color: colorManagement},
+		{name: "account", template: NewAccountTemplate(), params: map[string]interface{}{"accountId": "123456789012"}, label: "AWS Account (123456789012)", icon: "mxgraph.aws4.group_account", color: colorAccount},
+		{name: "region", template: NewRegionTemplate(), params: map[string]interface{}{"region": "eu-west-1"}, label: "Region (eu-west-1)", icon: "mxgraph.aws4.group_region", color: colorRegion},
+		{name: "az without group icon", template: NewAvailabilityZoneTemplate(), params: map[string]interface{}{"zone": "eu-west-1a"}, label: "Availability Zone (eu-west-1a)", color: colorZone},
+		{name: "vpc", template: NewVPCTemplate(), params: map[string]interface{}{"cidr": "10.0.0.0/16"}, label: "VPC (10.0.0.0/16)", icon: "mxgraph.aws4.group_vpc2", color: colorNetworking},
+		{name: "eks with version and nodes", template: NewEKSTemplate(), params: map[string]interface{}{"version": "1.29", "nodeCount": 3}, label: "EKS Cluster (v1.29, 3 nodes)", icon: "mxgraph.aws4.eks", color: colorContainers},
+		{name: "eks without nodes", template: NewEKSTemplate(), params: map[string]interface{}{"nodeCount": 0}, label: "EKS Cluster", icon: "mxgraph.aws4.eks", color: colorContainers},
+		{name: "rds multi-AZ", template: NewRDSTemplate(), params: map[string]interface{}{"engine": "postgres", "multiAZ": true}, label: "RDS (postgres, Multi-AZ)", icon: "mxgraph.aws4.rds", color: colorDatabase},
+		{name: "s3", template: NewS3Template(), params: map[string]interface{}{"bucketName": "example-assets"}, label: "S3 Bucket (example-assets)", icon: "mxgraph.aws4.s3", color: colorStorage},
+		{name: "lambda", template: NewLambdaTemplate(), params: map[string]interface{}{"runtime": "go1.x"}, label: "Lambda (go1.x)", icon: "mxgraph.aws4.lambda", color: colorCompute},
+		{name: "alb", template: NewALBTemplate(), params: map[string]interface{}{"scheme": "internal"}, label: "Application Load Balancer (internal)", icon: "mxgraph.aws4.elastic_load_balancing", color: colorNetworking},
+		{name: "iam", template: NewIAMTemplate(), params: map[string]interface{}{"iamType": "role"}, label: "IAM (role)", icon: "mxgraph.aws4.identity_and_access_management", color: colorSecurity},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			element, err := tt.template.Generate(tt.params)
+			if err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+
+			if element.Properties.Label != tt.label {
+				t.Errorf("Expected label '%s', got '%s'", tt.label, element.Properties.Label)
+			}
+
+			icon, color := element.Style.Custom["resIcon"], element.Style.FillColor
+			if element.Type == schema.ElementTypeGroup {
+				icon, color = element.Style.Custom["grIcon"], element.Style.StrokeColor
+			}
+			if icon != tt.icon {
+				t.Errorf("Expected icon '%s', got '%s'", tt.icon, icon)
+			}
+			if color != tt.color {
+				t.Errorf("Expected color %s, got %s", tt.color, color)
+			}
+		})
+	}
+}
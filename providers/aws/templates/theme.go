@@ -0,0 +1,44 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// Icon styles and palettes selectable in the provider settings
+const (
+	IconStyleColor = "color" // Icons on their category color (default)
+	IconStyleFlat  = "flat"  // Icons drawn in their category color without a background
+	PaletteLight   = "light" // Colors for light page backgrounds (default)
+	PaletteDark    = "dark"  // Colors for dark page backgrounds
+)
+
+// colorDarkLabel is the label color of the dark palette
+const colorDarkLabel = "#FFFFFF"
+
+// Theme adapts generated elements to the icon style and palette of a configured provider
+type Theme struct {
+	IconStyle string
+	Palette   string
+}
+
+// Apply adapts a generated element to the theme. Colors given as parameters are kept.
+func (t Theme) Apply(element *schema.Element, params map[string]interface{}) {
+	icon := element.Properties.Shape == "mxgraph.aws4.resourceIcon"
+	_, fillSet := params["fillColor"]
+	_, fontSet := params["fontColor"]
+
+	if icon && t.IconStyle == IconStyleFlat && !fillSet {
+		element.Style.StrokeColor = element.Style.FillColor
+		element.Style.FillColor = "none"
+	}
+
+	if t.Palette == PaletteDark {
+		if !fontSet {
+			element.Style.FontColor = colorDarkLabel
+		}
+		// Light group fills such as those of subnets would hide the dark background
+		if !icon && !fillSet {
+			element.Style.FillColor = "none"
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// VPCTemplate handles template generation for VPC elements
+type VPCTemplate struct{}
+
+// NewVPCTemplate creates a new VPC template generator
+func NewVPCTemplate() *VPCTemplate {
+	return &VPCTemplate{}
+}
+
+// Generate creates a schema.Element from VPC parameters
+func (t *VPCTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	return newGroup(params, groupSpec{
+		label:     "VPC",
+		icon:      "group_vpc2",
+		stroke:    colorNetworking,
+		fontColor: colorGroupLabel,
+		width:     400,
+		height:    300,
+	}, getStringParam(params, "cidr", "")), nil
+}
@@ -0,0 +1,213 @@
+package azure
+
+import (
+	"fmt"
+
+	"github.com/LederWorks/hippodamus/pkg/providers"
+	"github.com/LederWorks/hippodamus/pkg/schema"
+	"github.com/LederWorks/hippodamus/providers/azure/resources"
+	"github.com/LederWorks/hippodamus/providers/azure/templates"
+)
+
+// AzureProvider implements the Provider interface for Azure architecture elements
+type AzureProvider struct {
+	version string
+	// Resource instances
+	tenantResource          *resources.TenantResource
+	managementGroupResource *resources.ManagementGroupResource
+	subscriptionResource    *resources.SubscriptionResource
+	resourceGroupResource   *resources.ResourceGroupResource
+	vnetResource            *resources.VNetResource
+	subnetResource          *resources.SubnetResource
+	nsgResource             *resources.NSGResource
+	aksResource             *resources.AKSResource
+	appServiceResource      *resources.AppServiceResource
+	functionAppResource     *resources.FunctionAppResource
+	storageResource         *resources.StorageResource
+	sqlResource             *resources.SQLResource
+	keyVaultResource        *resources.KeyVaultResource
+	// Template instances
+	tenantTemplate          *templates.TenantTemplate
+	managementGroupTemplate *templates.ManagementGroupTemplate
+	subscriptionTemplate    *templates.SubscriptionTemplate
+	resourceGroupTemplate   *templates.ResourceGroupTemplate
+	vnetTemplate            *templates.VNetTemplate
+	subnetTemplate          *templates.SubnetTemplate
+	nsgTemplate             *templates.NSGTemplate
+	aksTemplate             *templates.AKSTemplate
+	appServiceTemplate      *templates.AppServiceTemplate
+	functionAppTemplate     *templates.FunctionAppTemplate
+	storageTemplate         *templates.StorageTemplate
+	sqlTemplate             *templates.SQLTemplate
+	keyVaultTemplate        *templates.KeyVaultTemplate
+}
+
+// NewAzureProvider creates a new Azure provider instance
+func NewAzureProvider() *AzureProvider {
+	return NewAzureProviderWithVersion("dev")
+}
+
+// NewAzureProviderWithVersion creates a new Azure provider instance with a specific version
+func NewAzureProviderWithVersion(version string) *AzureProvider {
+	return &AzureProvider{
+		version:                 version,
+		tenantResource:          resources.NewTenantResource(),
+		managementGroupResource: resources.NewManagementGroupResource(),
+		subscriptionResource:    resources.NewSubscriptionResource(),
+		resourceGroupResource:   resources.NewResourceGroupResource(),
+		vnetResource:            resources.NewVNetResource(),
+		subnetResource:          resources.NewSubnetResource(),
+		nsgResource:             resources.NewNSGResource(),
+		aksResource:             resources.NewAKSResource(),
+		appServiceResource:      resources.NewAppServiceResource(),
+		functionAppResource:     resources.NewFunctionAppResource(),
+		storageResource:         resources.NewStorageResource(),
+		sqlResource:             resources.NewSQLResource(),
+		keyVaultResource:        resources.NewKeyVaultResource(),
+		tenantTemplate:          templates.NewTenantTemplate(),
+		managementGroupTemplate: templates.NewManagementGroupTemplate(),
+		subscriptionTemplate:    templates.NewSubscriptionTemplate(),
+		resourceGroupTemplate:   templates.NewResourceGroupTemplate(),
+		vnetTemplate:            templates.NewVNetTemplate(),
+		subnetTemplate:          templates.NewSubnetTemplate(),
+		nsgTemplate:             templates.NewNSGTemplate(),
+		aksTemplate:             templates.NewAKSTemplate(),
+		appServiceTemplate:      templates.NewAppServiceTemplate(),
+		functionAppTemplate:     templates.NewFunctionAppTemplate(),
+		storageTemplate:         templates.NewStorageTemplate(),
+		sqlTemplate:             templates.NewSQLTemplate(),
+		keyVaultTemplate:        templates.NewKeyVaultTemplate(),
+	}
+}
+
+// Name returns the provider name
+func (p *AzureProvider) Name() string {
+	return "azure"
+}
+
+// Version returns the provider version
+func (p *AzureProvider) Version() string {
+	return p.version
+}
+
+// Resources returns the list of supported Azure resources
+func (p *AzureProvider) Resources() []providers.ResourceDefinition {
+	return []providers.ResourceDefinition{
+		p.tenantResource.Definition(),
+		p.managementGroupResource.Definition(),
+		p.subscriptionResource.Definition(),
+		p.resourceGroupResource.Definition(),
+		p.vnetResource.Definition(),
+		p.subnetResource.Definition(),
+		p.nsgResource.Definition(),
+		p.aksResource.Definition(),
+		p.appServiceResource.Definition(),
+		p.functionAppResource.Definition(),
+		p.storageResource.Definition(),
+		p.sqlResource.Definition(),
+		p.keyVaultResource.Definition(),
+	}
+}
+
+// Validate validates Azure resource parameters
+func (p *AzureProvider) Validate(resourceType string, params map[string]interface{}) error {
+	switch resourceType {
+	case "tenant":
+		return p.tenantResource.Validate(params)
+	case "management-group":
+		return p.managementGroupResource.Validate(params)
+	case "subscription":
+		return p.subscriptionResource.Validate(params)
+	case "resource-group":
+		return p.resourceGroupResource.Validate(params)
+	case "vnet":
+		return p.vnetResource.Validate(params)
+	case "subnet":
+		return p.subnetResource.Validate(params)
+	case "nsg":
+		return p.nsgResource.Validate(params)
+	case "aks":
+		return p.aksResource.Validate(params)
+	case "app-service":
+		return p.appServiceResource.Validate(params)
+	case "function-app":
+		return p.functionAppResource.Validate(params)
+	case "storage":
+		return p.storageResource.Validate(params)
+	case "sql":
+		return p.sqlResource.Validate(params)
+	case "key-vault":
+		return p.keyVaultResource.Validate(params)
+	default:
+		return &providers.ProviderError{
+			Provider: p.Name(),
+			Resource: resourceType,
+			Message:  fmt.Sprintf("unsupported resource type: %s", resourceType),
+			Code:     "UNSUPPORTED_RESOURCE",
+		}
+	}
+}
+
+// GenerateTemplate generates Azure resource templates
+func (p *AzureProvider) GenerateTemplate(resourceType string, params map[string]interface{}) (*schema.Element, error) {
+	if err := p.Validate(resourceType, params); err != nil {
+		return nil, err
+	}
+
+	switch resourceType {
+	case "tenant":
+		return p.tenantTemplate.Generate(params)
+	case "management-group":
+		return p.managementGroupTemplate.Generate(params)
+	case "subscription":
+		return p.subscriptionTemplate.Generate(params)
+	case "resource-group":
+		return p.resourceGroupTemplate.Generate(params)
+	case "vnet":
+		return p.vnetTemplate.Generate(params)
+	case "subnet":
+		return p.subnetTemplate.Generate(params)
+	case "nsg":
+		return p.nsgTemplate.Generate(params)
+	case "aks":
+		return p.aksTemplate.Generate(params)
+	case "app-service":
+		return p.appServiceTemplate.Generate(params)
+	case "function-app":
+		return p.functionAppTemplate.Generate(params)
+	case "storage":
+		return p.storageTemplate.Generate(params)
+	case "sql":
+		return p.sqlTemplate.Generate(params)
+	case "key-vault":
+		return p.keyVaultTemplate.Generate(params)
+	default:
+		return nil, &providers.ProviderError{
+			Provider: p.Name(),
+			Resource: resourceType,
+			Message:  fmt.Sprintf("unsupported resource type: %s", resourceType),
+			Code:     "UNSUPPORTED_RESOURCE",
+		}
+	}
+}
+
+// GetSchema returns the JSON schema for a resource type
+func (p *AzureProvider) GetSchema(resourceType string) (map[string]interface{}, error) {
+	for _, resource := range p.Resources() {
+		if resource.Type == resourceType {
+			return resource.Schema, nil
+		}
+	}
+
+	return nil, &providers.ProviderError{
+		Provider: p.Name(),
+		Resource: resourceType,
+		Message:  fmt.Sprintf("schema not found for resource type: %s", resourceType),
+		Code:     "SCHEMA_NOT_FOUND",
+	}
+}
+
+// GetVersion returns the provider version
+func (p *AzureProvider) GetVersion() string {
+	return p.version
+}
@@ -0,0 +1,124 @@
+package azure
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers"
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+func TestAzureProvider_Basic(t *testing.T) {
+	provider := NewAzureProvider()
+
+	if provider.Name() != "azure" {
+		t.Errorf("Expected provider name 'azure', got '%s'", provider.Name())
+	}
+
+	if provider.Version() != "dev" {
+		t.Errorf("Expected version 'dev', got '%s'", provider.Version())
+	}
+
+	expected := []string{"tenant", "management-group", "subscription", "resource-group", "vnet", "subnet", "nsg", "aks", "app-service", "function-app", "storage", "sql", "key-vault"}
+	resources := provider.Resources()
+	if len(resources) != len(expected) {
+		t.Fatalf("Expected %d resources, got %d", len(expected), len(resources))
+	}
+
+	for i, resourceType := range expected {
+		if resources[i].Type != resourceType {
+			t.Errorf("Expected resource %d to be '%s', got '%s'", i, resourceType, resources[i].Type)
+		}
+	}
+}
+
+func TestAzureProvider_Conformance(t *testing.T) {
+	providertest.Run(t, NewAzureProvider())
+}
+
+func TestAzureProvider_Examples(t *testing.T) {
+	provider := NewAzureProvider()
+
+	for _, resource := range provider.Resources() {
+		t.Run(resource.Type, func(t *testing.T) {
+			if len(resource.Examples) == 0 {
+				t.Fatal("Expected at least one example")
+			}
+
+			element, err := provider.GenerateTemplate(resource.Type, resource.Examples[0].Config)
+			if err != nil {
+				t.Fatalf("GenerateTemplate() error = %v", err)
+			}
+
+			if !strings.HasPrefix(element.Style.Custom["image"], "img/lib/azure2/") {
+				t.Errorf("Expected img/lib/azure2 icon, got '%s'", element.Style.Custom["image"])
+			}
+
+			if element.Properties.Width <= 0 || element.Properties.Height <= 0 {
+				t.Error("Expected element to have a default size")
+			}
+		})
+	}
+}
+
+func TestAzureProvider_ContainerTypes(t *testing.T) {
+	provider := NewAzureProvider()
+
+	containers := map[string]bool{"tenant": true, "management-group": true, "subscription": true, "resource-group": true, "vnet": true, "subnet": true}
+	for _, resource := range provider.Resources() {
+		element, err := provider.GenerateTemplate(resource.Type, map[string]interface{}{})
+		if err != nil {
+			t.Fatalf("GenerateTemplate(%s) error = %v", resource.Type, err)
+		}
+
+		if containers[resource.Type] && element.Type != schema.ElementTypeGroup {
+			t.Errorf("Expected %s to be a group, got %s", resource.Type, element.Type)
+		}
+		if !containers[resource.Type] && element.Type != schema.ElementTypeShape {
+			t.Errorf("Expected %s to be a shape, got %s", resource.Type, element.Type)
+		}
+	}
+}
+
+func TestAzureProvider_Validation(t *testing.T) {
+	provider := NewAzureProvider()
+
+	err := provider.Validate("subnet", map[string]interface{}{"cidr": "10.0.1.0/33"})
+	if err == nil {
+		t.Fatal("Expected invalid CIDR to cause a validation error")
+	}
+
+	var validationErr *providers.ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("Expected ValidationError, got %T", err)
+	}
+	if validationErr.Field != "cidr" {
+		t.Errorf("Expected error for field 'cidr', got '%s'", validationErr.Field)
+	}
+
+	if _, err := provider.GenerateTemplate("vnet", map[string]interface{}{"cidr": "invalid"}); err == nil {
+		t.Error("Expected GenerateTemplate to validate parameters")
+	}
+}
+
+func TestAzureProvider_UnsupportedResource(t *testing.T) {
+	provider := NewAzureProvider()
+
+	if err := provider.Validate("unsupported", nil); err == nil {
+		t.Error("Expected error for unsupported resource type")
+	}
+
+	if _, err := provider.GenerateTemplate("unsupported", nil); err == nil {
+		t.Error("Expected error for unsupported resource type")
+	}
+
+	if _, err := provider.GetSchema("unsupported"); err == nil {
+		t.Error("Expected error for unsupported resource schema")
+	}
+
+	if schema, err := provider.GetSchema("resource-group"); err != nil || schema == nil {
+		t.Errorf("Expected resource group schema, got error %v", err)
+	}
+}
@@ -0,0 +1,55 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// kubernetesVersionPattern matches Kubernetes versions
+const kubernetesVersionPattern = `^[0-9]+\.[0-9]+(\.[0-9]+)?$`
+
+// AKSResource defines the Azure AKS Cluster resource
+type AKSResource struct{}
+
+// NewAKSResource creates a new AKS Cluster resource instance
+func NewAKSResource() *AKSResource {
+	return &AKSResource{}
+}
+
+// Definition returns the resource definition for AKS Cluster elements
+func (r *AKSResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "aks",
+		Name:        "AKS Cluster",
+		Description: "Azure Kubernetes Service cluster",
+		Category:    "containers",
+		Schema: providers.ResourceSchema("AKS", 64, 64, map[string]interface{}{
+			"version": map[string]interface{}{
+				"type":        "string",
+				"description": "Kubernetes version",
+				"pattern":     kubernetesVersionPattern,
+			},
+			"nodeCount": map[string]interface{}{
+				"type":        "integer",
+				"description": "Number of nodes",
+				"minimum":     0,
+				"maximum":     5000,
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "AKS Cluster",
+				Description: "Basic AKS Cluster",
+				Config: map[string]interface{}{
+					"label":     "aks-platform",
+					"version":   "1.29",
+					"nodeCount": 3,
+				},
+			},
+		},
+	}
+}
+
+// Validate validates AKS Cluster parameters
+func (r *AKSResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
@@ -0,0 +1,27 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestAKSResource_Validate(t *testing.T) {
+	resource := NewAKSResource()
+
+	for _, version := range []string{"1.29", "1.29.2"} {
+		if err := resource.Validate(map[string]interface{}{"version": version, "nodeCount": 5000}); err != nil {
+			t.Errorf("Expected version %s with 5000 nodes to be valid, got %v", version, err)
+		}
+	}
+
+	err := resource.Validate(map[string]interface{}{"version": "v1.29"})
+	if code := providertest.ValidationCode(err, "version"); code != "INVALID_FORMAT" {
+		t.Errorf("Expected INVALID_FORMAT for version v1.29, got %q", code)
+	}
+
+	err = resource.Validate(map[string]interface{}{"nodeCount": 5001})
+	if code := providertest.ValidationCode(err, "nodeCount"); code != "OUT_OF_RANGE" {
+		t.Errorf("Expected OUT_OF_RANGE for 5001 nodes, got %q", code)
+	}
+}
@@ -0,0 +1,53 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// appServiceSKUPattern matches App Service plan SKUs
+const appServiceSKUPattern = `^(F1|D1|B[1-3]|S[1-3]|P[0-3]v[2-3]|I[1-6]v2)$`
+
+// AppServiceResource defines the Azure App Service resource
+type AppServiceResource struct{}
+
+// NewAppServiceResource creates a new App Service resource instance
+func NewAppServiceResource() *AppServiceResource {
+	return &AppServiceResource{}
+}
+
+// Definition returns the resource definition for App Service elements
+func (r *AppServiceResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "app-service",
+		Name:        "App Service",
+		Description: "Web app hosted on an App Service plan",
+		Category:    "web",
+		Schema: providers.ResourceSchema("App Service", 64, 64, map[string]interface{}{
+			"sku": map[string]interface{}{
+				"type":        "string",
+				"description": "App Service plan SKU",
+				"pattern":     appServiceSKUPattern,
+			},
+			"runtime": map[string]interface{}{
+				"type":        "string",
+				"description": "Runtime stack",
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "App Service",
+				Description: "Basic App Service",
+				Config: map[string]interface{}{
+					"label":   "app-web",
+					"sku":     "P1v3",
+					"runtime": "dotnet",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates App Service parameters
+func (r *AppServiceResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
@@ -0,0 +1,24 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestAppServiceResource_SKU(t *testing.T) {
+	resource := NewAppServiceResource()
+
+	for _, sku := range []string{"F1", "B2", "S3", "P1v3", "I2v2"} {
+		if err := resource.Validate(map[string]interface{}{"sku": sku}); err != nil {
+			t.Errorf("Expected SKU %s to be valid, got %v", sku, err)
+		}
+	}
+
+	for _, sku := range []string{"B4", "P1v4", "p1v3", "Premium"} {
+		err := resource.Validate(map[string]interface{}{"sku": sku})
+		if code := providertest.ValidationCode(err, "sku"); code != "INVALID_FORMAT" {
+			t.Errorf("Expected INVALID_FORMAT for SKU %s, got %q", sku, code)
+		}
+	}
+}
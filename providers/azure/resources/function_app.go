@@ -0,0 +1,58 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// functionRuntimes are the supported Azure Functions runtimes
+var functionRuntimes = []string{"dotnet", "dotnet-isolated", "node", "python", "java", "powershell", "custom"}
+
+// functionPlans are the supported Azure Functions hosting plans
+var functionPlans = []string{"consumption", "flex", "premium", "dedicated"}
+
+// FunctionAppResource defines the Azure Function App resource
+type FunctionAppResource struct{}
+
+// NewFunctionAppResource creates a new Function App resource instance
+func NewFunctionAppResource() *FunctionAppResource {
+	return &FunctionAppResource{}
+}
+
+// Definition returns the resource definition for Function App elements
+func (r *FunctionAppResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "function-app",
+		Name:        "Function App",
+		Description: "Serverless function app",
+		Category:    "compute",
+		Schema: providers.ResourceSchema("Function App", 64, 64, map[string]interface{}{
+			"runtime": map[string]interface{}{
+				"type":        "string",
+				"description": "Functions runtime",
+				"enum":        functionRuntimes,
+			},
+			"plan": map[string]interface{}{
+				"type":        "string",
+				"description": "Hosting plan",
+				"default":     "consumption",
+				"enum":        functionPlans,
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Function App",
+				Description: "Basic Function App",
+				Config: map[string]interface{}{
+					"label":   "func-orders",
+					"runtime": "python",
+					"plan":    "premium",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates Function App parameters
+func (r *FunctionAppResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
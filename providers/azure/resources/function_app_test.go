@@ -0,0 +1,27 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestFunctionAppResource_Validate(t *testing.T) {
+	resource := NewFunctionAppResource()
+
+	for _, runtime := range functionRuntimes {
+		if err := resource.Validate(map[string]interface{}{"runtime": runtime, "plan": "consumption"}); err != nil {
+			t.Errorf("Expected runtime %s to be valid, got %v", runtime, err)
+		}
+	}
+
+	err := resource.Validate(map[string]interface{}{"runtime": "go"})
+	if code := providertest.ValidationCode(err, "runtime"); code != "INVALID_ENUM" {
+		t.Errorf("Expected INVALID_ENUM for runtime go, got %q", code)
+	}
+
+	err = resource.Validate(map[string]interface{}{"plan": "serverless"})
+	if code := providertest.ValidationCode(err, "plan"); code != "INVALID_ENUM" {
+		t.Errorf("Expected INVALID_ENUM for plan serverless, got %q", code)
+	}
+}
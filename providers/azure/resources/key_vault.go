@@ -0,0 +1,58 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// keyVaultNamePattern matches key vault names
+const keyVaultNamePattern = `^[A-Za-z][A-Za-z0-9-]{1,22}[A-Za-z0-9]$`
+
+// keyVaultSKUs are the supported key vault pricing tiers
+var keyVaultSKUs = []string{"standard", "premium"}
+
+// KeyVaultResource defines the Azure Key Vault resource
+type KeyVaultResource struct{}
+
+// NewKeyVaultResource creates a new Key Vault resource instance
+func NewKeyVaultResource() *KeyVaultResource {
+	return &KeyVaultResource{}
+}
+
+// Definition returns the resource definition for Key Vault elements
+func (r *KeyVaultResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "key-vault",
+		Name:        "Key Vault",
+		Description: "Key vault for secrets, keys and certificates",
+		Category:    "security",
+		Schema: providers.ResourceSchema("Key Vault", 64, 64, map[string]interface{}{
+			"vaultName": map[string]interface{}{
+				"type":        "string",
+				"description": "Key vault name",
+				"pattern":     keyVaultNamePattern,
+			},
+			"sku": map[string]interface{}{
+				"type":        "string",
+				"description": "Pricing tier",
+				"default":     "standard",
+				"enum":        keyVaultSKUs,
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Key Vault",
+				Description: "Basic Key Vault",
+				Config: map[string]interface{}{
+					"label":     "kv-platform",
+					"vaultName": "kv-platform-prod",
+					"sku":       "premium",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates Key Vault parameters
+func (r *KeyVaultResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
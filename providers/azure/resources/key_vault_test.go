@@ -0,0 +1,28 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestKeyVaultResource_Validate(t *testing.T) {
+	resource := NewKeyVaultResource()
+
+	if err := resource.Validate(map[string]interface{}{"vaultName": "kv-shop-prod", "sku": "premium"}); err != nil {
+		t.Errorf("Expected key vault to be valid, got %v", err)
+	}
+
+	// Vault names have 3 to 24 characters, start with a letter and do not end with a dash
+	for _, vaultName := range []string{"kv", "1kv-shop", "kv-shop-", "kv_shop", "kv-shop-production-westeu"} {
+		err := resource.Validate(map[string]interface{}{"vaultName": vaultName})
+		if code := providertest.ValidationCode(err, "vaultName"); code != "INVALID_FORMAT" {
+			t.Errorf("Expected INVALID_FORMAT for vault name %s, got %q", vaultName, code)
+		}
+	}
+
+	err := resource.Validate(map[string]interface{}{"sku": "basic"})
+	if code := providertest.ValidationCode(err, "sku"); code != "INVALID_ENUM" {
+		t.Errorf("Expected INVALID_ENUM for SKU basic, got %q", code)
+	}
+}
@@ -0,0 +1,48 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// managementGroupIDPattern matches management group IDs
+const managementGroupIDPattern = `^[A-Za-z0-9_().-]{1,90}$`
+
+// ManagementGroupResource defines the Azure Management Group resource
+type ManagementGroupResource struct{}
+
+// NewManagementGroupResource creates a new Management Group resource instance
+func NewManagementGroupResource() *ManagementGroupResource {
+	return &ManagementGroupResource{}
+}
+
+// Definition returns the resource definition for Management Group elements
+func (r *ManagementGroupResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "management-group",
+		Name:        "Management Group",
+		Description: "Management group in the governance hierarchy",
+		Category:    "management",
+		Schema: providers.ResourceSchema("Management Group", 500, 300, map[string]interface{}{
+			"groupId": map[string]interface{}{
+				"type":        "string",
+				"description": "Management group ID",
+				"pattern":     managementGroupIDPattern,
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Management Group",
+				Description: "Basic Management Group",
+				Config: map[string]interface{}{
+					"label":   "Landing Zones",
+					"groupId": "mg-landing-zones",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates Management Group parameters
+func (r *ManagementGroupResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
@@ -0,0 +1,24 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestManagementGroupResource_GroupID(t *testing.T) {
+	resource := NewManagementGroupResource()
+
+	for _, groupID := range []string{"platform", "landing-zones", "corp_(prod).1"} {
+		if err := resource.Validate(map[string]interface{}{"groupId": groupID}); err != nil {
+			t.Errorf("Expected group ID %s to be valid, got %v", groupID, err)
+		}
+	}
+
+	for _, groupID := range []string{"landing zones", "corp/prod", ""} {
+		err := resource.Validate(map[string]interface{}{"groupId": groupID})
+		if code := providertest.ValidationCode(err, "groupId"); code != "INVALID_FORMAT" {
+			t.Errorf("Expected INVALID_FORMAT for group ID %q, got %q", groupID, code)
+		}
+	}
+}
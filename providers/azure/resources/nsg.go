@@ -0,0 +1,46 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// NSGResource defines the Azure Network Security Group resource
+type NSGResource struct{}
+
+// NewNSGResource creates a new Network Security Group resource instance
+func NewNSGResource() *NSGResource {
+	return &NSGResource{}
+}
+
+// Definition returns the resource definition for Network Security Group elements
+func (r *NSGResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "nsg",
+		Name:        "Network Security Group",
+		Description: "Network security group filtering subnet or NIC traffic",
+		Category:    "security",
+		Schema: providers.ResourceSchema("NSG", 64, 64, map[string]interface{}{
+			"ruleCount": map[string]interface{}{
+				"type":        "integer",
+				"description": "Number of security rules",
+				"minimum":     0,
+				"maximum":     1000,
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Network Security Group",
+				Description: "Basic Network Security Group",
+				Config: map[string]interface{}{
+					"label":     "nsg-app",
+					"ruleCount": 12,
+				},
+			},
+		},
+	}
+}
+
+// Validate validates Network Security Group parameters
+func (r *NSGResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
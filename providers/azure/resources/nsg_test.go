@@ -0,0 +1,22 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestNSGResource_RuleCount(t *testing.T) {
+	resource := NewNSGResource()
+
+	if err := resource.Validate(map[string]interface{}{"ruleCount": 0}); err != nil {
+		t.Errorf("Expected NSG without rules to be valid, got %v", err)
+	}
+
+	for _, ruleCount := range []int{-1, 1001} {
+		err := resource.Validate(map[string]interface{}{"ruleCount": ruleCount})
+		if code := providertest.ValidationCode(err, "ruleCount"); code != "OUT_OF_RANGE" {
+			t.Errorf("Expected OUT_OF_RANGE for %d rules, got %q", ruleCount, code)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// locationPattern matches Azure region names
+const locationPattern = `^[a-z]+[a-z0-9]*$`
+
+// ResourceGroupResource defines the Azure Resource Group resource
+type ResourceGroupResource struct{}
+
+// NewResourceGroupResource creates a new Resource Group resource instance
+func NewResourceGroupResource() *ResourceGroupResource {
+	return &ResourceGroupResource{}
+}
+
+// Definition returns the resource definition for Resource Group elements
+func (r *ResourceGroupResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "resource-group",
+		Name:        "Resource Group",
+		Description: "Resource group containing related resources",
+		Category:    "management",
+		Schema: providers.ResourceSchema("Resource Group", 400, 250, map[string]interface{}{
+			"location": map[string]interface{}{
+				"type":        "string",
+				"description": "Azure region",
+				"pattern":     locationPattern,
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Resource Group",
+				Description: "Basic Resource Group",
+				Config: map[string]interface{}{
+					"label":    "rg-app-prod",
+					"location": "westeurope",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates Resource Group parameters
+func (r *ResourceGroupResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
@@ -0,0 +1,25 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestResourceGroupResource_Location(t *testing.T) {
+	resource := NewResourceGroupResource()
+
+	for _, location := range []string{"westeurope", "eastus2"} {
+		if err := resource.Validate(map[string]interface{}{"location": location}); err != nil {
+			t.Errorf("Expected location %s to be valid, got %v", location, err)
+		}
+	}
+
+	// Locations are given by their name, not their display name
+	for _, location := range []string{"West Europe", "west-europe", "2westus"} {
+		err := resource.Validate(map[string]interface{}{"location": location})
+		if code := providertest.ValidationCode(err, "location"); code != "INVALID_FORMAT" {
+			t.Errorf("Expected INVALID_FORMAT for location %s, got %q", location, code)
+		}
+	}
+}
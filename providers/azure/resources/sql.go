@@ -0,0 +1,54 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// sqlTiers are the supported Azure SQL service tiers
+var sqlTiers = []string{"Basic", "Standard", "Premium", "GeneralPurpose", "BusinessCritical", "Hyperscale"}
+
+// SQLResource defines the Azure SQL Database resource
+type SQLResource struct{}
+
+// NewSQLResource creates a new SQL Database resource instance
+func NewSQLResource() *SQLResource {
+	return &SQLResource{}
+}
+
+// Definition returns the resource definition for SQL Database elements
+func (r *SQLResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "sql",
+		Name:        "SQL Database",
+		Description: "Azure SQL database",
+		Category:    "databases",
+		Schema: providers.ResourceSchema("SQL Database", 64, 64, map[string]interface{}{
+			"tier": map[string]interface{}{
+				"type":        "string",
+				"description": "Service tier",
+				"enum":        sqlTiers,
+			},
+			"zoneRedundant": map[string]interface{}{
+				"type":        "boolean",
+				"description": "Zone redundant deployment",
+				"default":     false,
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "SQL Database",
+				Description: "Basic SQL Database",
+				Config: map[string]interface{}{
+					"label":         "sqldb-orders",
+					"tier":          "GeneralPurpose",
+					"zoneRedundant": true,
+				},
+			},
+		},
+	}
+}
+
+// Validate validates SQL Database parameters
+func (r *SQLResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
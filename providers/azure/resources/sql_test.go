@@ -0,0 +1,27 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestSQLResource_Validate(t *testing.T) {
+	resource := NewSQLResource()
+
+	for _, tier := range sqlTiers {
+		if err := resource.Validate(map[string]interface{}{"tier": tier, "zoneRedundant": true}); err != nil {
+			t.Errorf("Expected tier %s to be valid, got %v", tier, err)
+		}
+	}
+
+	err := resource.Validate(map[string]interface{}{"tier": "Serverless"})
+	if code := providertest.ValidationCode(err, "tier"); code != "INVALID_ENUM" {
+		t.Errorf("Expected INVALID_ENUM for tier Serverless, got %q", code)
+	}
+
+	err = resource.Validate(map[string]interface{}{"zoneRedundant": "true"})
+	if code := providertest.ValidationCode(err, "zoneRedundant"); code != "INVALID_TYPE" {
+		t.Errorf("Expected INVALID_TYPE for zoneRedundant given as string, got %q", code)
+	}
+}
@@ -0,0 +1,58 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// storageAccountNamePattern matches storage account names
+const storageAccountNamePattern = `^[a-z0-9]{3,24}$`
+
+// storageRedundancies are the supported storage replication options
+var storageRedundancies = []string{"LRS", "ZRS", "GRS", "GZRS", "RA-GRS", "RA-GZRS"}
+
+// StorageResource defines the Azure Storage Account resource
+type StorageResource struct{}
+
+// NewStorageResource creates a new Storage Account resource instance
+func NewStorageResource() *StorageResource {
+	return &StorageResource{}
+}
+
+// Definition returns the resource definition for Storage Account elements
+func (r *StorageResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "storage",
+		Name:        "Storage Account",
+		Description: "Storage account for blobs, files, queues and tables",
+		Category:    "storage",
+		Schema: providers.ResourceSchema("Storage Account", 64, 64, map[string]interface{}{
+			"accountName": map[string]interface{}{
+				"type":        "string",
+				"description": "Storage account name",
+				"pattern":     storageAccountNamePattern,
+			},
+			"redundancy": map[string]interface{}{
+				"type":        "string",
+				"description": "Replication option",
+				"default":     "LRS",
+				"enum":        storageRedundancies,
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Storage Account",
+				Description: "Basic Storage Account",
+				Config: map[string]interface{}{
+					"label":       "Assets",
+					"accountName": "stassetsprod",
+					"redundancy":  "ZRS",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates Storage Account parameters
+func (r *StorageResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
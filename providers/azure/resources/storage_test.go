@@ -0,0 +1,28 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestStorageResource_Validate(t *testing.T) {
+	resource := NewStorageResource()
+
+	if err := resource.Validate(map[string]interface{}{"accountName": "stshopprod001", "redundancy": "RA-GZRS"}); err != nil {
+		t.Errorf("Expected storage account to be valid, got %v", err)
+	}
+
+	// Storage account names are 3 to 24 lowercase letters and digits
+	for _, accountName := range []string{"st", "st-shop", "StShop", "stshopproductionwesteu001"} {
+		err := resource.Validate(map[string]interface{}{"accountName": accountName})
+		if code := providertest.ValidationCode(err, "accountName"); code != "INVALID_FORMAT" {
+			t.Errorf("Expected INVALID_FORMAT for account name %s, got %q", accountName, code)
+		}
+	}
+
+	err := resource.Validate(map[string]interface{}{"redundancy": "RA-LRS"})
+	if code := providertest.ValidationCode(err, "redundancy"); code != "INVALID_ENUM" {
+		t.Errorf("Expected INVALID_ENUM for redundancy RA-LRS, got %q", code)
+	}
+}
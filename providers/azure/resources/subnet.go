@@ -0,0 +1,47 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// SubnetResource defines the Azure Subnet resource
+type SubnetResource struct{}
+
+// NewSubnetResource creates a new Subnet resource instance
+func NewSubnetResource() *SubnetResource {
+	return &SubnetResource{}
+}
+
+// Definition returns the resource definition for Subnet elements
+func (r *SubnetResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "subnet",
+		Name:        "Subnet",
+		Description: "Subnet within a virtual network",
+		Category:    "networking",
+		Schema: providers.ResourceSchema("Subnet", 200, 150, map[string]interface{}{
+			"cidr": map[string]interface{}{
+				"type":        "string",
+				"description": "Address prefix, an IPv4 or IPv6 CIDR block",
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Subnet",
+				Description: "Basic Subnet",
+				Config: map[string]interface{}{
+					"label": "snet-app",
+					"cidr":  "10.0.1.0/24",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates Subnet parameters
+func (r *SubnetResource) Validate(params map[string]interface{}) error {
+	if err := providers.ValidateParams(r.Definition().Schema, params); err != nil {
+		return err
+	}
+	return providers.ValidateCIDR(params, "cidr")
+}
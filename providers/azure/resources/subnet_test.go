@@ -0,0 +1,22 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestSubnetResource_CIDR(t *testing.T) {
+	resource := NewSubnetResource()
+
+	for _, cidr := range []string{"10.1.1.0/24", "fd00:db8::/64"} {
+		if err := resource.Validate(map[string]interface{}{"cidr": cidr}); err != nil {
+			t.Errorf("Expected CIDR block %s to be valid, got %v", cidr, err)
+		}
+	}
+
+	err := resource.Validate(map[string]interface{}{"cidr": "10.1.1.0/33"})
+	if code := providertest.ValidationCode(err, "cidr"); code != "INVALID_FORMAT" {
+		t.Errorf("Expected INVALID_FORMAT for CIDR 10.1.1.0/33, got %q", code)
+	}
+}
@@ -0,0 +1,45 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// SubscriptionResource defines the Azure Subscription resource
+type SubscriptionResource struct{}
+
+// NewSubscriptionResource creates a new Subscription resource instance
+func NewSubscriptionResource() *SubscriptionResource {
+	return &SubscriptionResource{}
+}
+
+// Definition returns the resource definition for Subscription elements
+func (r *SubscriptionResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "subscription",
+		Name:        "Subscription",
+		Description: "Subscription for billing and access boundaries",
+		Category:    "management",
+		Schema: providers.ResourceSchema("Subscription", 400, 300, map[string]interface{}{
+			"subscriptionId": map[string]interface{}{
+				"type":        "string",
+				"description": "Subscription ID (GUID)",
+				"pattern":     guidPattern,
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Subscription",
+				Description: "Basic Subscription",
+				Config: map[string]interface{}{
+					"label":          "Production",
+					"subscriptionId": "11111111-2222-3333-4444-555555555555",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates Subscription parameters
+func (r *SubscriptionResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
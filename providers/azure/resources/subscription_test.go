@@ -0,0 +1,20 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestSubscriptionResource_SubscriptionID(t *testing.T) {
+	resource := NewSubscriptionResource()
+
+	if err := resource.Validate(map[string]interface{}{"subscriptionId": "00000000-0000-0000-0000-000000000000"}); err != nil {
+		t.Errorf("Expected GUID subscription ID to be valid, got %v", err)
+	}
+
+	err := resource.Validate(map[string]interface{}{"subscriptionId": "prod-subscription"})
+	if code := providertest.ValidationCode(err, "subscriptionId"); code != "INVALID_FORMAT" {
+		t.Errorf("Expected INVALID_FORMAT for a subscription name, got %q", code)
+	}
+}
@@ -0,0 +1,57 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// guidPattern matches Azure tenant and subscription IDs
+const guidPattern = `^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`
+
+// tenantDomainPattern matches tenant domain names
+const tenantDomainPattern = `^[a-z0-9][a-z0-9-]*(\.[a-z0-9][a-z0-9-]*)+$`
+
+// TenantResource defines the Azure Tenant resource
+type TenantResource struct{}
+
+// NewTenantResource creates a new Tenant resource instance
+func NewTenantResource() *TenantResource {
+	return &TenantResource{}
+}
+
+// Definition returns the resource definition for Tenant elements
+func (r *TenantResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "tenant",
+		Name:        "Tenant",
+		Description: "Microsoft Entra ID tenant container",
+		Category:    "identity",
+		Schema: providers.ResourceSchema("Tenant", 600, 400, map[string]interface{}{
+			"tenantId": map[string]interface{}{
+				"type":        "string",
+				"description": "Tenant ID (GUID)",
+				"pattern":     guidPattern,
+			},
+			"domain": map[string]interface{}{
+				"type":        "string",
+				"description": "Primary domain",
+				"pattern":     tenantDomainPattern,
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Tenant",
+				Description: "Basic Tenant",
+				Config: map[string]interface{}{
+					"label":    "Contoso",
+					"tenantId": "72f988bf-86f1-41af-91ab-2d7cd011db47",
+					"domain":   "contoso.onmicrosoft.com",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates Tenant parameters
+func (r *TenantResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
@@ -0,0 +1,28 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestTenantResource_Validate(t *testing.T) {
+	resource := NewTenantResource()
+
+	if err := resource.Validate(map[string]interface{}{"tenantId": "72F988BF-86F1-41AF-91AB-2D7CD011DB47", "domain": "contoso.onmicrosoft.com"}); err != nil {
+		t.Errorf("Expected tenant with uppercase GUID to be valid, got %v", err)
+	}
+
+	err := resource.Validate(map[string]interface{}{"tenantId": "72f988bf86f141af91ab2d7cd011db47"})
+	if code := providertest.ValidationCode(err, "tenantId"); code != "INVALID_FORMAT" {
+		t.Errorf("Expected INVALID_FORMAT for a GUID without dashes, got %q", code)
+	}
+
+	// Tenant domains are lowercase DNS names with at least two labels
+	for _, domain := range []string{"contoso", "Contoso.com", "-contoso.com"} {
+		err := resource.Validate(map[string]interface{}{"domain": domain})
+		if code := providertest.ValidationCode(err, "domain"); code != "INVALID_FORMAT" {
+			t.Errorf("Expected INVALID_FORMAT for domain %s, got %q", domain, code)
+		}
+	}
+}
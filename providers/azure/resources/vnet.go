@@ -0,0 +1,52 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// VNetResource defines the Azure Virtual Network resource
+type VNetResource struct{}
+
+// NewVNetResource creates a new Virtual Network resource instance
+func NewVNetResource() *VNetResource {
+	return &VNetResource{}
+}
+
+// Definition returns the resource definition for Virtual Network elements
+func (r *VNetResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "vnet",
+		Name:        "Virtual Network",
+		Description: "Virtual network container",
+		Category:    "networking",
+		Schema: providers.ResourceSchema("VNet", 400, 250, map[string]interface{}{
+			"cidr": map[string]interface{}{
+				"type":        "string",
+				"description": "Address space, an IPv4 or IPv6 CIDR block",
+			},
+			"location": map[string]interface{}{
+				"type":        "string",
+				"description": "Azure region",
+				"pattern":     locationPattern,
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Virtual Network",
+				Description: "Basic Virtual Network",
+				Config: map[string]interface{}{
+					"label": "vnet-hub",
+					"cidr":  "10.0.0.0/16",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates Virtual Network parameters
+func (r *VNetResource) Validate(params map[string]interface{}) error {
+	if err := providers.ValidateParams(r.Definition().Schema, params); err != nil {
+		return err
+	}
+	return providers.ValidateCIDR(params, "cidr")
+}
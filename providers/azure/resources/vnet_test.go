@@ -0,0 +1,35 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestVNetResource_Validate(t *testing.T) {
+	resource := NewVNetResource()
+
+	if err := resource.Validate(map[string]interface{}{"cidr": "10.1.0.0/16", "location": "westeurope"}); err != nil {
+		t.Errorf("Expected VNet to be valid, got %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		params map[string]interface{}
+		field  string
+		code   string
+	}{
+		{name: "address without prefix", params: map[string]interface{}{"cidr": "10.1.0.0"}, field: "cidr", code: "INVALID_FORMAT"},
+		{name: "host name", params: map[string]interface{}{"cidr": "vnet.example.com/16"}, field: "cidr", code: "INVALID_FORMAT"},
+		{name: "display name location", params: map[string]interface{}{"location": "West Europe"}, field: "location", code: "INVALID_FORMAT"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := resource.Validate(tt.params)
+			if code := providertest.ValidationCode(err, tt.field); code != tt.code {
+				t.Errorf("Expected code %s for %s, got %q", tt.code, tt.field, code)
+			}
+		})
+	}
+}
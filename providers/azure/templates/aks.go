@@ -0,0 +1,32 @@
+package templates
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// AKSTemplate handles template generation for AKS Cluster elements
+type AKSTemplate struct{}
+
+// NewAKSTemplate creates a new AKS Cluster template generator
+func NewAKSTemplate() *AKSTemplate {
+	return &AKSTemplate{}
+}
+
+// Generate creates a schema.Element from AKS Cluster parameters
+func (t *AKSTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	var details []string
+	if version := getStringParam(params, "version", ""); version != "" {
+		details = append(details, version)
+	}
+	if _, exists := params["nodeCount"]; exists {
+		details = append(details, fmt.Sprintf("%d nodes", getIntParam(params, "nodeCount", 0)))
+	}
+
+	return newIcon(params, iconSpec{
+		label: "AKS",
+		icon:  "containers/Kubernetes_Services.svg",
+	}, strings.Join(details, ", ")), nil
+}
@@ -0,0 +1,21 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// AppServiceTemplate handles template generation for App Service elements
+type AppServiceTemplate struct{}
+
+// NewAppServiceTemplate creates a new App Service template generator
+func NewAppServiceTemplate() *AppServiceTemplate {
+	return &AppServiceTemplate{}
+}
+
+// Generate creates a schema.Element from App Service parameters
+func (t *AppServiceTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	return newIcon(params, iconSpec{
+		label: "App Service",
+		icon:  "app_services/App_Services.svg",
+	}, getStringParam(params, "sku", "")), nil
+}
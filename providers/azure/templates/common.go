@@ -0,0 +1,187 @@
+package templates
+
+import (
+	"fmt"
+
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// Azure architecture colors
+const (
+	colorAzure      = "#0078D4"
+	colorLabel      = "#004578"
+	colorTenant     = "#F3F9FD"
+	colorNetwork    = "#E8F4EA"
+	colorNetworkRim = "#5EA31A"
+	colorIconLabel  = "#323130"
+)
+
+// iconPath is the draw.io location of the Azure architecture icons
+const iconPath = "img/lib/azure2/"
+
+// containerSpec describes the appearance and child layout of an Azure scope or network container
+type containerSpec struct {
+	label       string
+	icon        string // Icon below img/lib/azure2, e.g. "general/Subscriptions.svg"
+	stroke      string
+	fill        string
+	dashed      bool
+	width       float64
+	height      float64
+	arrangement schema.Arrangement
+	spacing     float64 // Room between children, including labels below icons
+}
+
+// iconSpec describes an Azure service icon
+type iconSpec struct {
+	label string
+	icon  string // Icon below img/lib/azure2, e.g. "databases/SQL_Database.svg"
+}
+
+// newContainer creates a container with the service icon next to its label that grows
+// to fit its children
+func newContainer(params map[string]interface{}, spec containerSpec, detail string) *schema.Element {
+	fill := spec.fill
+	if fill == "" {
+		fill = "none"
+	}
+	stroke := spec.stroke
+	if stroke == "" {
+		stroke = colorAzure
+	}
+
+	style := schema.Style{
+		FillColor:     getStringParam(params, "fillColor", fill),
+		StrokeColor:   getStringParam(params, "strokeColor", stroke),
+		FontColor:     getStringParam(params, "fontColor", colorLabel),
+		FontSize:      12,
+		FontStyle:     "1",
+		TextAlign:     "left",
+		VerticalAlign: "top",
+		Custom: map[string]string{
+			"html":               "1",
+			"whiteSpace":         "wrap",
+			"container":          "1",
+			"collapsible":        "0",
+			"image":              iconPath + spec.icon,
+			"imageWidth":         "20",
+			"imageHeight":        "20",
+			"imageAlign":         "left",
+			"imageVerticalAlign": "top",
+			"spacingLeft":        "30",
+			"spacingTop":         "3",
+		},
+	}
+	if spec.dashed {
+		style.StrokeDashArray = "8 4"
+	}
+
+	return &schema.Element{
+		Type: schema.ElementTypeGroup,
+		Properties: schema.ElementProperties{
+			X:      getFloatParam(params, "x", 0),
+			Y:      getFloatParam(params, "y", 0),
+			Width:  getFloatParam(params, "width", spec.width),
+			Height: getFloatParam(params, "height", spec.height),
+			Label:  formatLabel(getStringParam(params, "label", spec.label), detail),
+			Shape:  "label",
+		},
+		Style: style,
+		// Children are laid out below the label and the container grows to fit them,
+		// so nested scopes such as subscriptions inside management groups stay readable
+		Nesting: schema.NestingConfig{
+			Mode:        schema.NestingModeChild,
+			AutoResize:  true,
+			Arrangement: spec.arrangement,
+			Spacing:     spec.spacing,
+			Padding: schema.Padding{
+				Top:    40,
+				Right:  20,
+				Bottom: 20,
+				Left:   20,
+			},
+		},
+	}
+}
+
+// newIcon creates an Azure service icon with its label below the icon
+func newIcon(params map[string]interface{}, spec iconSpec, detail string) *schema.Element {
+	return &schema.Element{
+		Type: schema.ElementTypeShape,
+		Properties: schema.ElementProperties{
+			X:      getFloatParam(params, "x", 0),
+			Y:      getFloatParam(params, "y", 0),
+			Width:  getFloatParam(params, "width", 64),
+			Height: getFloatParam(params, "height", 64),
+			Label:  formatLabel(getStringParam(params, "label", spec.label), detail),
+			Shape:  "image",
+		},
+		Style: schema.Style{
+			FontColor:             getStringParam(params, "fontColor", colorIconLabel),
+			FontSize:              12,
+			VerticalLabelPosition: "bottom",
+			VerticalAlign:         "top",
+			TextAlign:             "center",
+			Custom: map[string]string{
+				"image":      iconPath + spec.icon,
+				"html":       "1",
+				"aspect":     "fixed",
+				"whiteSpace": "wrap",
+			},
+		},
+	}
+}
+
+// formatLabel appends details such as a CIDR block or SKU to a label
+func formatLabel(label, detail string) string {
+	if detail == "" {
+		return label
+	}
+	return fmt.Sprintf("%s (%s)", label, detail)
+}
+
+func getStringParam(params map[string]interface{}, key, defaultValue string) string {
+	if val, ok := params[key]; ok {
+		if str, ok := val.(string); ok {
+			return str
+		}
+	}
+	return defaultValue
+}
+
+func getFloatParam(params map[string]interface{}, key string, defaultValue float64) float64 {
+	if val, ok := params[key]; ok {
+		switch v := val.(type) {
+		case float64:
+			return v
+		case int:
+			return float64(v)
+		case int64:
+			return float64(v)
+		}
+	}
+	return defaultValue
+}
+
+func getIntParam(params map[string]interface{}, key string, defaultValue int) int {
+	if val, ok := params[key]; ok {
+		switch v := val.(type) {
+		case int:
+			return v
+		case int64:
+			return int(v)
+		case float64:
+			return int(v)
+		}
+	}
+	return defaultValue
+}
+
+func getBoolParam(params map[string]interface{}, key string, defaultValue bool) bool {
+	if val, ok := params[key]; ok {
+		if b, ok := val.(bool); ok {
+			return b
+		}
+	}
+	return defaultValue
+}
@@ -0,0 +1,64 @@
+package templates
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+func TestNewIcon(t *testing.T) {
+	element := newIcon(map[string]interface{}{"x": 10, "y": 20.5, "label": "Orders"}, iconSpec{
+		label: "SQL Database",
+		icon:  "databases/SQL_Database.svg",
+	}, "Hyperscale")
+
+	if element.Type != schema.ElementTypeShape {
+		t.Errorf("Expected shape element, got %s", element.Type)
+	}
+	if element.Properties.X != 10 || element.Properties.Y != 20.5 {
+		t.Errorf("Expected position (10, 20.5), got (%v, %v)", element.Properties.X, element.Properties.Y)
+	}
+	if element.Properties.Width != 64 || element.Properties.Height != 64 {
+		t.Errorf("Expected default icon size 64, got %vx%v", element.Properties.Width, element.Properties.Height)
+	}
+	if element.Properties.Label != "Orders (Hyperscale)" {
+		t.Errorf("Expected label 'Orders (Hyperscale)', got '%s'", element.Properties.Label)
+	}
+	if element.Properties.Shape != "image" || element.Style.Custom["image"] != "img/lib/azure2/databases/SQL_Database.svg" {
+		t.Error("Expected img/lib/azure2 image for SQL Database")
+	}
+}
+
+func TestNewContainer(t *testing.T) {
+	element := newContainer(map[string]interface{}{"width": 640}, containerSpec{
+		label:       "Resource Group",
+		icon:        "general/Resource_Groups.svg",
+		dashed:      true,
+		width:       400,
+		height:      250,
+		spacing:     60,
+		arrangement: schema.ArrangementHorizontal,
+	}, "westeurope")
+
+	if element.Type != schema.ElementTypeGroup {
+		t.Errorf("Expected group element, got %s", element.Type)
+	}
+	if element.Properties.Width != 640 || element.Properties.Height != 250 {
+		t.Errorf("Expected size 640x250, got %vx%v", element.Properties.Width, element.Properties.Height)
+	}
+	if element.Properties.Label != "Resource Group (westeurope)" {
+		t.Errorf("Expected label 'Resource Group (westeurope)', got '%s'", element.Properties.Label)
+	}
+	if element.Style.Custom["image"] != "img/lib/azure2/general/Resource_Groups.svg" {
+		t.Errorf("Expected resource group icon next to the label, got %s", element.Style.Custom["image"])
+	}
+	if element.Style.StrokeColor != colorAzure || element.Style.FillColor != "none" {
+		t.Errorf("Expected transparent Azure blue container, got %s/%s", element.Style.FillColor, element.Style.StrokeColor)
+	}
+	if element.Style.StrokeDashArray == "" {
+		t.Error("Expected dashed container border")
+	}
+	if !element.Nesting.AutoResize || element.Nesting.Arrangement != schema.ArrangementHorizontal || element.Nesting.Spacing != 60 {
+		t.Errorf("Expected auto-resizing horizontal layout, got %+v", element.Nesting)
+	}
+}
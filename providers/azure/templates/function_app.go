@@ -0,0 +1,31 @@
+package templates
+
+import (
+	"strings"
+
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// FunctionAppTemplate handles template generation for Function App elements
+type FunctionAppTemplate struct{}
+
+// NewFunctionAppTemplate creates a new Function App template generator
+func NewFunctionAppTemplate() *FunctionAppTemplate {
+	return &FunctionAppTemplate{}
+}
+
+// Generate creates a schema.Element from Function App parameters
+func (t *FunctionAppTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	var details []string
+	if runtime := getStringParam(params, "runtime", ""); runtime != "" {
+		details = append(details, runtime)
+	}
+	if plan := getStringParam(params, "plan", ""); plan != "" {
+		details = append(details, plan)
+	}
+
+	return newIcon(params, iconSpec{
+		label: "Function App",
+		icon:  "compute/Function_Apps.svg",
+	}, strings.Join(details, ", ")), nil
+}
@@ -0,0 +1,21 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// KeyVaultTemplate handles template generation for Key Vault elements
+type KeyVaultTemplate struct{}
+
+// NewKeyVaultTemplate creates a new Key Vault template generator
+func NewKeyVaultTemplate() *KeyVaultTemplate {
+	return &KeyVaultTemplate{}
+}
+
+// Generate creates a schema.Element from Key Vault parameters
+func (t *KeyVaultTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	return newIcon(params, iconSpec{
+		label: "Key Vault",
+		icon:  "security/Key_Vaults.svg",
+	}, getStringParam(params, "sku", "")), nil
+}
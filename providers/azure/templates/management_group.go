@@ -0,0 +1,25 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// ManagementGroupTemplate handles template generation for Management Group elements
+type ManagementGroupTemplate struct{}
+
+// NewManagementGroupTemplate creates a new Management Group template generator
+func NewManagementGroupTemplate() *ManagementGroupTemplate {
+	return &ManagementGroupTemplate{}
+}
+
+// Generate creates a schema.Element from Management Group parameters
+func (t *ManagementGroupTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	return newContainer(params, containerSpec{
+		label:       "Management Group",
+		icon:        "general/Management_Groups.svg",
+		width:       500,
+		height:      300,
+		spacing:     30,
+		arrangement: schema.ArrangementHorizontal,
+	}, getStringParam(params, "groupId", "")), nil
+}
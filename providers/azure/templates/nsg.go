@@ -0,0 +1,28 @@
+package templates
+
+import (
+	"fmt"
+
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// NSGTemplate handles template generation for Network Security Group elements
+type NSGTemplate struct{}
+
+// NewNSGTemplate creates a new Network Security Group template generator
+func NewNSGTemplate() *NSGTemplate {
+	return &NSGTemplate{}
+}
+
+// Generate creates a schema.Element from Network Security Group parameters
+func (t *NSGTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	detail := ""
+	if _, exists := params["ruleCount"]; exists {
+		detail = fmt.Sprintf("%d rules", getIntParam(params, "ruleCount", 0))
+	}
+
+	return newIcon(params, iconSpec{
+		label: "NSG",
+		icon:  "networking/Network_Security_Groups.svg",
+	}, detail), nil
+}
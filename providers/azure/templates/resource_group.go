@@ -0,0 +1,26 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// ResourceGroupTemplate handles template generation for Resource Group elements
+type ResourceGroupTemplate struct{}
+
+// NewResourceGroupTemplate creates a new Resource Group template generator
+func NewResourceGroupTemplate() *ResourceGroupTemplate {
+	return &ResourceGroupTemplate{}
+}
+
+// Generate creates a schema.Element from Resource Group parameters
+func (t *ResourceGroupTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	return newContainer(params, containerSpec{
+		label:       "Resource Group",
+		icon:        "general/Resource_Groups.svg",
+		dashed:      true,
+		width:       400,
+		height:      250,
+		spacing:     60,
+		arrangement: schema.ArrangementHorizontal,
+	}, getStringParam(params, "location", "")), nil
+}
@@ -0,0 +1,31 @@
+package templates
+
+import (
+	"strings"
+
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// SQLTemplate handles template generation for SQL Database elements
+type SQLTemplate struct{}
+
+// NewSQLTemplate creates a new SQL Database template generator
+func NewSQLTemplate() *SQLTemplate {
+	return &SQLTemplate{}
+}
+
+// Generate creates a schema.Element from SQL Database parameters
+func (t *SQLTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	var details []string
+	if tier := getStringParam(params, "tier", ""); tier != "" {
+		details = append(details, tier)
+	}
+	if getBoolParam(params, "zoneRedundant", false) {
+		details = append(details, "zone redundant")
+	}
+
+	return newIcon(params, iconSpec{
+		label: "SQL Database",
+		icon:  "databases/SQL_Database.svg",
+	}, strings.Join(details, ", ")), nil
+}
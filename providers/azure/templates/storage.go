@@ -0,0 +1,21 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// StorageTemplate handles template generation for Storage Account elements
+type StorageTemplate struct{}
+
+// NewStorageTemplate creates a new Storage Account template generator
+func NewStorageTemplate() *StorageTemplate {
+	return &StorageTemplate{}
+}
+
+// Generate creates a schema.Element from Storage Account parameters
+func (t *StorageTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	return newIcon(params, iconSpec{
+		label: "Storage Account",
+		icon:  "storage/Storage_Accounts.svg",
+	}, getStringParam(params, "redundancy", "")), nil
+}
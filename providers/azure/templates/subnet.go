@@ -0,0 +1,28 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// SubnetTemplate handles template generation for Subnet elements
+type SubnetTemplate struct{}
+
+// NewSubnetTemplate creates a new Subnet template generator
+func NewSubnetTemplate() *SubnetTemplate {
+	return &SubnetTemplate{}
+}
+
+// Generate creates a schema.Element from Subnet parameters
+func (t *SubnetTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	return newContainer(params, containerSpec{
+		label:       "Subnet",
+		icon:        "networking/Subnet.svg",
+		stroke:      colorNetworkRim,
+		fill:        "#FFFFFF",
+		dashed:      true,
+		width:       200,
+		height:      150,
+		spacing:     60,
+		arrangement: schema.ArrangementHorizontal,
+	}, getStringParam(params, "cidr", "")), nil
+}
@@ -0,0 +1,25 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// SubscriptionTemplate handles template generation for Subscription elements
+type SubscriptionTemplate struct{}
+
+// NewSubscriptionTemplate creates a new Subscription template generator
+func NewSubscriptionTemplate() *SubscriptionTemplate {
+	return &SubscriptionTemplate{}
+}
+
+// Generate creates a schema.Element from Subscription parameters
+func (t *SubscriptionTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	return newContainer(params, containerSpec{
+		label:       "Subscription",
+		icon:        "general/Subscriptions.svg",
+		width:       400,
+		height:      300,
+		spacing:     30,
+		arrangement: schema.ArrangementVertical,
+	}, ""), nil
+}
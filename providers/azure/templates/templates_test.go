@@ -0,0 +1,53 @@
+package templates
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+func TestTemplates_Generate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template interface {
+			Generate(map[string]interface{}) (*schema.Element, error)
+		}
+		params    map[string]interface{}
+		label     string
+		icon      string
+		container bool
+	}{
+		{name: "tenant", template: NewTenantTemplate(), params: map[string]interface{}{"domain": "contoso.onmicrosoft.com"}, label: "Tenant (contoso.onmicrosoft.com)", icon: "identity/Azure_Active_Directory.svg", container: true},
+		{name: "management group", template: NewManagementGroupTemplate(), params: map[string]interface{}{"groupId": "platform"}, label: "Management Group (platform)", icon: "general/Management_Groups.svg", container: true},
+		{name: "subscription without ID in label", template: NewSubscriptionTemplate(), params: map[string]interface{}{"subscriptionId": "00000000-0000-0000-0000-000000000000"}, label: "Subscription", icon: "general/Subscriptions.svg", container: true},
+		{name: "resource group", template: NewResourceGroupTemplate(), params: map[string]interface{}{"label": "rg-shop", "location": "westeurope"}, label: "rg-shop (westeurope)", icon: "general/Resource_Groups.svg", container: true},
+		{name: "vnet", template: NewVNetTemplate(), params: map[string]interface{}{"cidr": "10.1.0.0/16"}, label: "VNet (10.1.0.0/16)", icon: "networking/Virtual_Networks.svg", container: true},
+		{name: "subnet", template: NewSubnetTemplate(), params: map[string]interface{}{"cidr": "10.1.1.0/24"}, label: "Subnet (10.1.1.0/24)", icon: "networking/Subnet.svg", container: true},
+		{name: "nsg with rule count", template: NewNSGTemplate(), params: map[string]interface{}{"ruleCount": 0}, label: "NSG (0 rules)", icon: "networking/Network_Security_Groups.svg"},
+		{name: "aks", template: NewAKSTemplate(), params: map[string]interface{}{"version": "1.29", "nodeCount": 3}, label: "AKS (1.29, 3 nodes)", icon: "containers/Kubernetes_Services.svg"},
+		{name: "app service", template: NewAppServiceTemplate(), params: map[string]interface{}{"sku": "P1v3"}, label: "App Service (P1v3)", icon: "app_services/App_Services.svg"},
+		{name: "function app", template: NewFunctionAppTemplate(), params: map[string]interface{}{"runtime": "python", "plan": "flex"}, label: "Function App (python, flex)", icon: "compute/Function_Apps.svg"},
+		{name: "key vault", template: NewKeyVaultTemplate(), params: map[string]interface{}{"sku": "premium"}, label: "Key Vault (premium)", icon: "security/Key_Vaults.svg"},
+		{name: "sql zone redundant", template: NewSQLTemplate(), params: map[string]interface{}{"tier": "BusinessCritical", "zoneRedundant": true}, label: "SQL Database (BusinessCritical, zone redundant)", icon: "databases/SQL_Database.svg"},
+		{name: "storage", template: NewStorageTemplate(), params: map[string]interface{}{"redundancy": "ZRS"}, label: "Storage Account (ZRS)", icon: "storage/Storage_Accounts.svg"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			element, err := tt.template.Generate(tt.params)
+			if err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+
+			if element.Properties.Label != tt.label {
+				t.Errorf("Expected label '%s', got '%s'", tt.label, element.Properties.Label)
+			}
+			if element.Style.Custom["image"] != iconPath+tt.icon {
+				t.Errorf("Expected icon %s, got %s", iconPath+tt.icon, element.Style.Custom["image"])
+			}
+			if container := element.Type == schema.ElementTypeGroup; container != tt.container {
+				t.Errorf("Expected container %v, got element type %s", tt.container, element.Type)
+			}
+		})
+	}
+}
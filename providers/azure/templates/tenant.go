@@ -0,0 +1,26 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// TenantTemplate handles template generation for Tenant elements
+type TenantTemplate struct{}
+
+// NewTenantTemplate creates a new Tenant template generator
+func NewTenantTemplate() *TenantTemplate {
+	return &TenantTemplate{}
+}
+
+// Generate creates a schema.Element from Tenant parameters
+func (t *TenantTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	return newContainer(params, containerSpec{
+		label:       "Tenant",
+		icon:        "identity/Azure_Active_Directory.svg",
+		fill:        colorTenant,
+		width:       600,
+		height:      400,
+		spacing:     30,
+		arrangement: schema.ArrangementVertical,
+	}, getStringParam(params, "domain", "")), nil
+}
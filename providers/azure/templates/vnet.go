@@ -0,0 +1,27 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// VNetTemplate handles template generation for Virtual Network elements
+type VNetTemplate struct{}
+
+// NewVNetTemplate creates a new Virtual Network template generator
+func NewVNetTemplate() *VNetTemplate {
+	return &VNetTemplate{}
+}
+
+// Generate creates a schema.Element from Virtual Network parameters
+func (t *VNetTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	return newContainer(params, containerSpec{
+		label:       "VNet",
+		icon:        "networking/Virtual_Networks.svg",
+		stroke:      colorNetworkRim,
+		fill:        colorNetwork,
+		width:       400,
+		height:      250,
+		spacing:     30,
+		arrangement: schema.ArrangementHorizontal,
+	}, getStringParam(params, "cidr", "")), nil
+}
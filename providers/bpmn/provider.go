@@ -0,0 +1,186 @@
+package bpmn
+
+import (
+	"fmt"
+
+	"github.com/LederWorks/hippodamus/pkg/providers"
+	"github.com/LederWorks/hippodamus/pkg/schema"
+	"github.com/LederWorks/hippodamus/providers/bpmn/resources"
+	"github.com/LederWorks/hippodamus/providers/bpmn/templates"
+)
+
+// BPMNProvider implements the Provider interface for BPMN process diagrams
+type BPMNProvider struct {
+	version string
+	// Resource instances
+	poolResource              *resources.PoolResource
+	laneResource              *resources.LaneResource
+	startEventResource        *resources.StartEventResource
+	intermediateEventResource *resources.IntermediateEventResource
+	endEventResource          *resources.EndEventResource
+	taskResource              *resources.TaskResource
+	gatewayResource           *resources.GatewayResource
+	dataObjectResource        *resources.DataObjectResource
+	sequenceFlowResource      *resources.SequenceFlowResource
+	messageFlowResource       *resources.MessageFlowResource
+	// Template instances
+	poolTemplate              *templates.PoolTemplate
+	laneTemplate              *templates.LaneTemplate
+	startEventTemplate        *templates.StartEventTemplate
+	intermediateEventTemplate *templates.IntermediateEventTemplate
+	endEventTemplate          *templates.EndEventTemplate
+	taskTemplate              *templates.TaskTemplate
+	gatewayTemplate           *templates.GatewayTemplate
+	dataObjectTemplate        *templates.DataObjectTemplate
+	sequenceFlowTemplate      *templates.SequenceFlowTemplate
+	messageFlowTemplate       *templates.MessageFlowTemplate
+}
+
+// NewBPMNProvider creates a new BPMN provider instance
+func NewBPMNProvider() *BPMNProvider {
+	return NewBPMNProviderWithVersion("dev")
+}
+
+// NewBPMNProviderWithVersion creates a new BPMN provider instance with a specific version
+func NewBPMNProviderWithVersion(version string) *BPMNProvider {
+	return &BPMNProvider{
+		version:                   version,
+		poolResource:              resources.NewPoolResource(),
+		laneResource:              resources.NewLaneResource(),
+		startEventResource:        resources.NewStartEventResource(),
+		intermediateEventResource: resources.NewIntermediateEventResource(),
+		endEventResource:          resources.NewEndEventResource(),
+		taskResource:              resources.NewTaskResource(),
+		gatewayResource:           resources.NewGatewayResource(),
+		dataObjectResource:        resources.NewDataObjectResource(),
+		sequenceFlowResource:      resources.NewSequenceFlowResource(),
+		messageFlowResource:       resources.NewMessageFlowResource(),
+		poolTemplate:              templates.NewPoolTemplate(),
+		laneTemplate:              templates.NewLaneTemplate(),
+		startEventTemplate:        templates.NewStartEventTemplate(),
+		intermediateEventTemplate: templates.NewIntermediateEventTemplate(),
+		endEventTemplate:          templates.NewEndEventTemplate(),
+		taskTemplate:              templates.NewTaskTemplate(),
+		gatewayTemplate:           templates.NewGatewayTemplate(),
+		dataObjectTemplate:        templates.NewDataObjectTemplate(),
+		sequenceFlowTemplate:      templates.NewSequenceFlowTemplate(),
+		messageFlowTemplate:       templates.NewMessageFlowTemplate(),
+	}
+}
+
+// Name returns the provider name
+func (p *BPMNProvider) Name() string {
+	return "bpmn"
+}
+
+// Version returns the provider version
+func (p *BPMNProvider) Version() string {
+	return p.version
+}
+
+// Resources returns the list of supported BPMN resources
+func (p *BPMNProvider) Resources() []providers.ResourceDefinition {
+	return []providers.ResourceDefinition{
+		p.poolResource.Definition(),
+		p.laneResource.Definition(),
+		p.startEventResource.Definition(),
+		p.intermediateEventResource.Definition(),
+		p.endEventResource.Definition(),
+		p.taskResource.Definition(),
+		p.gatewayResource.Definition(),
+		p.dataObjectResource.Definition(),
+		p.sequenceFlowResource.Definition(),
+		p.messageFlowResource.Definition(),
+	}
+}
+
+// Validate validates BPMN resource parameters
+func (p *BPMNProvider) Validate(resourceType string, params map[string]interface{}) error {
+	switch resourceType {
+	case "pool":
+		return p.poolResource.Validate(params)
+	case "lane":
+		return p.laneResource.Validate(params)
+	case "start-event":
+		return p.startEventResource.Validate(params)
+	case "intermediate-event":
+		return p.intermediateEventResource.Validate(params)
+	case "end-event":
+		return p.endEventResource.Validate(params)
+	case "task":
+		return p.taskResource.Validate(params)
+	case "gateway":
+		return p.gatewayResource.Validate(params)
+	case "data-object":
+		return p.dataObjectResource.Validate(params)
+	case "sequence-flow":
+		return p.sequenceFlowResource.Validate(params)
+	case "message-flow":
+		return p.messageFlowResource.Validate(params)
+	default:
+		return p.unsupportedResource(resourceType)
+	}
+}
+
+// GenerateTemplate generates BPMN resource templates
+func (p *BPMNProvider) GenerateTemplate(resourceType string, params map[string]interface{}) (*schema.Element, error) {
+	if err := p.Validate(resourceType, params); err != nil {
+		return nil, err
+	}
+
+	switch resourceType {
+	case "pool":
+		return p.poolTemplate.Generate(params)
+	case "lane":
+		return p.laneTemplate.Generate(params)
+	case "start-event":
+		return p.startEventTemplate.Generate(params)
+	case "intermediate-event":
+		return p.intermediateEventTemplate.Generate(params)
+	case "end-event":
+		return p.endEventTemplate.Generate(params)
+	case "task":
+		return p.taskTemplate.Generate(params)
+	case "gateway":
+		return p.gatewayTemplate.Generate(params)
+	case "data-object":
+		return p.dataObjectTemplate.Generate(params)
+	case "sequence-flow":
+		return p.sequenceFlowTemplate.Generate(params)
+	case "message-flow":
+		return p.messageFlowTemplate.Generate(params)
+	default:
+		return nil, p.unsupportedResource(resourceType)
+	}
+}
+
+// GetSchema returns the JSON schema for a resource type
+func (p *BPMNProvider) GetSchema(resourceType string) (map[string]interface{}, error) {
+	for _, resource := range p.Resources() {
+		if resource.Type == resourceType {
+			return resource.Schema, nil
+		}
+	}
+
+	return nil, &providers.ProviderError{
+		Provider: p.Name(),
+		Resource: resourceType,
+		Message:  fmt.Sprintf("schema not found for resource type: %s", resourceType),
+		Code:     "SCHEMA_NOT_FOUND",
+	}
+}
+
+// GetVersion returns the provider version
+func (p *BPMNProvider) GetVersion() string {
+	return p.version
+}
+
+// unsupportedResource returns the error for resource types the provider does not support
+func (p *BPMNProvider) unsupportedResource(resourceType string) error {
+	return &providers.ProviderError{
+		Provider: p.Name(),
+		Resource: resourceType,
+		Message:  fmt.Sprintf("unsupported resource type: %s", resourceType),
+		Code:     "UNSUPPORTED_RESOURCE",
+	}
+}
@@ -0,0 +1,225 @@
+package bpmn
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers"
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+	"github.com/LederWorks/hippodamus/pkg/schema"
+	"github.com/LederWorks/hippodamus/pkg/templates"
+)
+
+func TestBPMNProvider_Basic(t *testing.T) {
+	provider := NewBPMNProvider()
+
+	if provider.Name() != "bpmn" {
+		t.Errorf("Expected provider name 'bpmn', got '%s'", provider.Name())
+	}
+
+	if provider.Version() != "dev" {
+		t.Errorf("Expected version 'dev', got '%s'", provider.Version())
+	}
+
+	expected := []string{"pool", "lane", "start-event", "intermediate-event", "end-event", "task", "gateway", "data-object", "sequence-flow", "message-flow"}
+	resources := provider.Resources()
+	if len(resources) != len(expected) {
+		t.Fatalf("Expected %d resources, got %d", len(expected), len(resources))
+	}
+
+	for i, resourceType := range expected {
+		if resources[i].Type != resourceType {
+			t.Errorf("Expected resource %d to be '%s', got '%s'", i, resourceType, resources[i].Type)
+		}
+	}
+}
+
+func TestBPMNProvider_Conformance(t *testing.T) {
+	providertest.Run(t, NewBPMNProvider())
+}
+
+func TestBPMNProvider_Examples(t *testing.T) {
+	provider := NewBPMNProvider()
+
+	for _, resource := range provider.Resources() {
+		t.Run(resource.Type, func(t *testing.T) {
+			if len(resource.Examples) == 0 {
+				t.Fatal("Expected at least one example")
+			}
+
+			element, err := provider.GenerateTemplate(resource.Type, resource.Examples[0].Config)
+			if err != nil {
+				t.Fatalf("GenerateTemplate() error = %v", err)
+			}
+
+			switch resource.Type {
+			case "pool", "lane":
+				if element.Type != schema.ElementTypeSwimLane || element.Properties.Shape != "swimlane" {
+					t.Errorf("Expected swimlane, got %s with shape '%s'", element.Type, element.Properties.Shape)
+				}
+			case "sequence-flow", "message-flow":
+				if element.Type != schema.ElementTypeConnector {
+					t.Errorf("Expected connector, got %s", element.Type)
+				}
+			default:
+				if !strings.HasPrefix(element.Properties.Shape, "mxgraph.bpmn.") {
+					t.Errorf("Expected mxgraph.bpmn shape, got '%s'", element.Properties.Shape)
+				}
+			}
+		})
+	}
+}
+
+func TestBPMNProvider_ConnectionRules(t *testing.T) {
+	provider := NewBPMNProvider()
+
+	tests := []struct {
+		resourceType      string
+		disallowedSources []string
+		disallowedTargets []string
+	}{
+		{
+			resourceType:      "sequence-flow",
+			disallowedSources: []string{"end-event", "data-object", "pool", "lane"},
+			disallowedTargets: []string{"start-event", "data-object", "pool", "lane"},
+		},
+		{
+			resourceType:      "message-flow",
+			disallowedSources: []string{"start-event", "gateway", "data-object", "lane"},
+			disallowedTargets: []string{"end-event", "gateway", "data-object", "lane"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.resourceType, func(t *testing.T) {
+			definition := resourceDefinition(t, provider, tt.resourceType)
+			if !reflect.DeepEqual(definition.DisallowedSources, tt.disallowedSources) {
+				t.Errorf("Expected disallowed sources %v, got %v", tt.disallowedSources, definition.DisallowedSources)
+			}
+			if !reflect.DeepEqual(definition.DisallowedTargets, tt.disallowedTargets) {
+				t.Errorf("Expected disallowed targets %v, got %v", tt.disallowedTargets, definition.DisallowedTargets)
+			}
+		})
+	}
+}
+
+func TestBPMNProvider_ContainmentRules(t *testing.T) {
+	provider := NewBPMNProvider()
+
+	if parents := resourceDefinition(t, provider, "lane").AllowedParents; !reflect.DeepEqual(parents, []string{"pool", "lane"}) {
+		t.Errorf("Expected lanes to be allowed in pools and lanes, got %v", parents)
+	}
+	if parents := resourceDefinition(t, provider, "task").AllowedParents; parents != nil {
+		t.Errorf("Expected tasks to be allowed anywhere, got %v", parents)
+	}
+}
+
+// The flow and placement rules are declared on the resource definitions and enforced by the
+// template processor, which knows the endpoints of each flow and the parent of each lane
+func TestBPMNProvider_StructuralRules(t *testing.T) {
+	if err := providers.DefaultRegistry.Register(NewBPMNProvider()); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+
+	element := func(id, resource string, params map[string]interface{}, children ...schema.Element) schema.Element {
+		return schema.Element{ID: id, Resource: resource, Parameters: params, Children: children}
+	}
+	flow := func(id, resource, source, target string) schema.Element {
+		return element(id, resource, map[string]interface{}{"source": source, "target": target})
+	}
+	process := []schema.Element{
+		element("start", "bpmn-start-event", nil),
+		element("check", "bpmn-task", nil),
+		element("decide", "bpmn-gateway", nil),
+		element("end", "bpmn-end-event", nil),
+		element("invoice", "bpmn-data-object", nil),
+	}
+
+	tests := []struct {
+		name     string
+		elements []schema.Element
+		wantErr  string
+	}{
+		{
+			name:     "valid process",
+			elements: []schema.Element{flow("f1", "bpmn-sequence-flow", "start", "check"), flow("f2", "bpmn-sequence-flow", "check", "end")},
+		},
+		{
+			name:     "incoming sequence flow of a start event",
+			elements: []schema.Element{flow("f1", "bpmn-sequence-flow", "check", "start")},
+			wantErr:  "resource bpmn.sequence-flow cannot end at bpmn.start-event",
+		},
+		{
+			name:     "outgoing sequence flow of an end event",
+			elements: []schema.Element{flow("f1", "bpmn-sequence-flow", "end", "check")},
+			wantErr:  "resource bpmn.sequence-flow cannot start at bpmn.end-event",
+		},
+		{
+			name:     "sequence flow to a data object",
+			elements: []schema.Element{flow("f1", "bpmn-sequence-flow", "check", "invoice")},
+			wantErr:  "resource bpmn.sequence-flow cannot end at bpmn.data-object",
+		},
+		{
+			name:     "message flow from a gateway",
+			elements: []schema.Element{flow("m1", "bpmn-message-flow", "decide", "check")},
+			wantErr:  "resource bpmn.message-flow cannot start at bpmn.gateway",
+		},
+		{
+			name:     "lane outside a pool",
+			elements: []schema.Element{element("sales", "bpmn-lane", nil)},
+			wantErr:  "resource bpmn.lane must be placed in bpmn.pool or bpmn.lane, not at the top level",
+		},
+		{
+			name:     "lane in a pool",
+			elements: []schema.Element{element("shop", "bpmn-pool", nil, element("sales", "bpmn-lane", nil))},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &schema.DiagramConfig{
+				Diagram: schema.Diagram{Pages: []schema.Page{{ID: "process", Name: "Process", Elements: append(append([]schema.Element{}, process...), tt.elements...)}}},
+			}
+
+			err := templates.NewTemplateProcessor("").ProcessDiagram(config)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ProcessDiagram() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+// resourceDefinition returns the definition of a resource of the provider
+func resourceDefinition(t *testing.T, provider *BPMNProvider, resourceType string) providers.ResourceDefinition {
+	t.Helper()
+	for _, definition := range provider.Resources() {
+		if definition.Type == resourceType {
+			return definition
+		}
+	}
+	t.Fatalf("Resource %s not found", resourceType)
+	return providers.ResourceDefinition{}
+}
+
+func TestBPMNProvider_UnsupportedResource(t *testing.T) {
+	provider := NewBPMNProvider()
+
+	if err := provider.Validate("choreography", nil); err == nil {
+		t.Error("Expected error for unsupported resource type")
+	}
+
+	if _, err := provider.GenerateTemplate("choreography", nil); err == nil {
+		t.Error("Expected error for unsupported resource type")
+	}
+
+	if _, err := provider.GetSchema("choreography"); err == nil {
+		t.Error("Expected error for unsupported resource schema")
+	}
+}
@@ -0,0 +1,53 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// eventTriggers are the supported BPMN event definitions
+var eventTriggers = []string{"none", "message", "timer", "error"}
+
+// eventSchema builds the JSON schema of a BPMN event with the triggers it supports
+func eventSchema(defaultLabel string, triggers []string, properties map[string]interface{}) map[string]interface{} {
+	merged := map[string]interface{}{
+		"trigger": map[string]interface{}{
+			"type":        "string",
+			"description": "Event definition",
+			"enum":        triggers,
+			"default":     "none",
+		},
+	}
+	for name, property := range properties {
+		merged[name] = property
+	}
+	return providers.ResourceSchema(defaultLabel, 40, 40, merged)
+}
+
+// flowSchema builds the JSON schema of a BPMN flow between two elements
+func flowSchema(properties map[string]interface{}) map[string]interface{} {
+	merged := map[string]interface{}{
+		"source": map[string]interface{}{
+			"type":        "string",
+			"description": "Source element ID",
+			"minLength":   1,
+		},
+		"target": map[string]interface{}{
+			"type":        "string",
+			"description": "Target element ID",
+			"minLength":   1,
+		},
+		"label": map[string]interface{}{
+			"type":        "string",
+			"description": "Flow label",
+		},
+	}
+	for name, property := range properties {
+		merged[name] = property
+	}
+
+	return map[string]interface{}{
+		"type":       "object",
+		"properties": merged,
+		"required":   []string{"source", "target"},
+	}
+}
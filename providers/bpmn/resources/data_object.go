@@ -0,0 +1,54 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// dataObjectTypes are the supported data object transfer types
+var dataObjectTypes = []string{"none", "input", "output"}
+
+// DataObjectResource defines the BPMN Data Object resource
+type DataObjectResource struct{}
+
+// NewDataObjectResource creates a new Data Object resource instance
+func NewDataObjectResource() *DataObjectResource {
+	return &DataObjectResource{}
+}
+
+// Definition returns the resource definition for Data Object elements
+func (r *DataObjectResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "data-object",
+		Name:        "Data Object",
+		Description: "Information used or produced by a process",
+		Category:    "data",
+		Schema: providers.ResourceSchema("", 40, 60, map[string]interface{}{
+			"dataType": map[string]interface{}{
+				"type":        "string",
+				"description": "Whether the data is a process input or output",
+				"enum":        dataObjectTypes,
+				"default":     "none",
+			},
+			"collection": map[string]interface{}{
+				"type":        "boolean",
+				"description": "Whether the data object is a collection",
+				"default":     false,
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Data Object",
+				Description: "Incident report produced by a runbook",
+				Config: map[string]interface{}{
+					"label":    "Incident report",
+					"dataType": "output",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates Data Object parameters
+func (r *DataObjectResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
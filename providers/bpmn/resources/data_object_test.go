@@ -0,0 +1,25 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestDataObjectResource_Validate(t *testing.T) {
+	resource := NewDataObjectResource()
+
+	if err := resource.Validate(map[string]interface{}{"dataType": "output", "collection": true}); err != nil {
+		t.Errorf("Expected output collection to be valid, got %v", err)
+	}
+
+	err := resource.Validate(map[string]interface{}{"dataType": "store"})
+	if code := providertest.ValidationCode(err, "dataType"); code != "INVALID_ENUM" {
+		t.Errorf("Expected INVALID_ENUM for data type store, got %q", code)
+	}
+
+	err = resource.Validate(map[string]interface{}{"collection": "yes"})
+	if code := providertest.ValidationCode(err, "collection"); code != "INVALID_TYPE" {
+		t.Errorf("Expected INVALID_TYPE for collection yes, got %q", code)
+	}
+}
@@ -0,0 +1,42 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// endEventTriggers are the results an end event can throw
+var endEventTriggers = []string{"none", "message", "error"}
+
+// EndEventResource defines the BPMN End Event resource
+type EndEventResource struct{}
+
+// NewEndEventResource creates a new End Event resource instance
+func NewEndEventResource() *EndEventResource {
+	return &EndEventResource{}
+}
+
+// Definition returns the resource definition for End Event elements
+func (r *EndEventResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "end-event",
+		Name:        "End Event",
+		Description: "Event ending a path of a process, has no outgoing sequence flows",
+		Category:    "events",
+		Schema:      eventSchema("", endEventTriggers, map[string]interface{}{}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "End Event",
+				Description: "Process ending with an error",
+				Config: map[string]interface{}{
+					"label":   "Restore failed",
+					"trigger": "error",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates End Event parameters
+func (r *EndEventResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
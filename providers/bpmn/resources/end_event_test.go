@@ -0,0 +1,23 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestEndEventResource_Trigger(t *testing.T) {
+	resource := NewEndEventResource()
+
+	for _, trigger := range []string{"none", "message", "error"} {
+		if err := resource.Validate(map[string]interface{}{"trigger": trigger}); err != nil {
+			t.Errorf("Expected %s end event to be valid, got %v", trigger, err)
+		}
+	}
+
+	// A process cannot end by waiting
+	err := resource.Validate(map[string]interface{}{"trigger": "timer"})
+	if code := providertest.ValidationCode(err, "trigger"); code != "INVALID_ENUM" {
+		t.Errorf("Expected INVALID_ENUM for timer end event, got %q", code)
+	}
+}
@@ -0,0 +1,49 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// gatewayTypes are the supported BPMN gateways
+var gatewayTypes = []string{"exclusive", "parallel", "inclusive"}
+
+// GatewayResource defines the BPMN Gateway resource
+type GatewayResource struct{}
+
+// NewGatewayResource creates a new Gateway resource instance
+func NewGatewayResource() *GatewayResource {
+	return &GatewayResource{}
+}
+
+// Definition returns the resource definition for Gateway elements
+func (r *GatewayResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "gateway",
+		Name:        "Gateway",
+		Description: "Splits or joins the sequence flow of a process",
+		Category:    "gateways",
+		Schema: providers.ResourceSchema("", 50, 50, map[string]interface{}{
+			"gatewayType": map[string]interface{}{
+				"type":        "string",
+				"description": "Gateway behavior",
+				"enum":        gatewayTypes,
+				"default":     "exclusive",
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Gateway",
+				Description: "Decision between two paths",
+				Config: map[string]interface{}{
+					"label":       "Lag below 5s?",
+					"gatewayType": "exclusive",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates Gateway parameters
+func (r *GatewayResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
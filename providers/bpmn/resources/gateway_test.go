@@ -0,0 +1,22 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestGatewayResource_GatewayType(t *testing.T) {
+	resource := NewGatewayResource()
+
+	for _, gatewayType := range gatewayTypes {
+		if err := resource.Validate(map[string]interface{}{"gatewayType": gatewayType}); err != nil {
+			t.Errorf("Expected %s gateway to be valid, got %v", gatewayType, err)
+		}
+	}
+
+	err := resource.Validate(map[string]interface{}{"gatewayType": "event-based"})
+	if code := providertest.ValidationCode(err, "gatewayType"); code != "INVALID_ENUM" {
+		t.Errorf("Expected INVALID_ENUM for event-based gateway, got %q", code)
+	}
+}
@@ -0,0 +1,66 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// IntermediateEventResource defines the BPMN Intermediate Event resource
+type IntermediateEventResource struct{}
+
+// NewIntermediateEventResource creates a new Intermediate Event resource instance
+func NewIntermediateEventResource() *IntermediateEventResource {
+	return &IntermediateEventResource{}
+}
+
+// Definition returns the resource definition for Intermediate Event elements
+func (r *IntermediateEventResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "intermediate-event",
+		Name:        "Intermediate Event",
+		Description: "Event caught or thrown during a process",
+		Category:    "events",
+		Schema: eventSchema("", eventTriggers, map[string]interface{}{
+			"throwing": map[string]interface{}{
+				"type":        "boolean",
+				"description": "Whether the event is thrown instead of caught",
+				"default":     false,
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Intermediate Event",
+				Description: "Waiting for a message",
+				Config: map[string]interface{}{
+					"label":   "Payment received",
+					"trigger": "message",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates Intermediate Event parameters
+func (r *IntermediateEventResource) Validate(params map[string]interface{}) error {
+	if err := providers.ValidateParams(r.Definition().Schema, params); err != nil {
+		return err
+	}
+
+	// Timers and errors can only be caught, a none event can only be thrown
+	trigger, _ := params["trigger"].(string)
+	throwing, _ := params["throwing"].(bool)
+	switch {
+	case throwing && (trigger == "timer" || trigger == "error"):
+		return &providers.ValidationError{
+			Field:   "throwing",
+			Message: "intermediate " + trigger + " events can only be caught",
+			Code:    "INVALID_TRIGGER",
+		}
+	case !throwing && (trigger == "" || trigger == "none"):
+		return &providers.ValidationError{
+			Field:   "throwing",
+			Message: "intermediate none events can only be thrown",
+			Code:    "INVALID_TRIGGER",
+		}
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestIntermediateEventResource_CatchingAndThrowing(t *testing.T) {
+	resource := NewIntermediateEventResource()
+
+	tests := []struct {
+		name     string
+		trigger  string
+		throwing bool
+		code     string
+	}{
+		{name: "catching message", trigger: "message"},
+		{name: "throwing message", trigger: "message", throwing: true},
+		{name: "catching timer", trigger: "timer"},
+		{name: "throwing timer", trigger: "timer", throwing: true, code: "INVALID_TRIGGER"},
+		{name: "catching error", trigger: "error"},
+		{name: "throwing error", trigger: "error", throwing: true, code: "INVALID_TRIGGER"},
+		{name: "throwing none", trigger: "none", throwing: true},
+		{name: "catching none", trigger: "none", code: "INVALID_TRIGGER"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := resource.Validate(map[string]interface{}{"trigger": tt.trigger, "throwing": tt.throwing})
+			if code := providertest.ValidationCode(err, "throwing"); code != tt.code {
+				t.Errorf("Expected code %q, got %q", tt.code, code)
+			}
+		})
+	}
+}
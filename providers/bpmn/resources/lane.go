@@ -0,0 +1,39 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// LaneResource defines the BPMN Lane resource
+type LaneResource struct{}
+
+// NewLaneResource creates a new Lane resource instance
+func NewLaneResource() *LaneResource {
+	return &LaneResource{}
+}
+
+// Definition returns the resource definition for Lane elements
+func (r *LaneResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "lane",
+		Name:        "Lane",
+		Description: "Role or system within a pool, lays out its flow elements horizontally",
+		Category:    "swimlanes",
+		Schema:      providers.ResourceSchema("Lane", 770, 200, map[string]interface{}{}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Lane",
+				Description: "On-call engineer",
+				Config: map[string]interface{}{
+					"label": "On-call engineer",
+				},
+			},
+		},
+		AllowedParents: []string{"pool", "lane"},
+	}
+}
+
+// Validate validates Lane parameters
+func (r *LaneResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
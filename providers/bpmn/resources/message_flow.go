@@ -0,0 +1,44 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// MessageFlowResource defines the BPMN Message Flow resource
+type MessageFlowResource struct{}
+
+// NewMessageFlowResource creates a new Message Flow resource instance
+func NewMessageFlowResource() *MessageFlowResource {
+	return &MessageFlowResource{}
+}
+
+// Definition returns the resource definition for Message Flow elements
+func (r *MessageFlowResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "message-flow",
+		Name:        "Message Flow",
+		Description: "Message exchanged between two pools",
+		Category:    "flows",
+		Schema:      flowSchema(map[string]interface{}{}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Message Flow",
+				Description: "Alert sent to the on-call engineer",
+				Config: map[string]interface{}{
+					"source": "monitoring",
+					"target": "alert-received",
+					"label":  "Alert",
+				},
+			},
+		},
+		// Message flows connect participants, so they do not start or end a process
+		// and do not pass through gateways
+		DisallowedSources: []string{"start-event", "gateway", "data-object", "lane"},
+		DisallowedTargets: []string{"end-event", "gateway", "data-object", "lane"},
+	}
+}
+
+// Validate validates Message Flow parameters
+func (r *MessageFlowResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
@@ -0,0 +1,38 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// PoolResource defines the BPMN Pool resource
+type PoolResource struct{}
+
+// NewPoolResource creates a new Pool resource instance
+func NewPoolResource() *PoolResource {
+	return &PoolResource{}
+}
+
+// Definition returns the resource definition for Pool elements
+func (r *PoolResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "pool",
+		Name:        "Pool",
+		Description: "Participant of a collaboration, stacks its lanes vertically",
+		Category:    "swimlanes",
+		Schema:      providers.ResourceSchema("Pool", 800, 200, map[string]interface{}{}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Pool",
+				Description: "Operations team",
+				Config: map[string]interface{}{
+					"label": "Operations",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates Pool parameters
+func (r *PoolResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
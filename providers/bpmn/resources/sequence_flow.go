@@ -0,0 +1,65 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// SequenceFlowResource defines the BPMN Sequence Flow resource
+type SequenceFlowResource struct{}
+
+// NewSequenceFlowResource creates a new Sequence Flow resource instance
+func NewSequenceFlowResource() *SequenceFlowResource {
+	return &SequenceFlowResource{}
+}
+
+// Definition returns the resource definition for Sequence Flow elements
+func (r *SequenceFlowResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "sequence-flow",
+		Name:        "Sequence Flow",
+		Description: "Order of events, activities and gateways within a pool",
+		Category:    "flows",
+		Schema: flowSchema(map[string]interface{}{
+			"condition": map[string]interface{}{
+				"type":        "string",
+				"description": "Condition of a flow leaving a gateway or activity",
+			},
+			"default": map[string]interface{}{
+				"type":        "boolean",
+				"description": "Whether the flow is taken when no condition holds",
+				"default":     false,
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Sequence Flow",
+				Description: "Conditional flow leaving a gateway",
+				Config: map[string]interface{}{
+					"source":    "lag-check",
+					"target":    "failover",
+					"condition": "lag >= 5s",
+				},
+			},
+		},
+		// Start events have no incoming and end events no outgoing sequence flows, and
+		// sequence flows only connect flow nodes
+		DisallowedSources: []string{"end-event", "data-object", "pool", "lane"},
+		DisallowedTargets: []string{"start-event", "data-object", "pool", "lane"},
+	}
+}
+
+// Validate validates Sequence Flow parameters
+func (r *SequenceFlowResource) Validate(params map[string]interface{}) error {
+	if err := providers.ValidateParams(r.Definition().Schema, params); err != nil {
+		return err
+	}
+
+	if condition, _ := params["condition"].(string); condition != "" && params["default"] == true {
+		return &providers.ValidationError{
+			Field:   "default",
+			Message: "a default flow cannot have a condition",
+			Code:    "INVALID_COMBINATION",
+		}
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestSequenceFlowResource_DefaultFlow(t *testing.T) {
+	resource := NewSequenceFlowResource()
+
+	tests := []struct {
+		name   string
+		params map[string]interface{}
+		code   string
+	}{
+		{name: "conditional flow", params: map[string]interface{}{"source": "decide", "target": "ship", "condition": "paid"}},
+		{name: "default flow", params: map[string]interface{}{"source": "decide", "target": "cancel", "default": true}},
+		{name: "default flow with empty condition", params: map[string]interface{}{"source": "decide", "target": "cancel", "default": true, "condition": ""}},
+		{name: "default flow with condition", params: map[string]interface{}{"source": "decide", "target": "cancel", "default": true, "condition": "unpaid"}, code: "INVALID_COMBINATION"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := resource.Validate(tt.params)
+			if code := providertest.ValidationCode(err, "default"); code != tt.code {
+				t.Errorf("Expected code %q, got %q", tt.code, code)
+			}
+		})
+	}
+}
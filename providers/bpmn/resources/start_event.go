@@ -0,0 +1,43 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// startEventTriggers are the triggers a start event can have, error start events are
+// only allowed in event sub-processes
+var startEventTriggers = []string{"none", "message", "timer"}
+
+// StartEventResource defines the BPMN Start Event resource
+type StartEventResource struct{}
+
+// NewStartEventResource creates a new Start Event resource instance
+func NewStartEventResource() *StartEventResource {
+	return &StartEventResource{}
+}
+
+// Definition returns the resource definition for Start Event elements
+func (r *StartEventResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "start-event",
+		Name:        "Start Event",
+		Description: "Event starting a process, has no incoming sequence flows",
+		Category:    "events",
+		Schema:      eventSchema("", startEventTriggers, map[string]interface{}{}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Start Event",
+				Description: "Timer start event",
+				Config: map[string]interface{}{
+					"label":   "Every night",
+					"trigger": "timer",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates Start Event parameters
+func (r *StartEventResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
@@ -0,0 +1,23 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestStartEventResource_Trigger(t *testing.T) {
+	resource := NewStartEventResource()
+
+	for _, trigger := range []string{"none", "message", "timer"} {
+		if err := resource.Validate(map[string]interface{}{"trigger": trigger}); err != nil {
+			t.Errorf("Expected %s start event to be valid, got %v", trigger, err)
+		}
+	}
+
+	// Errors end or interrupt a process, they cannot start one
+	err := resource.Validate(map[string]interface{}{"trigger": "error"})
+	if code := providertest.ValidationCode(err, "trigger"); code != "INVALID_ENUM" {
+		t.Errorf("Expected INVALID_ENUM for error start event, got %q", code)
+	}
+}
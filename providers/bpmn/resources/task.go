@@ -0,0 +1,49 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// taskTypes are the supported BPMN task markers
+var taskTypes = []string{"abstract", "user", "service", "script", "manual", "send", "receive", "business-rule"}
+
+// TaskResource defines the BPMN Task resource
+type TaskResource struct{}
+
+// NewTaskResource creates a new Task resource instance
+func NewTaskResource() *TaskResource {
+	return &TaskResource{}
+}
+
+// Definition returns the resource definition for Task elements
+func (r *TaskResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "task",
+		Name:        "Task",
+		Description: "Unit of work performed in a process",
+		Category:    "activities",
+		Schema: providers.ResourceSchema("Task", 120, 80, map[string]interface{}{
+			"taskType": map[string]interface{}{
+				"type":        "string",
+				"description": "Kind of work, shown as a marker",
+				"enum":        taskTypes,
+				"default":     "abstract",
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Task",
+				Description: "Manual step of a runbook",
+				Config: map[string]interface{}{
+					"label":    "Check replication lag",
+					"taskType": "manual",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates Task parameters
+func (r *TaskResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
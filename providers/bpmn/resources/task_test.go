@@ -0,0 +1,23 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestTaskResource_TaskType(t *testing.T) {
+	resource := NewTaskResource()
+
+	for _, taskType := range taskTypes {
+		if err := resource.Validate(map[string]interface{}{"taskType": taskType}); err != nil {
+			t.Errorf("Expected %s task to be valid, got %v", taskType, err)
+		}
+	}
+
+	// Task types use dashes, not the camel case of the draw.io markers
+	err := resource.Validate(map[string]interface{}{"taskType": "businessRule"})
+	if code := providertest.ValidationCode(err, "taskType"); code != "INVALID_ENUM" {
+		t.Errorf("Expected INVALID_ENUM for task type businessRule, got %q", code)
+	}
+}
@@ -0,0 +1,173 @@
+package templates
+
+import (
+	"fmt"
+
+	"github.com/LederWorks/hippodamus/pkg/schema"
+	coretemplates "github.com/LederWorks/hippodamus/providers/core/templates"
+)
+
+// BPMN diagram colors
+const (
+	colorFill   = "#FFFFFF"
+	colorStroke = "#000000"
+	colorFont   = "#000000"
+)
+
+// nodeSpec describes the draw.io BPMN shape of an event, activity, gateway or data object
+type nodeSpec struct {
+	label      string
+	shape      string // draw.io shape, e.g. "mxgraph.bpmn.event"
+	width      float64
+	height     float64
+	labelBelow bool              // Whether the label is drawn below the shape instead of inside
+	custom     map[string]string // Shape specific style properties
+}
+
+// newNode creates a BPMN flow node or data object drawn with a draw.io mxgraph.bpmn shape
+func newNode(params map[string]interface{}, spec nodeSpec) *schema.Element {
+	custom := map[string]string{
+		"html":       "1",
+		"whiteSpace": "wrap",
+	}
+	for key, value := range spec.custom {
+		custom[key] = value
+	}
+
+	style := schema.Style{
+		FillColor:     getStringParam(params, "fillColor", colorFill),
+		StrokeColor:   getStringParam(params, "strokeColor", colorStroke),
+		FontColor:     getStringParam(params, "fontColor", colorFont),
+		FontSize:      12,
+		TextAlign:     "center",
+		VerticalAlign: "middle",
+		Custom:        custom,
+	}
+	if spec.labelBelow {
+		style.VerticalLabelPosition = "bottom"
+		style.VerticalAlign = "top"
+		style.Custom["aspect"] = "fixed"
+	}
+
+	return &schema.Element{
+		Type: schema.ElementTypeShape,
+		Properties: schema.ElementProperties{
+			X:      getFloatParam(params, "x", 0),
+			Y:      getFloatParam(params, "y", 0),
+			Width:  getFloatParam(params, "width", spec.width),
+			Height: getFloatParam(params, "height", spec.height),
+			Label:  getStringParam(params, "label", spec.label),
+			Shape:  spec.shape,
+		},
+		Style: style,
+	}
+}
+
+// newFlow creates a BPMN flow between two elements
+func newFlow(params map[string]interface{}, label string, custom map[string]string) *schema.Element {
+	return &schema.Element{
+		Type: schema.ElementTypeConnector,
+		Properties: schema.ElementProperties{
+			Source: getStringParam(params, "source", ""),
+			Target: getStringParam(params, "target", ""),
+			Label:  label,
+		},
+		Style: schema.Style{
+			StrokeColor: getStringParam(params, "strokeColor", colorStroke),
+			FontColor:   colorFont,
+			FontSize:    11,
+			Custom:      custom,
+		},
+	}
+}
+
+// eventSymbols maps event triggers to draw.io BPMN event symbols
+var eventSymbols = map[string]string{
+	"none":    "general",
+	"message": "message",
+	"timer":   "timer",
+	"error":   "error",
+}
+
+// newEvent creates a BPMN event with the outline of its kind and the symbol of its trigger
+func newEvent(params map[string]interface{}, outline string) *schema.Element {
+	return newNode(params, nodeSpec{
+		shape:      "mxgraph.bpmn.event",
+		width:      40,
+		height:     40,
+		labelBelow: true,
+		custom: map[string]string{
+			"outline":   outline,
+			"symbol":    eventSymbols[getStringParam(params, "trigger", "none")],
+			"perimeter": "ellipsePerimeter",
+		},
+	})
+}
+
+func getStringParam(params map[string]interface{}, key, defaultValue string) string {
+	if val, ok := params[key]; ok {
+		if str, ok := val.(string); ok {
+			return str
+		}
+	}
+	return defaultValue
+}
+
+func getFloatParam(params map[string]interface{}, key string, defaultValue float64) float64 {
+	if val, ok := params[key]; ok {
+		switch v := val.(type) {
+		case float64:
+			return v
+		case int:
+			return float64(v)
+		case int64:
+			return float64(v)
+		}
+	}
+	return defaultValue
+}
+
+func getBoolParam(params map[string]interface{}, key string, defaultValue bool) bool {
+	if val, ok := params[key]; ok {
+		if b, ok := val.(bool); ok {
+			return b
+		}
+	}
+	return defaultValue
+}
+
+// swimlaneHeaderSize is the width of the vertical header of pools and lanes
+const swimlaneHeaderSize = 30
+
+// newSwimlane creates a pool or lane from the core swimlane with the header on the left
+func newSwimlane(swimlane *coretemplates.SwimlaneTemplate, params map[string]interface{}, label string, width, height float64) (*schema.Element, error) {
+	swimlaneParams := map[string]interface{}{
+		"label":       getStringParam(params, "label", label),
+		"x":           getFloatParam(params, "x", 0),
+		"y":           getFloatParam(params, "y", 0),
+		"width":       getFloatParam(params, "width", width),
+		"height":      getFloatParam(params, "height", height),
+		"orientation": "vertical",
+		"startSize":   float64(swimlaneHeaderSize),
+		"collapsible": false,
+		"fillColor":   getStringParam(params, "fillColor", "none"),
+		"strokeColor": getStringParam(params, "strokeColor", colorStroke),
+		"fontColor":   getStringParam(params, "fontColor", colorFont),
+	}
+
+	element, err := swimlane.Generate(swimlaneParams)
+	if err != nil {
+		return nil, err
+	}
+
+	element.Properties.Shape = "swimlane"
+	element.Style.FontStyle = "1"
+	element.Style.Custom = map[string]string{
+		"html":       "1",
+		"horizontal": "0",
+		"startSize":  fmt.Sprintf("%d", swimlaneHeaderSize),
+		"container":  "1",
+		"whiteSpace": "wrap",
+	}
+	return element, nil
+}
@@ -0,0 +1,117 @@
+package templates
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+func TestEventTemplates(t *testing.T) {
+	tests := []struct {
+		name    string
+		element func() (*schema.Element, error)
+		outline string
+		symbol  string
+	}{
+		{name: "start", element: func() (*schema.Element, error) { return NewStartEventTemplate().Generate(map[string]interface{}{}) }, outline: "standard", symbol: "general"},
+		{name: "catching timer", element: func() (*schema.Element, error) {
+			return NewIntermediateEventTemplate().Generate(map[string]interface{}{"trigger": "timer"})
+		}, outline: "catching", symbol: "timer"},
+		{name: "throwing message", element: func() (*schema.Element, error) {
+			return NewIntermediateEventTemplate().Generate(map[string]interface{}{"trigger": "message", "throwing": true})
+		}, outline: "throwing", symbol: "message"},
+		{name: "error end", element: func() (*schema.Element, error) {
+			return NewEndEventTemplate().Generate(map[string]interface{}{"trigger": "error"})
+		}, outline: "end", symbol: "error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			element, err := tt.element()
+			if err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+			if element.Properties.Shape != "mxgraph.bpmn.event" {
+				t.Errorf("Expected mxgraph.bpmn.event, got '%s'", element.Properties.Shape)
+			}
+			if element.Style.Custom["outline"] != tt.outline || element.Style.Custom["symbol"] != tt.symbol {
+				t.Errorf("Expected %s outline with %s symbol, got %s/%s", tt.outline, tt.symbol, element.Style.Custom["outline"], element.Style.Custom["symbol"])
+			}
+			if element.Style.VerticalLabelPosition != "bottom" {
+				t.Error("Expected the event label below the shape")
+			}
+		})
+	}
+}
+
+func TestTaskTemplate_Marker(t *testing.T) {
+	element, _ := NewTaskTemplate().Generate(map[string]interface{}{"taskType": "business-rule"})
+	if element.Style.Custom["taskMarker"] != "businessRule" {
+		t.Errorf("Expected businessRule marker, got '%s'", element.Style.Custom["taskMarker"])
+	}
+}
+
+func TestPoolTemplate_ReusesCoreSwimlane(t *testing.T) {
+	element, err := NewPoolTemplate().Generate(map[string]interface{}{"label": "Operations", "x": 40})
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	if element.Type != schema.ElementTypeSwimLane || element.Properties.X != 40 {
+		t.Errorf("Expected swimlane at x=40, got %s at %v", element.Type, element.Properties.X)
+	}
+	if element.Style.Custom["horizontal"] != "0" {
+		t.Error("Expected the pool header on the left")
+	}
+	if element.Nesting.Arrangement != schema.ArrangementVertical || element.Nesting.Padding.Left != swimlaneHeaderSize {
+		t.Errorf("Expected lanes stacked next to the header, got %+v", element.Nesting)
+	}
+}
+
+func TestSequenceFlowTemplate(t *testing.T) {
+	conditional, _ := NewSequenceFlowTemplate().Generate(map[string]interface{}{"source": "a", "target": "b", "condition": "ok"})
+	if conditional.Properties.Label != "ok" || conditional.Style.Custom["startArrow"] != "diamondThin" {
+		t.Errorf("Expected conditional flow labelled with its condition, got '%s'", conditional.Properties.Label)
+	}
+
+	defaultFlow, _ := NewSequenceFlowTemplate().Generate(map[string]interface{}{"source": "a", "target": "b", "default": true})
+	if defaultFlow.Style.Custom["startArrow"] != "dash" {
+		t.Error("Expected default flow marker")
+	}
+
+	message, _ := NewMessageFlowTemplate().Generate(map[string]interface{}{"source": "a", "target": "b"})
+	if message.Style.StrokeDashArray == "" || message.Style.Custom["startArrow"] != "oval" {
+		t.Error("Expected dashed message flow starting with a circle")
+	}
+}
+
+func TestGatewayTemplate_Type(t *testing.T) {
+	element, _ := NewGatewayTemplate().Generate(map[string]interface{}{"gatewayType": "parallel"})
+	if element.Properties.Shape != "mxgraph.bpmn.gateway2" || element.Style.Custom["gwType"] != "parallel" {
+		t.Errorf("Expected parallel gateway, got %s/%s", element.Properties.Shape, element.Style.Custom["gwType"])
+	}
+}
+
+func TestDataObjectTemplate_Collection(t *testing.T) {
+	element, _ := NewDataObjectTemplate().Generate(map[string]interface{}{"dataType": "input", "collection": true})
+	if element.Properties.Shape != "mxgraph.bpmn.data" {
+		t.Errorf("Expected mxgraph.bpmn.data, got '%s'", element.Properties.Shape)
+	}
+	if element.Style.Custom["bpmnTransferType"] != "input" || element.Style.Custom["isCollection"] != "1" {
+		t.Errorf("Expected input collection, got %s/%s", element.Style.Custom["bpmnTransferType"], element.Style.Custom["isCollection"])
+	}
+}
+
+func TestLaneTemplate_LaysOutFlowElements(t *testing.T) {
+	element, err := NewLaneTemplate().Generate(map[string]interface{}{"label": "Sales"})
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	if element.Type != schema.ElementTypeSwimLane || element.Properties.Label != "Sales" {
+		t.Errorf("Expected swimlane Sales, got %s '%s'", element.Type, element.Properties.Label)
+	}
+	if element.Nesting.Arrangement != schema.ArrangementHorizontal || element.Nesting.Padding.Left <= swimlaneHeaderSize {
+		t.Errorf("Expected flow elements laid out next to the lane header, got %+v", element.Nesting)
+	}
+}
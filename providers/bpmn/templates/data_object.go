@@ -0,0 +1,33 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// DataObjectTemplate handles template generation for Data Object elements
+type DataObjectTemplate struct{}
+
+// NewDataObjectTemplate creates a new Data Object template generator
+func NewDataObjectTemplate() *DataObjectTemplate {
+	return &DataObjectTemplate{}
+}
+
+// Generate creates a schema.Element from Data Object parameters
+func (t *DataObjectTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	collection := "0"
+	if getBoolParam(params, "collection", false) {
+		collection = "1"
+	}
+
+	return newNode(params, nodeSpec{
+		shape:      "mxgraph.bpmn.data",
+		width:      40,
+		height:     60,
+		labelBelow: true,
+		custom: map[string]string{
+			"bpmnTransferType": getStringParam(params, "dataType", "none"),
+			"isCollection":     collection,
+			"size":             "15",
+		},
+	}), nil
+}
@@ -0,0 +1,18 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// EndEventTemplate handles template generation for End Event elements
+type EndEventTemplate struct{}
+
+// NewEndEventTemplate creates a new End Event template generator
+func NewEndEventTemplate() *EndEventTemplate {
+	return &EndEventTemplate{}
+}
+
+// Generate creates a schema.Element from End Event parameters
+func (t *EndEventTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	return newEvent(params, "end"), nil
+}
@@ -0,0 +1,29 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// GatewayTemplate handles template generation for Gateway elements
+type GatewayTemplate struct{}
+
+// NewGatewayTemplate creates a new Gateway template generator
+func NewGatewayTemplate() *GatewayTemplate {
+	return &GatewayTemplate{}
+}
+
+// Generate creates a schema.Element from Gateway parameters
+func (t *GatewayTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	return newNode(params, nodeSpec{
+		shape:      "mxgraph.bpmn.gateway2",
+		width:      50,
+		height:     50,
+		labelBelow: true,
+		custom: map[string]string{
+			"gwType":    getStringParam(params, "gatewayType", "exclusive"),
+			"outline":   "none",
+			"symbol":    "general",
+			"perimeter": "rhombusPerimeter",
+		},
+	}), nil
+}
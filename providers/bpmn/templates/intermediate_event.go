@@ -0,0 +1,22 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// IntermediateEventTemplate handles template generation for Intermediate Event elements
+type IntermediateEventTemplate struct{}
+
+// NewIntermediateEventTemplate creates a new Intermediate Event template generator
+func NewIntermediateEventTemplate() *IntermediateEventTemplate {
+	return &IntermediateEventTemplate{}
+}
+
+// Generate creates a schema.Element from Intermediate Event parameters
+func (t *IntermediateEventTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	outline := "catching"
+	if getBoolParam(params, "throwing", false) {
+		outline = "throwing"
+	}
+	return newEvent(params, outline), nil
+}
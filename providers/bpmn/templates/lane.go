@@ -0,0 +1,40 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+	coretemplates "github.com/LederWorks/hippodamus/providers/core/templates"
+)
+
+// LaneTemplate handles template generation for Lane elements
+type LaneTemplate struct {
+	swimlane *coretemplates.SwimlaneTemplate
+}
+
+// NewLaneTemplate creates a new Lane template generator
+func NewLaneTemplate() *LaneTemplate {
+	return &LaneTemplate{swimlane: coretemplates.NewSwimlaneTemplate()}
+}
+
+// Generate creates a schema.Element from Lane parameters
+func (t *LaneTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	element, err := newSwimlane(t.swimlane, params, "Lane", 770, 200)
+	if err != nil {
+		return nil, err
+	}
+	element.Style.FontStyle = "0"
+
+	// Flow elements run from left to right next to the lane header
+	element.Nesting = schema.NestingConfig{
+		Mode:        schema.NestingModeChild,
+		AutoResize:  true,
+		Arrangement: schema.ArrangementHorizontal,
+		Spacing:     60,
+		Padding: schema.Padding{
+			Top:    40,
+			Right:  40,
+			Bottom: 40,
+			Left:   swimlaneHeaderSize + 30,
+		},
+	}
+	return element, nil
+}
@@ -0,0 +1,25 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// MessageFlowTemplate handles template generation for Message Flow elements
+type MessageFlowTemplate struct{}
+
+// NewMessageFlowTemplate creates a new Message Flow template generator
+func NewMessageFlowTemplate() *MessageFlowTemplate {
+	return &MessageFlowTemplate{}
+}
+
+// Generate creates a schema.Element from Message Flow parameters
+func (t *MessageFlowTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	element := newFlow(params, getStringParam(params, "label", ""), map[string]string{
+		"endArrow":   "block",
+		"endFill":    "0",
+		"startArrow": "oval",
+		"startFill":  "0",
+	})
+	element.Style.StrokeDashArray = "8 4"
+	return element, nil
+}
@@ -0,0 +1,37 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+	coretemplates "github.com/LederWorks/hippodamus/providers/core/templates"
+)
+
+// PoolTemplate handles template generation for Pool elements
+type PoolTemplate struct {
+	swimlane *coretemplates.SwimlaneTemplate
+}
+
+// NewPoolTemplate creates a new Pool template generator
+func NewPoolTemplate() *PoolTemplate {
+	return &PoolTemplate{swimlane: coretemplates.NewSwimlaneTemplate()}
+}
+
+// Generate creates a schema.Element from Pool parameters
+func (t *PoolTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	element, err := newSwimlane(t.swimlane, params, "Pool", 800, 200)
+	if err != nil {
+		return nil, err
+	}
+
+	// Lanes are stacked below each other next to the pool header. They overlap by one
+	// pixel to share their borders, as a spacing of zero selects the default spacing.
+	element.Nesting = schema.NestingConfig{
+		Mode:        schema.NestingModeChild,
+		AutoResize:  true,
+		Arrangement: schema.ArrangementVertical,
+		Spacing:     -1,
+		Padding: schema.Padding{
+			Left: swimlaneHeaderSize,
+		},
+	}
+	return element, nil
+}
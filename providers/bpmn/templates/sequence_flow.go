@@ -0,0 +1,37 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// SequenceFlowTemplate handles template generation for Sequence Flow elements
+type SequenceFlowTemplate struct{}
+
+// NewSequenceFlowTemplate creates a new Sequence Flow template generator
+func NewSequenceFlowTemplate() *SequenceFlowTemplate {
+	return &SequenceFlowTemplate{}
+}
+
+// Generate creates a schema.Element from Sequence Flow parameters
+func (t *SequenceFlowTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	custom := map[string]string{
+		"endArrow": "block",
+		"endFill":  "1",
+	}
+
+	// Conditional flows start with a diamond and default flows with a slash
+	label := getStringParam(params, "label", "")
+	if condition := getStringParam(params, "condition", ""); condition != "" {
+		custom["startArrow"] = "diamondThin"
+		custom["startFill"] = "0"
+		custom["startSize"] = "14"
+		if label == "" {
+			label = condition
+		}
+	} else if getBoolParam(params, "default", false) {
+		custom["startArrow"] = "dash"
+		custom["startFill"] = "0"
+	}
+
+	return newFlow(params, label, custom), nil
+}
@@ -0,0 +1,18 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// StartEventTemplate handles template generation for Start Event elements
+type StartEventTemplate struct{}
+
+// NewStartEventTemplate creates a new Start Event template generator
+func NewStartEventTemplate() *StartEventTemplate {
+	return &StartEventTemplate{}
+}
+
+// Generate creates a schema.Element from Start Event parameters
+func (t *StartEventTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	return newEvent(params, "standard"), nil
+}
@@ -0,0 +1,40 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// taskMarkers maps task types to draw.io BPMN task markers
+var taskMarkers = map[string]string{
+	"abstract":      "abstract",
+	"user":          "user",
+	"service":       "service",
+	"script":        "script",
+	"manual":        "manual",
+	"send":          "send",
+	"receive":       "receive",
+	"business-rule": "businessRule",
+}
+
+// TaskTemplate handles template generation for Task elements
+type TaskTemplate struct{}
+
+// NewTaskTemplate creates a new Task template generator
+func NewTaskTemplate() *TaskTemplate {
+	return &TaskTemplate{}
+}
+
+// Generate creates a schema.Element from Task parameters
+func (t *TaskTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	return newNode(params, nodeSpec{
+		label:  "Task",
+		shape:  "mxgraph.bpmn.task",
+		width:  120,
+		height: 80,
+		custom: map[string]string{
+			"taskMarker": taskMarkers[getStringParam(params, "taskType", "abstract")],
+			"rectStyle":  "rounded",
+			"size":       "10",
+		},
+	}), nil
+}
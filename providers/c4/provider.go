@@ -0,0 +1,150 @@
+package c4
+
+import (
+	"fmt"
+
+	"github.com/LederWorks/hippodamus/pkg/providers"
+	"github.com/LederWorks/hippodamus/pkg/schema"
+	"github.com/LederWorks/hippodamus/providers/c4/resources"
+	"github.com/LederWorks/hippodamus/providers/c4/templates"
+)
+
+// C4Provider implements the Provider interface for C4 model diagrams
+type C4Provider struct {
+	version string
+	// Resource instances
+	personResource         *resources.PersonResource
+	softwareSystemResource *resources.SoftwareSystemResource
+	containerResource      *resources.ContainerResource
+	componentResource      *resources.ComponentResource
+	systemBoundaryResource *resources.SystemBoundaryResource
+	relationshipResource   *resources.RelationshipResource
+	// Template instances
+	personTemplate         *templates.PersonTemplate
+	softwareSystemTemplate *templates.SoftwareSystemTemplate
+	containerTemplate      *templates.ContainerTemplate
+	componentTemplate      *templates.ComponentTemplate
+	systemBoundaryTemplate *templates.SystemBoundaryTemplate
+	relationshipTemplate   *templates.RelationshipTemplate
+}
+
+// NewC4Provider creates a new C4 provider instance
+func NewC4Provider() *C4Provider {
+	return NewC4ProviderWithVersion("dev")
+}
+
+// NewC4ProviderWithVersion creates a new C4 provider instance with a specific version
+func NewC4ProviderWithVersion(version string) *C4Provider {
+	return &C4Provider{
+		version:                version,
+		personResource:         resources.NewPersonResource(),
+		softwareSystemResource: resources.NewSoftwareSystemResource(),
+		containerResource:      resources.NewContainerResource(),
+		componentResource:      resources.NewComponentResource(),
+		systemBoundaryResource: resources.NewSystemBoundaryResource(),
+		relationshipResource:   resources.NewRelationshipResource(),
+		personTemplate:         templates.NewPersonTemplate(),
+		softwareSystemTemplate: templates.NewSoftwareSystemTemplate(),
+		containerTemplate:      templates.NewContainerTemplate(),
+		componentTemplate:      templates.NewComponentTemplate(),
+		systemBoundaryTemplate: templates.NewSystemBoundaryTemplate(),
+		relationshipTemplate:   templates.NewRelationshipTemplate(),
+	}
+}
+
+// Name returns the provider name
+func (p *C4Provider) Name() string {
+	return "c4"
+}
+
+// Version returns the provider version
+func (p *C4Provider) Version() string {
+	return p.version
+}
+
+// Resources returns the list of supported C4 resources
+func (p *C4Provider) Resources() []providers.ResourceDefinition {
+	return []providers.ResourceDefinition{
+		p.personResource.Definition(),
+		p.softwareSystemResource.Definition(),
+		p.containerResource.Definition(),
+		p.componentResource.Definition(),
+		p.systemBoundaryResource.Definition(),
+		p.relationshipResource.Definition(),
+	}
+}
+
+// Validate validates C4 resource parameters
+func (p *C4Provider) Validate(resourceType string, params map[string]interface{}) error {
+	switch resourceType {
+	case "person":
+		return p.personResource.Validate(params)
+	case "software-system":
+		return p.softwareSystemResource.Validate(params)
+	case "container":
+		return p.containerResource.Validate(params)
+	case "component":
+		return p.componentResource.Validate(params)
+	case "system-boundary":
+		return p.systemBoundaryResource.Validate(params)
+	case "relationship":
+		return p.relationshipResource.Validate(params)
+	default:
+		return p.unsupportedResource(resourceType)
+	}
+}
+
+// GenerateTemplate generates C4 resource templates
+func (p *C4Provider) GenerateTemplate(resourceType string, params map[string]interface{}) (*schema.Element, error) {
+	if err := p.Validate(resourceType, params); err != nil {
+		return nil, err
+	}
+
+	switch resourceType {
+	case "person":
+		return p.personTemplate.Generate(params)
+	case "software-system":
+		return p.softwareSystemTemplate.Generate(params)
+	case "container":
+		return p.containerTemplate.Generate(params)
+	case "component":
+		return p.componentTemplate.Generate(params)
+	case "system-boundary":
+		return p.systemBoundaryTemplate.Generate(params)
+	case "relationship":
+		return p.relationshipTemplate.Generate(params)
+	default:
+		return nil, p.unsupportedResource(resourceType)
+	}
+}
+
+// GetSchema returns the JSON schema for a resource type
+func (p *C4Provider) GetSchema(resourceType string) (map[string]interface{}, error) {
+	for _, resource := range p.Resources() {
+		if resource.Type == resourceType {
+			return resource.Schema, nil
+		}
+	}
+
+	return nil, &providers.ProviderError{
+		Provider: p.Name(),
+		Resource: resourceType,
+		Message:  fmt.Sprintf("schema not found for resource type: %s", resourceType),
+		Code:     "SCHEMA_NOT_FOUND",
+	}
+}
+
+// GetVersion returns the provider version
+func (p *C4Provider) GetVersion() string {
+	return p.version
+}
+
+// unsupportedResource returns the error for resource types the provider does not support
+func (p *C4Provider) unsupportedResource(resourceType string) error {
+	return &providers.ProviderError{
+		Provider: p.Name(),
+		Resource: resourceType,
+		Message:  fmt.Sprintf("unsupported resource type: %s", resourceType),
+		Code:     "UNSUPPORTED_RESOURCE",
+	}
+}
@@ -0,0 +1,108 @@
+package c4
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+func TestC4Provider_Basic(t *testing.T) {
+	provider := NewC4Provider()
+
+	if provider.Name() != "c4" {
+		t.Errorf("Expected provider name 'c4', got '%s'", provider.Name())
+	}
+
+	if provider.Version() != "dev" {
+		t.Errorf("Expected version 'dev', got '%s'", provider.Version())
+	}
+
+	expected := []string{"person", "software-system", "container", "component", "system-boundary", "relationship"}
+	resources := provider.Resources()
+	if len(resources) != len(expected) {
+		t.Fatalf("Expected %d resources, got %d", len(expected), len(resources))
+	}
+
+	for i, resourceType := range expected {
+		if resources[i].Type != resourceType {
+			t.Errorf("Expected resource %d to be '%s', got '%s'", i, resourceType, resources[i].Type)
+		}
+	}
+}
+
+func TestC4Provider_Conformance(t *testing.T) {
+	providertest.Run(t, NewC4Provider())
+}
+
+func TestC4Provider_Examples(t *testing.T) {
+	provider := NewC4Provider()
+
+	for _, resource := range provider.Resources() {
+		for _, example := range resource.Examples {
+			t.Run(resource.Type+"/"+example.Name, func(t *testing.T) {
+				element, err := provider.GenerateTemplate(resource.Type, example.Config)
+				if err != nil {
+					t.Fatalf("GenerateTemplate() error = %v", err)
+				}
+
+				switch resource.Type {
+				case "relationship":
+					if element.Type != schema.ElementTypeConnector || element.Properties.Source == "" || element.Properties.Target == "" {
+						t.Error("Expected a connector between source and target")
+					}
+				case "system-boundary":
+					if element.Type != schema.ElementTypeGroup || element.Style.StrokeDashArray == "" {
+						t.Error("Expected a dashed group boundary")
+					}
+				default:
+					if !strings.Contains(element.Properties.Label, "<b>"+example.Config["label"].(string)+"</b>") {
+						t.Errorf("Expected the name in bold, got '%s'", element.Properties.Label)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestC4Provider_ContainmentRules(t *testing.T) {
+	provider := NewC4Provider()
+
+	allowedParents := map[string][]string{
+		"person":          nil,
+		"software-system": nil,
+		"container":       {"software-system", "system-boundary"},
+		"component":       {"container"},
+		"system-boundary": nil,
+		"relationship":    nil,
+	}
+
+	for _, resource := range provider.Resources() {
+		want, exists := allowedParents[resource.Type]
+		if !exists {
+			t.Errorf("Unexpected resource %s", resource.Type)
+			continue
+		}
+		if !reflect.DeepEqual(resource.AllowedParents, want) {
+			t.Errorf("Expected %s to be allowed in %v, got %v", resource.Type, want, resource.AllowedParents)
+		}
+	}
+}
+
+func TestC4Provider_UnsupportedResource(t *testing.T) {
+	provider := NewC4Provider()
+
+	if err := provider.Validate("deployment-node", nil); err == nil {
+		t.Error("Expected error for unsupported resource type")
+	}
+
+	if _, err := provider.GenerateTemplate("deployment-node", nil); err == nil {
+		t.Error("Expected error for unsupported resource type")
+	}
+
+	if _, err := provider.GetSchema("deployment-node"); err == nil {
+		t.Error("Expected error for unsupported resource schema")
+	}
+}
@@ -0,0 +1,23 @@
+package resources
+
+// elementProperties returns the schema properties shared by the C4 elements
+func elementProperties(withTechnology bool) map[string]interface{} {
+	properties := map[string]interface{}{
+		"description": map[string]interface{}{
+			"type":        "string",
+			"description": "Short description of the responsibilities",
+		},
+		"external": map[string]interface{}{
+			"type":        "boolean",
+			"description": "Whether the element is outside the scope being modelled",
+			"default":     false,
+		},
+	}
+	if withTechnology {
+		properties["technology"] = map[string]interface{}{
+			"type":        "string",
+			"description": "Implementation technology, e.g. \"Spring Boot\"",
+		}
+	}
+	return properties
+}
@@ -0,0 +1,41 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// ComponentResource defines the C4 Component resource
+type ComponentResource struct{}
+
+// NewComponentResource creates a new Component resource instance
+func NewComponentResource() *ComponentResource {
+	return &ComponentResource{}
+}
+
+// Definition returns the resource definition for Component elements
+func (r *ComponentResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "component",
+		Name:        "Component",
+		Description: "Grouping of related functionality within a container",
+		Category:    "components",
+		Schema:      providers.ResourceSchema("Component", 240, 120, elementProperties(true)),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Component",
+				Description: "Controller within an API application",
+				Config: map[string]interface{}{
+					"label":       "Orders Controller",
+					"technology":  "net/http",
+					"description": "Handles order requests",
+				},
+			},
+		},
+		AllowedParents: []string{"container"},
+	}
+}
+
+// Validate validates Component parameters
+func (r *ComponentResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
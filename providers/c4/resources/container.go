@@ -0,0 +1,62 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// containerTypes are the supported container shapes
+var containerTypes = []string{"default", "database", "queue", "web-browser"}
+
+// ContainerResource defines the C4 Container resource
+type ContainerResource struct{}
+
+// NewContainerResource creates a new Container resource instance
+func NewContainerResource() *ContainerResource {
+	return &ContainerResource{}
+}
+
+// Definition returns the resource definition for Container elements
+func (r *ContainerResource) Definition() providers.ResourceDefinition {
+	properties := elementProperties(true)
+	properties["containerType"] = map[string]interface{}{
+		"type":        "string",
+		"description": "Shape of the container",
+		"enum":        containerTypes,
+		"default":     "default",
+	}
+
+	return providers.ResourceDefinition{
+		Type:        "container",
+		Name:        "Container",
+		Description: "Application or data store within a software system, may contain components",
+		Category:    "containers",
+		Schema:      providers.ResourceSchema("Container", 240, 120, properties),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Container",
+				Description: "Backend application",
+				Config: map[string]interface{}{
+					"label":       "API Application",
+					"technology":  "Go",
+					"description": "Provides the shop functionality via a JSON API",
+				},
+			},
+			{
+				Name:        "Database",
+				Description: "Data store drawn as a cylinder",
+				Config: map[string]interface{}{
+					"label":         "Database",
+					"technology":    "PostgreSQL",
+					"description":   "Stores products and orders",
+					"containerType": "database",
+				},
+			},
+		},
+		AllowedParents: []string{"software-system", "system-boundary"},
+	}
+}
+
+// Validate validates Container parameters
+func (r *ContainerResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
@@ -0,0 +1,22 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestContainerResource_ContainerType(t *testing.T) {
+	resource := NewContainerResource()
+
+	for _, containerType := range containerTypes {
+		if err := resource.Validate(map[string]interface{}{"containerType": containerType, "technology": "PostgreSQL"}); err != nil {
+			t.Errorf("Expected container type %s to be valid, got %v", containerType, err)
+		}
+	}
+
+	err := resource.Validate(map[string]interface{}{"containerType": "mobile-app"})
+	if code := providertest.ValidationCode(err, "containerType"); code != "INVALID_ENUM" {
+		t.Errorf("Expected INVALID_ENUM for container type mobile-app, got %q", code)
+	}
+}
@@ -0,0 +1,39 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// PersonResource defines the C4 Person resource
+type PersonResource struct{}
+
+// NewPersonResource creates a new Person resource instance
+func NewPersonResource() *PersonResource {
+	return &PersonResource{}
+}
+
+// Definition returns the resource definition for Person elements
+func (r *PersonResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "person",
+		Name:        "Person",
+		Description: "User or role interacting with the software systems",
+		Category:    "people",
+		Schema:      providers.ResourceSchema("Person", 200, 180, elementProperties(false)),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Person",
+				Description: "Customer of the system",
+				Config: map[string]interface{}{
+					"label":       "Customer",
+					"description": "A customer placing orders",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates Person parameters
+func (r *PersonResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
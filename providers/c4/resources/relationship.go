@@ -0,0 +1,64 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// RelationshipResource defines the C4 Relationship resource
+type RelationshipResource struct{}
+
+// NewRelationshipResource creates a new Relationship resource instance
+func NewRelationshipResource() *RelationshipResource {
+	return &RelationshipResource{}
+}
+
+// Definition returns the resource definition for Relationship elements
+func (r *RelationshipResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "relationship",
+		Name:        "Relationship",
+		Description: "Labelled dependency between two elements",
+		Category:    "relationships",
+		Schema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"source": map[string]interface{}{
+					"type":        "string",
+					"description": "Source element ID",
+					"minLength":   1,
+				},
+				"target": map[string]interface{}{
+					"type":        "string",
+					"description": "Target element ID",
+					"minLength":   1,
+				},
+				"label": map[string]interface{}{
+					"type":        "string",
+					"description": "What the relationship does, e.g. \"Reads from\"",
+				},
+				"technology": map[string]interface{}{
+					"type":        "string",
+					"description": "Protocol or technology, e.g. \"JSON/HTTPS\"",
+				},
+			},
+			"required": []string{"source", "target"},
+		},
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Relationship",
+				Description: "Synchronous call between containers",
+				Config: map[string]interface{}{
+					"source":     "web-app",
+					"target":     "api",
+					"label":      "Makes API calls to",
+					"technology": "JSON/HTTPS",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates Relationship parameters
+func (r *RelationshipResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
@@ -0,0 +1,25 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestRelationshipResource_Endpoints(t *testing.T) {
+	resource := NewRelationshipResource()
+
+	if err := resource.Validate(map[string]interface{}{"source": "customer", "target": "shop", "label": "Places orders"}); err != nil {
+		t.Errorf("Expected relationship between two elements to be valid, got %v", err)
+	}
+
+	// Both ends of a relationship name an element
+	err := resource.Validate(map[string]interface{}{"source": "customer", "target": ""})
+	if code := providertest.ValidationCode(err, "target"); code != "REQUIRED" {
+		t.Errorf("Expected REQUIRED for an empty target, got %q", code)
+	}
+	err = resource.Validate(map[string]interface{}{"target": "shop"})
+	if code := providertest.ValidationCode(err, "source"); code != "REQUIRED" {
+		t.Errorf("Expected REQUIRED for a missing source, got %q", code)
+	}
+}
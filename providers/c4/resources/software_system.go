@@ -0,0 +1,48 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// SoftwareSystemResource defines the C4 Software System resource
+type SoftwareSystemResource struct{}
+
+// NewSoftwareSystemResource creates a new Software System resource instance
+func NewSoftwareSystemResource() *SoftwareSystemResource {
+	return &SoftwareSystemResource{}
+}
+
+// Definition returns the resource definition for Software System elements
+func (r *SoftwareSystemResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "software-system",
+		Name:        "Software System",
+		Description: "Software system delivering value to its users, may contain containers",
+		Category:    "systems",
+		Schema:      providers.ResourceSchema("Software System", 240, 120, elementProperties(false)),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Software System",
+				Description: "System in scope",
+				Config: map[string]interface{}{
+					"label":       "Online Shop",
+					"description": "Allows customers to browse and order products",
+				},
+			},
+			{
+				Name:        "External Software System",
+				Description: "System outside the scope being modelled",
+				Config: map[string]interface{}{
+					"label":       "Payment Provider",
+					"description": "Processes card payments",
+					"external":    true,
+				},
+			},
+		},
+	}
+}
+
+// Validate validates Software System parameters
+func (r *SoftwareSystemResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
@@ -0,0 +1,38 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// SystemBoundaryResource defines the C4 System Boundary resource
+type SystemBoundaryResource struct{}
+
+// NewSystemBoundaryResource creates a new System Boundary resource instance
+func NewSystemBoundaryResource() *SystemBoundaryResource {
+	return &SystemBoundaryResource{}
+}
+
+// Definition returns the resource definition for System Boundary elements
+func (r *SystemBoundaryResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "system-boundary",
+		Name:        "System Boundary",
+		Description: "Dashed boundary around the containers of a software system",
+		Category:    "boundaries",
+		Schema:      providers.ResourceSchema("System Boundary", 400, 300, map[string]interface{}{}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "System Boundary",
+				Description: "Boundary of the system in scope",
+				Config: map[string]interface{}{
+					"label": "Online Shop",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates System Boundary parameters
+func (r *SystemBoundaryResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
@@ -0,0 +1,156 @@
+package templates
+
+import (
+	"fmt"
+	"html"
+
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// C4 model colors
+const (
+	colorElementFont  = "#FFFFFF"
+	colorBoundary     = "#666666"
+	colorBoundaryFont = "#333333"
+	colorRelationship = "#828282"
+	colorRelationFont = "#404040"
+)
+
+// palette is the fill and stroke color of a C4 element
+type palette struct {
+	fill   string
+	stroke string
+}
+
+// elementSpec describes the appearance of a C4 person, system, container or component
+type elementSpec struct {
+	label    string // Default element name
+	kind     string // C4 element type shown in brackets, e.g. "Container"
+	shape    string // draw.io shape, empty for a rounded rectangle
+	custom   map[string]string
+	width    float64
+	height   float64
+	internal palette // Colors of elements in scope
+	external palette // Colors of elements with external: true
+	font     string  // Font color, white when empty
+	nestable bool    // Whether the element can hold the next level of the C4 hierarchy
+}
+
+// newElement creates a C4 element with the standard label layout of name, type and
+// technology, and description
+func newElement(params map[string]interface{}, spec elementSpec) *schema.Element {
+	font := spec.font
+	if font == "" {
+		font = colorElementFont
+	}
+
+	colors := spec.internal
+	if getBoolParam(params, "external", false) {
+		colors = spec.external
+	}
+
+	kind := spec.kind
+	if technology := getStringParam(params, "technology", ""); technology != "" {
+		kind = fmt.Sprintf("%s: %s", kind, technology)
+	}
+
+	custom := map[string]string{
+		"html":                 "1",
+		"whiteSpace":           "wrap",
+		"labelBackgroundColor": "none",
+		"metaEdit":             "1",
+	}
+	if spec.shape == "" {
+		custom["arcSize"] = "10"
+	}
+	for key, value := range spec.custom {
+		custom[key] = value
+	}
+
+	element := &schema.Element{
+		Type: schema.ElementTypeShape,
+		Properties: schema.ElementProperties{
+			X:      getFloatParam(params, "x", 0),
+			Y:      getFloatParam(params, "y", 0),
+			Width:  getFloatParam(params, "width", spec.width),
+			Height: getFloatParam(params, "height", spec.height),
+			Label:  formatLabel(getStringParam(params, "label", spec.label), kind, getStringParam(params, "description", "")),
+			Shape:  spec.shape,
+		},
+		Style: schema.Style{
+			FillColor:     getStringParam(params, "fillColor", colors.fill),
+			StrokeColor:   getStringParam(params, "strokeColor", colors.stroke),
+			FontColor:     getStringParam(params, "fontColor", font),
+			FontSize:      11,
+			TextAlign:     "center",
+			VerticalAlign: "middle",
+			Rounded:       spec.shape == "",
+			Custom:        custom,
+		},
+	}
+
+	if spec.nestable {
+		// Containers inside systems and components inside containers are laid out below
+		// the label, and the element grows to fit them
+		element.Style.VerticalAlign = "top"
+		element.Style.Custom["container"] = "1"
+		element.Style.Custom["collapsible"] = "0"
+		element.Style.Custom["spacingTop"] = "10"
+		element.Nesting = schema.NestingConfig{
+			Mode:        schema.NestingModeChild,
+			AutoResize:  true,
+			Arrangement: schema.ArrangementHorizontal,
+			Spacing:     40,
+			Padding: schema.Padding{
+				Top:    90,
+				Right:  20,
+				Bottom: 20,
+				Left:   20,
+			},
+		}
+	}
+
+	return element
+}
+
+// formatLabel renders the C4 label layout: the name in bold, the type in brackets and
+// the description below
+func formatLabel(name, kind, description string) string {
+	label := fmt.Sprintf(`<font style="font-size: 16px"><b>%s</b></font><div>[%s]</div>`, html.EscapeString(name), html.EscapeString(kind))
+	if description != "" {
+		label += fmt.Sprintf(`<br><div><font style="font-size: 11px">%s</font></div>`, html.EscapeString(description))
+	}
+	return label
+}
+
+func getStringParam(params map[string]interface{}, key, defaultValue string) string {
+	if val, ok := params[key]; ok {
+		if str, ok := val.(string); ok {
+			return str
+		}
+	}
+	return defaultValue
+}
+
+func getFloatParam(params map[string]interface{}, key string, defaultValue float64) float64 {
+	if val, ok := params[key]; ok {
+		switch v := val.(type) {
+		case float64:
+			return v
+		case int:
+			return float64(v)
+		case int64:
+			return float64(v)
+		}
+	}
+	return defaultValue
+}
+
+func getBoolParam(params map[string]interface{}, key string, defaultValue bool) bool {
+	if val, ok := params[key]; ok {
+		if b, ok := val.(bool); ok {
+			return b
+		}
+	}
+	return defaultValue
+}
@@ -0,0 +1,56 @@
+package templates
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+func TestFormatLabel(t *testing.T) {
+	label := formatLabel("Orders <API>", "Container: Go", "Handles orders")
+
+	want := `<font style="font-size: 16px"><b>Orders &lt;API&gt;</b></font><div>[Container: Go]</div><br><div><font style="font-size: 11px">Handles orders</font></div>`
+	if label != want {
+		t.Errorf("Expected label %q, got %q", want, label)
+	}
+
+	if got := formatLabel("Customer", "Person", ""); got != `<font style="font-size: 16px"><b>Customer</b></font><div>[Person]</div>` {
+		t.Errorf("Expected label without description, got %q", got)
+	}
+}
+
+func TestNewElement_External(t *testing.T) {
+	spec := elementSpec{
+		label:    "Software System",
+		kind:     "Software System",
+		width:    240,
+		height:   120,
+		internal: palette{fill: "#1168BD", stroke: "#0B4884"},
+		external: palette{fill: "#999999", stroke: "#8A8A8A"},
+	}
+
+	internal := newElement(map[string]interface{}{}, spec)
+	if internal.Style.FillColor != "#1168BD" || !internal.Style.Rounded {
+		t.Errorf("Expected rounded blue system, got %s", internal.Style.FillColor)
+	}
+
+	external := newElement(map[string]interface{}{"external": true}, spec)
+	if external.Style.FillColor != "#999999" || external.Style.StrokeColor != "#8A8A8A" {
+		t.Errorf("Expected grey external system, got %s/%s", external.Style.FillColor, external.Style.StrokeColor)
+	}
+}
+
+func TestContainerTemplate(t *testing.T) {
+	container, _ := NewContainerTemplate().Generate(map[string]interface{}{"label": "API", "technology": "Go"})
+	if container.Nesting.Mode != schema.NestingModeChild || !container.Nesting.AutoResize {
+		t.Errorf("Expected container to lay out its components, got %+v", container.Nesting)
+	}
+	if container.Style.Custom["container"] != "1" {
+		t.Error("Expected container style")
+	}
+
+	database, _ := NewContainerTemplate().Generate(map[string]interface{}{"containerType": "database"})
+	if database.Properties.Shape != "cylinder3" || database.Nesting.AutoResize {
+		t.Errorf("Expected cylinder database without nesting, got shape '%s'", database.Properties.Shape)
+	}
+}
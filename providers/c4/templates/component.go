@@ -0,0 +1,26 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// ComponentTemplate handles template generation for Component elements
+type ComponentTemplate struct{}
+
+// NewComponentTemplate creates a new Component template generator
+func NewComponentTemplate() *ComponentTemplate {
+	return &ComponentTemplate{}
+}
+
+// Generate creates a schema.Element from Component parameters
+func (t *ComponentTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	return newElement(params, elementSpec{
+		label:    "Component",
+		kind:     "Component",
+		width:    240,
+		height:   120,
+		internal: palette{fill: "#85BBF0", stroke: "#78A8D8"},
+		external: palette{fill: "#CCCCCC", stroke: "#BFBFBF"},
+		font:     "#000000",
+	}), nil
+}
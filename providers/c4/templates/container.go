@@ -0,0 +1,43 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// ContainerTemplate handles template generation for Container elements
+type ContainerTemplate struct{}
+
+// NewContainerTemplate creates a new Container template generator
+func NewContainerTemplate() *ContainerTemplate {
+	return &ContainerTemplate{}
+}
+
+// Generate creates a schema.Element from Container parameters
+func (t *ContainerTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	spec := elementSpec{
+		label:    "Container",
+		kind:     "Container",
+		width:    240,
+		height:   120,
+		internal: palette{fill: "#438DD5", stroke: "#3C7FC0"},
+		external: palette{fill: "#B3B3B3", stroke: "#A6A6A6"},
+		nestable: true,
+	}
+
+	switch getStringParam(params, "containerType", "default") {
+	case "database":
+		spec.shape = "cylinder3"
+		spec.custom = map[string]string{"size": "15", "boundedLbl": "1"}
+		spec.nestable = false
+	case "queue":
+		spec.shape = "cylinder3"
+		spec.custom = map[string]string{"size": "15", "boundedLbl": "1", "direction": "south"}
+		spec.nestable = false
+	case "web-browser":
+		spec.shape = "mxgraph.c4.webBrowserContainer2"
+		spec.height = 160
+		spec.nestable = false
+	}
+
+	return newElement(params, spec), nil
+}
@@ -0,0 +1,26 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// PersonTemplate handles template generation for Person elements
+type PersonTemplate struct{}
+
+// NewPersonTemplate creates a new Person template generator
+func NewPersonTemplate() *PersonTemplate {
+	return &PersonTemplate{}
+}
+
+// Generate creates a schema.Element from Person parameters
+func (t *PersonTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	return newElement(params, elementSpec{
+		label:    "Person",
+		kind:     "Person",
+		shape:    "mxgraph.c4.person2",
+		width:    200,
+		height:   180,
+		internal: palette{fill: "#08427B", stroke: "#073B6F"},
+		external: palette{fill: "#686868", stroke: "#4D4D4D"},
+	}), nil
+}
@@ -0,0 +1,49 @@
+package templates
+
+import (
+	"fmt"
+	"html"
+
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// RelationshipTemplate handles template generation for Relationship elements
+type RelationshipTemplate struct{}
+
+// NewRelationshipTemplate creates a new Relationship template generator
+func NewRelationshipTemplate() *RelationshipTemplate {
+	return &RelationshipTemplate{}
+}
+
+// Generate creates a schema.Element from Relationship parameters
+func (t *RelationshipTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	label := ""
+	if description := getStringParam(params, "label", ""); description != "" {
+		label = fmt.Sprintf("<b>%s</b>", html.EscapeString(description))
+	}
+	if technology := getStringParam(params, "technology", ""); technology != "" {
+		label += fmt.Sprintf("<div>[%s]</div>", html.EscapeString(technology))
+	}
+
+	return &schema.Element{
+		Type: schema.ElementTypeConnector,
+		Properties: schema.ElementProperties{
+			Source: getStringParam(params, "source", ""),
+			Target: getStringParam(params, "target", ""),
+			Label:  label,
+		},
+		Style: schema.Style{
+			StrokeColor: getStringParam(params, "strokeColor", colorRelationship),
+			StrokeWidth: 1,
+			FontColor:   colorRelationFont,
+			FontSize:    11,
+			Custom: map[string]string{
+				"endArrow":  "blockThin",
+				"endFill":   "1",
+				"endSize":   "14",
+				"metaEdit":  "1",
+				"jumpStyle": "arc",
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,26 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// SoftwareSystemTemplate handles template generation for Software System elements
+type SoftwareSystemTemplate struct{}
+
+// NewSoftwareSystemTemplate creates a new Software System template generator
+func NewSoftwareSystemTemplate() *SoftwareSystemTemplate {
+	return &SoftwareSystemTemplate{}
+}
+
+// Generate creates a schema.Element from Software System parameters
+func (t *SoftwareSystemTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	return newElement(params, elementSpec{
+		label:    "Software System",
+		kind:     "Software System",
+		width:    240,
+		height:   120,
+		internal: palette{fill: "#1168BD", stroke: "#0B4884"},
+		external: palette{fill: "#999999", stroke: "#8A8A8A"},
+		nestable: true,
+	}), nil
+}
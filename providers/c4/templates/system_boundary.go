@@ -0,0 +1,66 @@
+package templates
+
+import (
+	"fmt"
+	"html"
+
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// SystemBoundaryTemplate handles template generation for System Boundary elements
+type SystemBoundaryTemplate struct{}
+
+// NewSystemBoundaryTemplate creates a new System Boundary template generator
+func NewSystemBoundaryTemplate() *SystemBoundaryTemplate {
+	return &SystemBoundaryTemplate{}
+}
+
+// Generate creates a schema.Element from System Boundary parameters
+func (t *SystemBoundaryTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	name := getStringParam(params, "label", "System Boundary")
+
+	return &schema.Element{
+		Type: schema.ElementTypeGroup,
+		Properties: schema.ElementProperties{
+			X:      getFloatParam(params, "x", 0),
+			Y:      getFloatParam(params, "y", 0),
+			Width:  getFloatParam(params, "width", 400),
+			Height: getFloatParam(params, "height", 300),
+			Label:  fmt.Sprintf("<b>%s</b><div>[Software System]</div>", html.EscapeString(name)),
+		},
+		Style: schema.Style{
+			FillColor:       getStringParam(params, "fillColor", "none"),
+			StrokeColor:     getStringParam(params, "strokeColor", colorBoundary),
+			FontColor:       getStringParam(params, "fontColor", colorBoundaryFont),
+			FontSize:        11,
+			TextAlign:       "left",
+			VerticalAlign:   "bottom",
+			StrokeDashArray: "8 4",
+			Rounded:         true,
+			Custom: map[string]string{
+				"html":                 "1",
+				"whiteSpace":           "wrap",
+				"container":            "1",
+				"collapsible":          "0",
+				"arcSize":              "20",
+				"absoluteArcSize":      "1",
+				"labelBackgroundColor": "none",
+				"spacing":              "10",
+				"metaEdit":             "1",
+			},
+		},
+		// The name sits in the bottom left corner, so children are laid out from the top
+		Nesting: schema.NestingConfig{
+			Mode:        schema.NestingModeChild,
+			AutoResize:  true,
+			Arrangement: schema.ArrangementHorizontal,
+			Spacing:     40,
+			Padding: schema.Padding{
+				Top:    20,
+				Right:  20,
+				Bottom: 60,
+				Left:   20,
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,70 @@
+package templates
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+func TestTemplates_Generate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template interface {
+			Generate(map[string]interface{}) (*schema.Element, error)
+		}
+		params map[string]interface{}
+		shape  string
+		fill   string
+		kind   string // Element kind in brackets below the name
+	}{
+		{name: "person", template: NewPersonTemplate(), params: map[string]interface{}{"label": "Customer"}, shape: "mxgraph.c4.person2", fill: "#08427B", kind: "[Person]"},
+		{name: "external person", template: NewPersonTemplate(), params: map[string]interface{}{"external": true}, shape: "mxgraph.c4.person2", fill: "#686868", kind: "[Person]"},
+		{name: "software system", template: NewSoftwareSystemTemplate(), params: map[string]interface{}{}, fill: "#1168BD", kind: "[Software System]"},
+		{name: "queue container", template: NewContainerTemplate(), params: map[string]interface{}{"containerType": "queue", "technology": "Kafka"}, shape: "cylinder3", fill: "#438DD5", kind: "[Container: Kafka]"},
+		{name: "web browser container", template: NewContainerTemplate(), params: map[string]interface{}{"containerType": "web-browser"}, shape: "mxgraph.c4.webBrowserContainer2", fill: "#438DD5", kind: "[Container]"},
+		{name: "component", template: NewComponentTemplate(), params: map[string]interface{}{"technology": "Spring Bean"}, fill: "#85BBF0", kind: "[Component: Spring Bean]"},
+		{name: "system boundary", template: NewSystemBoundaryTemplate(), params: map[string]interface{}{"label": "Shop"}, fill: "none", kind: "[Software System]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			element, err := tt.template.Generate(tt.params)
+			if err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+
+			if element.Properties.Shape != tt.shape {
+				t.Errorf("Expected shape '%s', got '%s'", tt.shape, element.Properties.Shape)
+			}
+			if element.Style.FillColor != tt.fill {
+				t.Errorf("Expected fill color %s, got %s", tt.fill, element.Style.FillColor)
+			}
+			if !strings.Contains(element.Properties.Label, tt.kind) {
+				t.Errorf("Expected label with %s, got '%s'", tt.kind, element.Properties.Label)
+			}
+		})
+	}
+}
+
+func TestRelationshipTemplate(t *testing.T) {
+	element, err := NewRelationshipTemplate().Generate(map[string]interface{}{
+		"source":     "customer",
+		"target":     "shop",
+		"label":      "Places <orders>",
+		"technology": "HTTPS",
+	})
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	if element.Type != schema.ElementTypeConnector || element.Properties.Source != "customer" || element.Properties.Target != "shop" {
+		t.Errorf("Expected connector from customer to shop, got %s %s -> %s", element.Type, element.Properties.Source, element.Properties.Target)
+	}
+	if want := "<b>Places &lt;orders&gt;</b><div>[HTTPS]</div>"; element.Properties.Label != want {
+		t.Errorf("Expected label %q, got %q", want, element.Properties.Label)
+	}
+	if element.Style.Custom["endArrow"] != "blockThin" {
+		t.Errorf("Expected thin block arrow, got %s", element.Style.Custom["endArrow"])
+	}
+}
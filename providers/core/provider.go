@@ -18,12 +18,14 @@ type CoreProvider struct {
 	textResource      *resources.TextResource
 	groupResource     *resources.GroupResource
 	swimlaneResource  *resources.SwimlaneResource
+	threeTierResource *resources.ThreeTierAppResource
 	// Template instances
 	shapeTemplate     *templates.ShapeTemplate
 	connectorTemplate *templates.ConnectorTemplate
 	textTemplate      *templates.TextTemplate
 	groupTemplate     *templates.GroupTemplate
 	swimlaneTemplate  *templates.SwimlaneTemplate
+	threeTierTemplate *templates.ThreeTierAppTemplate
 }
 
 // NewCoreProvider creates a new core provider instance
@@ -40,11 +42,13 @@ func NewCoreProviderWithVersion(version string) *CoreProvider {
 		textResource:      resources.NewTextResource(),
 		groupResource:     resources.NewGroupResource(),
 		swimlaneResource:  resources.NewSwimlaneResource(),
+		threeTierResource: resources.NewThreeTierAppResource(),
 		shapeTemplate:     templates.NewShapeTemplate(),
 		connectorTemplate: templates.NewConnectorTemplate(),
 		textTemplate:      templates.NewTextTemplate(),
 		groupTemplate:     templates.NewGroupTemplate(),
 		swimlaneTemplate:  templates.NewSwimlaneTemplate(),
+		threeTierTemplate: templates.NewThreeTierAppTemplate(),
 	}
 }
 
@@ -66,6 +70,7 @@ func (p *CoreProvider) Resources() []providers.ResourceDefinition {
 		p.textResource.Definition(),
 		p.groupResource.Definition(),
 		p.swimlaneResource.Definition(),
+		p.threeTierResource.Definition(),
 	}
 }
 
@@ -82,6 +87,8 @@ func (p *CoreProvider) Validate(resourceType string, params map[string]interface
 		return p.groupResource.Validate(params)
 	case "swimlane":
 		return p.swimlaneResource.Validate(params)
+	case "three-tier-app":
+		return p.threeTierResource.Validate(params)
 	default:
 		return &providers.ProviderError{
 			Provider: p.Name(),
@@ -109,6 +116,8 @@ func (p *CoreProvider) GenerateTemplate(resourceType string, params map[string]i
 		return p.groupTemplate.Generate(params)
 	case "swimlane":
 		return p.swimlaneTemplate.Generate(params)
+	case "three-tier-app":
+		return p.threeTierTemplate.Generate(params)
 	default:
 		return nil, &providers.ProviderError{
 			Provider: p.Name(),
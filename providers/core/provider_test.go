@@ -2,6 +2,8 @@ package core
 
 import (
 	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
 )
 
 func TestCoreProvider_Basic(t *testing.T) {
@@ -42,6 +44,10 @@ func TestCoreProvider_Basic(t *testing.T) {
 	}
 }
 
+func TestCoreProvider_Conformance(t *testing.T) {
+	providertest.Run(t, NewCoreProvider())
+}
+
 func TestCoreProvider_ShapeValidation(t *testing.T) {
 	provider := NewCoreProvider()
 
@@ -25,10 +25,12 @@ func (r *ConnectorResource) Definition() providers.ResourceDefinition {
 				"source": map[string]interface{}{
 					"type":        "string",
 					"description": "Source element ID",
+					"minLength":   1,
 				},
 				"target": map[string]interface{}{
 					"type":        "string",
 					"description": "Target element ID",
+					"minLength":   1,
 				},
 				"sourcePort": map[string]interface{}{
 					"type":        "string",
@@ -100,111 +102,7 @@ func (r *ConnectorResource) Definition() providers.ResourceDefinition {
 	}
 }
 
-// Validate validates connector parameters
+// Validate validates connector parameters against the schema of the resource definition
 func (r *ConnectorResource) Validate(params map[string]interface{}) error {
-	// Check required parameters
-	if source, ok := params["source"]; !ok || source == "" {
-		return &providers.ValidationError{
-			Field:   "source",
-			Message: "source element ID is required",
-		}
-	}
-
-	if target, ok := params["target"]; !ok || target == "" {
-		return &providers.ValidationError{
-			Field:   "target",
-			Message: "target element ID is required",
-		}
-	}
-
-	// Validate port values if provided
-	validPorts := []string{"top", "right", "bottom", "left", "center"}
-
-	if sourcePort, ok := params["sourcePort"]; ok {
-		if port, ok := sourcePort.(string); ok {
-			valid := false
-			for _, validPort := range validPorts {
-				if port == validPort {
-					valid = true
-					break
-				}
-			}
-			if !valid {
-				return &providers.ValidationError{
-					Field:   "sourcePort",
-					Message: "invalid source port value",
-				}
-			}
-		}
-	}
-
-	if targetPort, ok := params["targetPort"]; ok {
-		if port, ok := targetPort.(string); ok {
-			valid := false
-			for _, validPort := range validPorts {
-				if port == validPort {
-					valid = true
-					break
-				}
-			}
-			if !valid {
-				return &providers.ValidationError{
-					Field:   "targetPort",
-					Message: "invalid target port value",
-				}
-			}
-		}
-	}
-
-	// Validate stroke style if provided
-	if strokeStyle, ok := params["strokeStyle"]; ok {
-		validStyles := []string{"solid", "dashed", "dotted"}
-		if style, ok := strokeStyle.(string); ok {
-			valid := false
-			for _, validStyle := range validStyles {
-				if style == validStyle {
-					valid = true
-					break
-				}
-			}
-			if !valid {
-				return &providers.ValidationError{
-					Field:   "strokeStyle",
-					Message: "invalid stroke style",
-				}
-			}
-		}
-	}
-
-	// Validate arrow style if provided
-	if arrow, ok := params["arrow"]; ok {
-		validArrows := []string{"none", "source", "target", "both"}
-		if arrowStr, ok := arrow.(string); ok {
-			valid := false
-			for _, validArrow := range validArrows {
-				if arrowStr == validArrow {
-					valid = true
-					break
-				}
-			}
-			if !valid {
-				return &providers.ValidationError{
-					Field:   "arrow",
-					Message: "invalid arrow style",
-				}
-			}
-		}
-	}
-
-	// Validate stroke width if provided
-	if strokeWidth, ok := params["strokeWidth"]; ok {
-		if w, ok := strokeWidth.(float64); ok && w < 1 {
-			return &providers.ValidationError{
-				Field:   "strokeWidth",
-				Message: "stroke width must be at least 1",
-			}
-		}
-	}
-
-	return nil
+	return providers.ValidateParams(r.Definition().Schema, params)
 }
@@ -1,6 +1,7 @@
 package resources
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/LederWorks/hippodamus/pkg/providers"
@@ -204,7 +205,8 @@ func TestConnectorResource_Validate(t *testing.T) {
 					return
 				}
 
-				if validationErr, ok := err.(*providers.ValidationError); ok {
+				var validationErr *providers.ValidationError
+				if errors.As(err, &validationErr) {
 					if validationErr.Field != tt.errorField {
 						t.Errorf("Expected error field %s, got %s", tt.errorField, validationErr.Field)
 					}
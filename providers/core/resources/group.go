@@ -141,86 +141,7 @@ func (r *GroupResource) Definition() providers.ResourceDefinition {
 	}
 }
 
-// Validate validates group parameters
+// Validate validates group parameters against the schema of the resource definition
 func (r *GroupResource) Validate(params map[string]interface{}) error {
-	// Validate stroke style if provided
-	if strokeStyle, ok := params["strokeStyle"]; ok {
-		validStyles := []string{"solid", "dashed", "dotted"}
-		if style, ok := strokeStyle.(string); ok {
-			valid := false
-			for _, validStyle := range validStyles {
-				if style == validStyle {
-					valid = true
-					break
-				}
-			}
-			if !valid {
-				return &providers.ValidationError{
-					Field:   "strokeStyle",
-					Message: "invalid stroke style",
-				}
-			}
-		}
-	}
-
-	// Validate font style if provided
-	if fontStyle, ok := params["fontStyle"]; ok {
-		validStyles := []string{"normal", "bold", "italic", "bold italic"}
-		if style, ok := fontStyle.(string); ok {
-			valid := false
-			for _, validStyle := range validStyles {
-				if style == validStyle {
-					valid = true
-					break
-				}
-			}
-			if !valid {
-				return &providers.ValidationError{
-					Field:   "fontStyle",
-					Message: "invalid font style",
-				}
-			}
-		}
-	}
-
-	// Validate dimensions if provided
-	if width, ok := params["width"]; ok {
-		if w, ok := width.(float64); ok && w < 50 {
-			return &providers.ValidationError{
-				Field:   "width",
-				Message: "width must be at least 50",
-			}
-		}
-	}
-
-	if height, ok := params["height"]; ok {
-		if h, ok := height.(float64); ok && h < 50 {
-			return &providers.ValidationError{
-				Field:   "height",
-				Message: "height must be at least 50",
-			}
-		}
-	}
-
-	// Validate font size if provided
-	if fontSize, ok := params["fontSize"]; ok {
-		if fs, ok := fontSize.(float64); ok && (fs < 6 || fs > 72) {
-			return &providers.ValidationError{
-				Field:   "fontSize",
-				Message: "font size must be between 6 and 72",
-			}
-		}
-	}
-
-	// Validate stroke width if provided
-	if strokeWidth, ok := params["strokeWidth"]; ok {
-		if sw, ok := strokeWidth.(float64); ok && sw < 0 {
-			return &providers.ValidationError{
-				Field:   "strokeWidth",
-				Message: "stroke width cannot be negative",
-			}
-		}
-	}
-
-	return nil
+	return providers.ValidateParams(r.Definition().Schema, params)
 }
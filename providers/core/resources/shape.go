@@ -26,6 +26,7 @@ func (r *ShapeResource) Definition() providers.ResourceDefinition {
 					"type":        "string",
 					"description": "Text label for the shape",
 					"default":     "Shape Element",
+					"minLength":   1,
 				},
 				"shape": map[string]interface{}{
 					"type":        "string",
@@ -122,60 +123,7 @@ func (r *ShapeResource) Definition() providers.ResourceDefinition {
 	}
 }
 
-// Validate validates shape parameters
+// Validate validates shape parameters against the schema of the resource definition
 func (r *ShapeResource) Validate(params map[string]interface{}) error {
-	// Check required parameters
-	if label, ok := params["label"]; !ok || label == "" {
-		return &providers.ValidationError{
-			Field:   "label",
-			Message: "label is required",
-		}
-	}
-
-	// Validate shape type if provided
-	if shape, ok := params["shape"]; ok {
-		validShapes := []string{"rectangle", "ellipse", "triangle", "diamond", "hexagon", "cloud", "cylinder"}
-		shapeStr, ok := shape.(string)
-		if !ok {
-			return &providers.ValidationError{
-				Field:   "shape",
-				Message: "shape must be a string",
-			}
-		}
-
-		valid := false
-		for _, validShape := range validShapes {
-			if shapeStr == validShape {
-				valid = true
-				break
-			}
-		}
-		if !valid {
-			return &providers.ValidationError{
-				Field:   "shape",
-				Message: "invalid shape type",
-			}
-		}
-	}
-
-	// Validate dimensions if provided
-	if width, ok := params["width"]; ok {
-		if w, ok := width.(float64); ok && w < 10 {
-			return &providers.ValidationError{
-				Field:   "width",
-				Message: "width must be at least 10",
-			}
-		}
-	}
-
-	if height, ok := params["height"]; ok {
-		if h, ok := height.(float64); ok && h < 10 {
-			return &providers.ValidationError{
-				Field:   "height",
-				Message: "height must be at least 10",
-			}
-		}
-	}
-
-	return nil
+	return providers.ValidateParams(r.Definition().Schema, params)
 }
@@ -1,6 +1,8 @@
 package resources
 
 import (
+	"errors"
+	"strings"
 	"testing"
 
 	"github.com/LederWorks/hippodamus/pkg/providers"
@@ -114,6 +116,33 @@ func TestShapeResource_Validate(t *testing.T) {
 			wantErr: true,
 			errType: "ValidationError",
 		},
+		{
+			name: "integer width too small",
+			params: map[string]interface{}{
+				"label": "Test",
+				"width": 5,
+			},
+			wantErr: true,
+			errType: "ValidationError",
+		},
+		{
+			name: "font size too small",
+			params: map[string]interface{}{
+				"label":    "Test",
+				"fontSize": 6,
+			},
+			wantErr: true,
+			errType: "ValidationError",
+		},
+		{
+			name: "invalid font style",
+			params: map[string]interface{}{
+				"label":     "Test",
+				"fontStyle": "underline",
+			},
+			wantErr: true,
+			errType: "ValidationError",
+		},
 		{
 			name: "valid with all parameters",
 			params: map[string]interface{}{
@@ -146,7 +175,8 @@ func TestShapeResource_Validate(t *testing.T) {
 			if tt.wantErr && tt.errType != "" {
 				switch tt.errType {
 				case "ValidationError":
-					if _, ok := err.(*providers.ValidationError); !ok {
+					var validationErr *providers.ValidationError
+					if !errors.As(err, &validationErr) {
 						t.Errorf("Expected ValidationError, got %T", err)
 					}
 				}
@@ -174,3 +204,25 @@ func TestShapeResource_ValidShapes(t *testing.T) {
 		})
 	}
 }
+
+func TestShapeResource_ValidateReportsAllErrors(t *testing.T) {
+	resource := NewShapeResource()
+
+	err := resource.Validate(map[string]interface{}{
+		"shape":  "star",
+		"height": 5,
+	})
+
+	var validationErrs providers.ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		t.Fatalf("Expected ValidationErrors, got %v", err)
+	}
+
+	var fields []string
+	for _, validationErr := range validationErrs {
+		fields = append(fields, validationErr.Field)
+	}
+	if strings.Join(fields, ",") != "label,height,shape" {
+		t.Errorf("Expected errors for label, height and shape, got %v", fields)
+	}
+}
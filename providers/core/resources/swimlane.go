@@ -150,116 +150,7 @@ func (r *SwimlaneResource) Definition() providers.ResourceDefinition {
 	}
 }
 
-// Validate validates swimlane parameters
+// Validate validates swimlane parameters against the schema of the resource definition
 func (r *SwimlaneResource) Validate(params map[string]interface{}) error {
-	// Validate orientation if provided
-	if orientation, ok := params["orientation"]; ok {
-		validOrientations := []string{"horizontal", "vertical"}
-		if orient, ok := orientation.(string); ok {
-			valid := false
-			for _, validOrient := range validOrientations {
-				if orient == validOrient {
-					valid = true
-					break
-				}
-			}
-			if !valid {
-				return &providers.ValidationError{
-					Field:   "orientation",
-					Message: "invalid orientation",
-				}
-			}
-		}
-	}
-
-	// Validate child layout if provided
-	if childLayout, ok := params["childLayout"]; ok {
-		validLayouts := []string{"stackLayout", "flowLayout", "freeLayout"}
-		if layout, ok := childLayout.(string); ok {
-			valid := false
-			for _, validLayout := range validLayouts {
-				if layout == validLayout {
-					valid = true
-					break
-				}
-			}
-			if !valid {
-				return &providers.ValidationError{
-					Field:   "childLayout",
-					Message: "invalid child layout",
-				}
-			}
-		}
-	}
-
-	// Validate font style if provided
-	if fontStyle, ok := params["fontStyle"]; ok {
-		validStyles := []string{"normal", "bold", "italic", "bold italic"}
-		if style, ok := fontStyle.(string); ok {
-			valid := false
-			for _, validStyle := range validStyles {
-				if style == validStyle {
-					valid = true
-					break
-				}
-			}
-			if !valid {
-				return &providers.ValidationError{
-					Field:   "fontStyle",
-					Message: "invalid font style",
-				}
-			}
-		}
-	}
-
-	// Validate dimensions if provided
-	if width, ok := params["width"]; ok {
-		if w, ok := width.(float64); ok && w < 100 {
-			return &providers.ValidationError{
-				Field:   "width",
-				Message: "width must be at least 100",
-			}
-		}
-	}
-
-	if height, ok := params["height"]; ok {
-		if h, ok := height.(float64); ok && h < 50 {
-			return &providers.ValidationError{
-				Field:   "height",
-				Message: "height must be at least 50",
-			}
-		}
-	}
-
-	// Validate start size if provided
-	if startSize, ok := params["startSize"]; ok {
-		if ss, ok := startSize.(float64); ok && ss < 20 {
-			return &providers.ValidationError{
-				Field:   "startSize",
-				Message: "start size must be at least 20",
-			}
-		}
-	}
-
-	// Validate font size if provided
-	if fontSize, ok := params["fontSize"]; ok {
-		if fs, ok := fontSize.(float64); ok && (fs < 6 || fs > 72) {
-			return &providers.ValidationError{
-				Field:   "fontSize",
-				Message: "font size must be between 6 and 72",
-			}
-		}
-	}
-
-	// Validate stroke width if provided
-	if strokeWidth, ok := params["strokeWidth"]; ok {
-		if sw, ok := strokeWidth.(float64); ok && sw < 0 {
-			return &providers.ValidationError{
-				Field:   "strokeWidth",
-				Message: "stroke width cannot be negative",
-			}
-		}
-	}
-
-	return nil
+	return providers.ValidateParams(r.Definition().Schema, params)
 }
@@ -25,6 +25,7 @@ func (r *TextResource) Definition() providers.ResourceDefinition {
 				"label": map[string]interface{}{
 					"type":        "string",
 					"description": "Text content to display",
+					"minLength":   1,
 				},
 				"x": map[string]interface{}{
 					"type":        "number",
@@ -132,117 +133,12 @@ func (r *TextResource) Definition() providers.ResourceDefinition {
 				},
 			},
 		},
+		// Text is a leaf, it cannot enclose other resources
+		AllowedChildren: []string{},
 	}
 }
 
-// Validate validates text parameters
+// Validate validates text parameters against the schema of the resource definition
 func (r *TextResource) Validate(params map[string]interface{}) error {
-	// Check required parameters
-	if label, ok := params["label"]; !ok || label == "" {
-		return &providers.ValidationError{
-			Field:   "label",
-			Message: "label text is required",
-		}
-	}
-
-	// Validate font style if provided
-	if fontStyle, ok := params["fontStyle"]; ok {
-		validStyles := []string{"normal", "bold", "italic", "bold italic"}
-		if style, ok := fontStyle.(string); ok {
-			valid := false
-			for _, validStyle := range validStyles {
-				if style == validStyle {
-					valid = true
-					break
-				}
-			}
-			if !valid {
-				return &providers.ValidationError{
-					Field:   "fontStyle",
-					Message: "invalid font style",
-				}
-			}
-		}
-	}
-
-	// Validate text alignment if provided
-	if textAlign, ok := params["textAlign"]; ok {
-		validAligns := []string{"left", "center", "right"}
-		if align, ok := textAlign.(string); ok {
-			valid := false
-			for _, validAlign := range validAligns {
-				if align == validAlign {
-					valid = true
-					break
-				}
-			}
-			if !valid {
-				return &providers.ValidationError{
-					Field:   "textAlign",
-					Message: "invalid text alignment",
-				}
-			}
-		}
-	}
-
-	// Validate vertical alignment if provided
-	if verticalAlign, ok := params["verticalAlign"]; ok {
-		validAligns := []string{"top", "middle", "bottom"}
-		if align, ok := verticalAlign.(string); ok {
-			valid := false
-			for _, validAlign := range validAligns {
-				if align == validAlign {
-					valid = true
-					break
-				}
-			}
-			if !valid {
-				return &providers.ValidationError{
-					Field:   "verticalAlign",
-					Message: "invalid vertical alignment",
-				}
-			}
-		}
-	}
-
-	// Validate dimensions if provided
-	if width, ok := params["width"]; ok {
-		if w, ok := width.(float64); ok && w < 10 {
-			return &providers.ValidationError{
-				Field:   "width",
-				Message: "width must be at least 10",
-			}
-		}
-	}
-
-	if height, ok := params["height"]; ok {
-		if h, ok := height.(float64); ok && h < 10 {
-			return &providers.ValidationError{
-				Field:   "height",
-				Message: "height must be at least 10",
-			}
-		}
-	}
-
-	// Validate font size if provided
-	if fontSize, ok := params["fontSize"]; ok {
-		if fs, ok := fontSize.(float64); ok && (fs < 6 || fs > 72) {
-			return &providers.ValidationError{
-				Field:   "fontSize",
-				Message: "font size must be between 6 and 72",
-			}
-		}
-	}
-
-	// Validate stroke width if provided
-	if strokeWidth, ok := params["strokeWidth"]; ok {
-		if sw, ok := strokeWidth.(float64); ok && sw < 0 {
-			return &providers.ValidationError{
-				Field:   "strokeWidth",
-				Message: "stroke width cannot be negative",
-			}
-		}
-	}
-
-	return nil
+	return providers.ValidateParams(r.Definition().Schema, params)
 }
@@ -1,6 +1,7 @@
 package resources
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/LederWorks/hippodamus/pkg/providers"
@@ -46,6 +47,11 @@ func TestTextResource_Definition(t *testing.T) {
 	if len(def.Examples) != 2 {
 		t.Errorf("Expected 2 examples, got %d", len(def.Examples))
 	}
+
+	// Text cannot contain other resources
+	if def.AllowedChildren == nil || len(def.AllowedChildren) != 0 {
+		t.Errorf("Expected no allowed children, got %#v", def.AllowedChildren)
+	}
 }
 
 func TestTextResource_Validate(t *testing.T) {
@@ -182,7 +188,8 @@ func TestTextResource_Validate(t *testing.T) {
 					return
 				}
 
-				if validationErr, ok := err.(*providers.ValidationError); ok {
+				var validationErr *providers.ValidationError
+				if errors.As(err, &validationErr) {
 					if validationErr.Field != tt.errorField {
 						t.Errorf("Expected error field %s, got %s", tt.errorField, validationErr.Field)
 					}
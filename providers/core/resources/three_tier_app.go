@@ -0,0 +1,96 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// ThreeTierAppResource defines the three-tier application composite resource
+type ThreeTierAppResource struct{}
+
+// NewThreeTierAppResource creates a new three-tier application resource instance
+func NewThreeTierAppResource() *ThreeTierAppResource {
+	return &ThreeTierAppResource{}
+}
+
+// Definition returns the resource definition for three-tier applications
+func (r *ThreeTierAppResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "three-tier-app",
+		Name:        "Three-Tier Application",
+		Description: "Composite of web, app and db tiers connected in sequence. The tiers and their connectors (web-to-app, app-to-db) can be overridden by declaring children with their IDs.",
+		Category:    "composite",
+		Schema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"label": map[string]interface{}{
+					"type":        "string",
+					"description": "Application name",
+					"default":     "Application",
+				},
+				"x": map[string]interface{}{
+					"type":        "number",
+					"description": "X position",
+					"default":     0,
+				},
+				"y": map[string]interface{}{
+					"type":        "number",
+					"description": "Y position",
+					"default":     0,
+				},
+				"webLabel": map[string]interface{}{
+					"type":        "string",
+					"description": "Label of the web tier",
+					"default":     "Web",
+				},
+				"appLabel": map[string]interface{}{
+					"type":        "string",
+					"description": "Label of the app tier",
+					"default":     "App",
+				},
+				"dbLabel": map[string]interface{}{
+					"type":        "string",
+					"description": "Label of the db tier",
+					"default":     "Database",
+				},
+				"webToAppLabel": map[string]interface{}{
+					"type":        "string",
+					"description": "Label of the connector from the web to the app tier",
+					"default":     "HTTPS",
+				},
+				"appToDbLabel": map[string]interface{}{
+					"type":        "string",
+					"description": "Label of the connector from the app to the db tier",
+					"default":     "SQL",
+				},
+				"fillColor": map[string]interface{}{
+					"type":        "string",
+					"description": "Background color of the application",
+					"default":     "#F5F5F5",
+				},
+				"strokeColor": map[string]interface{}{
+					"type":        "string",
+					"description": "Border color of the application",
+					"default":     "#CCCCCC",
+				},
+			},
+			"required": []string{},
+		},
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Web Shop",
+				Description: "Web shop with its storefront, order service and database",
+				Config: map[string]interface{}{
+					"label":    "Web Shop",
+					"webLabel": "Storefront",
+					"appLabel": "Orders",
+					"dbLabel":  "PostgreSQL",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates three-tier application parameters against the schema of the resource definition
+func (r *ThreeTierAppResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
@@ -0,0 +1,36 @@
+package resources
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+func TestThreeTierAppResource_Definition(t *testing.T) {
+	def := NewThreeTierAppResource().Definition()
+
+	if def.Type != "three-tier-app" {
+		t.Errorf("Expected type 'three-tier-app', got %s", def.Type)
+	}
+	if def.Category != "composite" {
+		t.Errorf("Expected category 'composite', got %s", def.Category)
+	}
+	if len(def.Examples) != 1 {
+		t.Errorf("Expected 1 example, got %d", len(def.Examples))
+	}
+}
+
+func TestThreeTierAppResource_Validate(t *testing.T) {
+	resource := NewThreeTierAppResource()
+
+	if err := resource.Validate(map[string]interface{}{"label": "Shop", "dbLabel": "PostgreSQL"}); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+
+	err := resource.Validate(map[string]interface{}{"webLabel": 42})
+	var validationErr *providers.ValidationError
+	if !errors.As(err, &validationErr) || validationErr.Field != "webLabel" {
+		t.Errorf("Expected a webLabel ValidationError, got %v", err)
+	}
+}
@@ -0,0 +1,78 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// ThreeTierAppTemplate handles template generation for three-tier applications
+type ThreeTierAppTemplate struct{}
+
+// NewThreeTierAppTemplate creates a new three-tier application template generator
+func NewThreeTierAppTemplate() *ThreeTierAppTemplate {
+	return &ThreeTierAppTemplate{}
+}
+
+// Generate creates a group with the web, app and db tiers and the connectors between them.
+// The connectors refer to the tiers by their IDs, which resolve within the group.
+func (t *ThreeTierAppTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	tiers := []struct {
+		id, label, shape, fill, stroke string
+	}{
+		{"web", getStringParam(params, "webLabel", "Web"), "rectangle", "#E3F2FD", "#1976D2"},
+		{"app", getStringParam(params, "appLabel", "App"), "rectangle", "#E8F5E9", "#388E3C"},
+		{"db", getStringParam(params, "dbLabel", "Database"), "cylinder", "#FFF3E0", "#F57C00"},
+	}
+	links := []struct {
+		id, source, target, label string
+	}{
+		{"web-to-app", "web", "app", getStringParam(params, "webToAppLabel", "HTTPS")},
+		{"app-to-db", "app", "db", getStringParam(params, "appToDbLabel", "SQL")},
+	}
+
+	var children []schema.Element
+	for _, tier := range tiers {
+		element, err := NewShapeTemplate().Generate(map[string]interface{}{
+			"label":       tier.label,
+			"shape":       tier.shape,
+			"fillColor":   tier.fill,
+			"strokeColor": tier.stroke,
+		})
+		if err != nil {
+			return nil, err
+		}
+		element.ID = tier.id
+		children = append(children, *element)
+	}
+	for _, link := range links {
+		element, err := NewConnectorTemplate().Generate(map[string]interface{}{
+			"source": link.source,
+			"target": link.target,
+			"label":  link.label,
+		})
+		if err != nil {
+			return nil, err
+		}
+		element.ID = link.id
+		children = append(children, *element)
+	}
+
+	return &schema.Element{
+		Type: schema.ElementTypeGroup,
+		Properties: schema.ElementProperties{
+			X:     getFloatParam(params, "x", 0),
+			Y:     getFloatParam(params, "y", 0),
+			Label: getStringParam(params, "label", "Application"),
+		},
+		Style: schema.Style{
+			FillColor:   getStringParam(params, "fillColor", "#F5F5F5"),
+			StrokeColor: getStringParam(params, "strokeColor", "#CCCCCC"),
+			FontStyle:   "bold",
+		},
+		Nesting: schema.NestingConfig{
+			Arrangement: schema.ArrangementHorizontal,
+			Spacing:     60, // Room for the connector labels
+			AutoResize:  true,
+		},
+		Children: children,
+	}, nil
+}
@@ -0,0 +1,52 @@
+package templates
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+func TestThreeTierAppTemplate_Generate(t *testing.T) {
+	element, err := NewThreeTierAppTemplate().Generate(map[string]interface{}{
+		"label":        "Web Shop",
+		"dbLabel":      "PostgreSQL",
+		"appToDbLabel": "JDBC",
+	})
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	if element.Type != schema.ElementTypeGroup || element.Properties.Label != "Web Shop" {
+		t.Errorf("Expected the Web Shop group, got %s %q", element.Type, element.Properties.Label)
+	}
+	if !element.Nesting.AutoResize {
+		t.Error("Expected the group to grow to fit its tiers")
+	}
+
+	expected := []struct {
+		id, label   string
+		elementType schema.ElementType
+	}{
+		{"web", "Web", schema.ElementTypeShape},
+		{"app", "App", schema.ElementTypeShape},
+		{"db", "PostgreSQL", schema.ElementTypeShape},
+		{"web-to-app", "HTTPS", schema.ElementTypeConnector},
+		{"app-to-db", "JDBC", schema.ElementTypeConnector},
+	}
+	if len(element.Children) != len(expected) {
+		t.Fatalf("Expected %d children, got %d", len(expected), len(element.Children))
+	}
+	for i, want := range expected {
+		child := element.Children[i]
+		if child.ID != want.id || child.Properties.Label != want.label || child.Type != want.elementType {
+			t.Errorf("Expected child %d to be %s %s %q, got %s %s %q", i, want.elementType, want.id, want.label, child.Type, child.ID, child.Properties.Label)
+		}
+	}
+
+	if link := element.Children[4]; link.Properties.Source != "app" || link.Properties.Target != "db" {
+		t.Errorf("Expected app-to-db to connect app and db, got %s -> %s", link.Properties.Source, link.Properties.Target)
+	}
+	if db := element.Children[2]; db.Properties.Shape != "cylinder" {
+		t.Errorf("Expected the db tier to be a cylinder, got %s", db.Properties.Shape)
+	}
+}
@@ -0,0 +1,204 @@
+package kubernetes
+
+import (
+	"fmt"
+
+	"github.com/LederWorks/hippodamus/pkg/providers"
+	"github.com/LederWorks/hippodamus/pkg/schema"
+	"github.com/LederWorks/hippodamus/providers/kubernetes/resources"
+	"github.com/LederWorks/hippodamus/providers/kubernetes/templates"
+)
+
+// KubernetesProvider implements the Provider interface for Kubernetes objects
+type KubernetesProvider struct {
+	version string
+	// Resource instances
+	clusterResource     *resources.ClusterResource
+	namespaceResource   *resources.NamespaceResource
+	deploymentResource  *resources.DeploymentResource
+	statefulSetResource *resources.StatefulSetResource
+	daemonSetResource   *resources.DaemonSetResource
+	podResource         *resources.PodResource
+	serviceResource     *resources.ServiceResource
+	ingressResource     *resources.IngressResource
+	configMapResource   *resources.ConfigMapResource
+	secretResource      *resources.SecretResource
+	pvcResource         *resources.PVCResource
+	hpaResource         *resources.HPAResource
+	// Template instances
+	clusterTemplate     *templates.ClusterTemplate
+	namespaceTemplate   *templates.NamespaceTemplate
+	deploymentTemplate  *templates.DeploymentTemplate
+	statefulSetTemplate *templates.StatefulSetTemplate
+	daemonSetTemplate   *templates.DaemonSetTemplate
+	podTemplate         *templates.PodTemplate
+	serviceTemplate     *templates.ServiceTemplate
+	ingressTemplate     *templates.IngressTemplate
+	configMapTemplate   *templates.ConfigMapTemplate
+	secretTemplate      *templates.SecretTemplate
+	pvcTemplate         *templates.PVCTemplate
+	hpaTemplate         *templates.HPATemplate
+}
+
+// NewKubernetesProvider creates a new Kubernetes provider instance
+func NewKubernetesProvider() *KubernetesProvider {
+	return NewKubernetesProviderWithVersion("dev")
+}
+
+// NewKubernetesProviderWithVersion creates a new Kubernetes provider instance with a specific version
+func NewKubernetesProviderWithVersion(version string) *KubernetesProvider {
+	return &KubernetesProvider{
+		version:             version,
+		clusterResource:     resources.NewClusterResource(),
+		namespaceResource:   resources.NewNamespaceResource(),
+		deploymentResource:  resources.NewDeploymentResource(),
+		statefulSetResource: resources.NewStatefulSetResource(),
+		daemonSetResource:   resources.NewDaemonSetResource(),
+		podResource:         resources.NewPodResource(),
+		serviceResource:     resources.NewServiceResource(),
+		ingressResource:     resources.NewIngressResource(),
+		configMapResource:   resources.NewConfigMapResource(),
+		secretResource:      resources.NewSecretResource(),
+		pvcResource:         resources.NewPVCResource(),
+		hpaResource:         resources.NewHPAResource(),
+		clusterTemplate:     templates.NewClusterTemplate(),
+		namespaceTemplate:   templates.NewNamespaceTemplate(),
+		deploymentTemplate:  templates.NewDeploymentTemplate(),
+		statefulSetTemplate: templates.NewStatefulSetTemplate(),
+		daemonSetTemplate:   templates.NewDaemonSetTemplate(),
+		podTemplate:         templates.NewPodTemplate(),
+		serviceTemplate:     templates.NewServiceTemplate(),
+		ingressTemplate:     templates.NewIngressTemplate(),
+		configMapTemplate:   templates.NewConfigMapTemplate(),
+		secretTemplate:      templates.NewSecretTemplate(),
+		pvcTemplate:         templates.NewPVCTemplate(),
+		hpaTemplate:         templates.NewHPATemplate(),
+	}
+}
+
+// Name returns the provider name
+func (p *KubernetesProvider) Name() string {
+	return "kubernetes"
+}
+
+// Version returns the provider version
+func (p *KubernetesProvider) Version() string {
+	return p.version
+}
+
+// Resources returns the list of supported Kubernetes objects
+func (p *KubernetesProvider) Resources() []providers.ResourceDefinition {
+	return []providers.ResourceDefinition{
+		p.clusterResource.Definition(),
+		p.namespaceResource.Definition(),
+		p.deploymentResource.Definition(),
+		p.statefulSetResource.Definition(),
+		p.daemonSetResource.Definition(),
+		p.podResource.Definition(),
+		p.serviceResource.Definition(),
+		p.ingressResource.Definition(),
+		p.configMapResource.Definition(),
+		p.secretResource.Definition(),
+		p.pvcResource.Definition(),
+		p.hpaResource.Definition(),
+	}
+}
+
+// Validate validates Kubernetes object parameters
+func (p *KubernetesProvider) Validate(resourceType string, params map[string]interface{}) error {
+	switch resourceType {
+	case "cluster":
+		return p.clusterResource.Validate(params)
+	case "namespace":
+		return p.namespaceResource.Validate(params)
+	case "deployment":
+		return p.deploymentResource.Validate(params)
+	case "statefulset":
+		return p.statefulSetResource.Validate(params)
+	case "daemonset":
+		return p.daemonSetResource.Validate(params)
+	case "pod":
+		return p.podResource.Validate(params)
+	case "service":
+		return p.serviceResource.Validate(params)
+	case "ingress":
+		return p.ingressResource.Validate(params)
+	case "configmap":
+		return p.configMapResource.Validate(params)
+	case "secret":
+		return p.secretResource.Validate(params)
+	case "pvc":
+		return p.pvcResource.Validate(params)
+	case "hpa":
+		return p.hpaResource.Validate(params)
+	default:
+		return &providers.ProviderError{
+			Provider: p.Name(),
+			Resource: resourceType,
+			Message:  fmt.Sprintf("unsupported resource type: %s", resourceType),
+			Code:     "UNSUPPORTED_RESOURCE",
+		}
+	}
+}
+
+// GenerateTemplate generates Kubernetes object templates
+func (p *KubernetesProvider) GenerateTemplate(resourceType string, params map[string]interface{}) (*schema.Element, error) {
+	if err := p.Validate(resourceType, params); err != nil {
+		return nil, err
+	}
+
+	switch resourceType {
+	case "cluster":
+		return p.clusterTemplate.Generate(params)
+	case "namespace":
+		return p.namespaceTemplate.Generate(params)
+	case "deployment":
+		return p.deploymentTemplate.Generate(params)
+	case "statefulset":
+		return p.statefulSetTemplate.Generate(params)
+	case "daemonset":
+		return p.daemonSetTemplate.Generate(params)
+	case "pod":
+		return p.podTemplate.Generate(params)
+	case "service":
+		return p.serviceTemplate.Generate(params)
+	case "ingress":
+		return p.ingressTemplate.Generate(params)
+	case "configmap":
+		return p.configMapTemplate.Generate(params)
+	case "secret":
+		return p.secretTemplate.Generate(params)
+	case "pvc":
+		return p.pvcTemplate.Generate(params)
+	case "hpa":
+		return p.hpaTemplate.Generate(params)
+	default:
+		return nil, &providers.ProviderError{
+			Provider: p.Name(),
+			Resource: resourceType,
+			Message:  fmt.Sprintf("unsupported resource type: %s", resourceType),
+			Code:     "UNSUPPORTED_RESOURCE",
+		}
+	}
+}
+
+// GetSchema returns the JSON schema for a resource type
+func (p *KubernetesProvider) GetSchema(resourceType string) (map[string]interface{}, error) {
+	for _, resource := range p.Resources() {
+		if resource.Type == resourceType {
+			return resource.Schema, nil
+		}
+	}
+
+	return nil, &providers.ProviderError{
+		Provider: p.Name(),
+		Resource: resourceType,
+		Message:  fmt.Sprintf("schema not found for resource type: %s", resourceType),
+		Code:     "SCHEMA_NOT_FOUND",
+	}
+}
+
+// GetVersion returns the provider version
+func (p *KubernetesProvider) GetVersion() string {
+	return p.version
+}
@@ -0,0 +1,103 @@
+package kubernetes
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers"
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+func TestKubernetesProvider_Basic(t *testing.T) {
+	provider := NewKubernetesProvider()
+
+	if provider.Name() != "kubernetes" {
+		t.Errorf("Expected provider name 'kubernetes', got '%s'", provider.Name())
+	}
+
+	if provider.Version() != "dev" {
+		t.Errorf("Expected version 'dev', got '%s'", provider.Version())
+	}
+
+	expected := []string{"cluster", "namespace", "deployment", "statefulset", "daemonset", "pod", "service", "ingress", "configmap", "secret", "pvc", "hpa"}
+	resources := provider.Resources()
+	if len(resources) != len(expected) {
+		t.Fatalf("Expected %d resources, got %d", len(expected), len(resources))
+	}
+
+	for i, resourceType := range expected {
+		if resources[i].Type != resourceType {
+			t.Errorf("Expected resource %d to be '%s', got '%s'", i, resourceType, resources[i].Type)
+		}
+	}
+}
+
+func TestKubernetesProvider_Conformance(t *testing.T) {
+	providertest.Run(t, NewKubernetesProvider())
+}
+
+func TestKubernetesProvider_Examples(t *testing.T) {
+	provider := NewKubernetesProvider()
+
+	for _, resource := range provider.Resources() {
+		t.Run(resource.Type, func(t *testing.T) {
+			if len(resource.Examples) == 0 {
+				t.Fatal("Expected at least one example")
+			}
+
+			element, err := provider.GenerateTemplate(resource.Type, resource.Examples[0].Config)
+			if err != nil {
+				t.Fatalf("GenerateTemplate() error = %v", err)
+			}
+
+			switch resource.Type {
+			case "cluster", "namespace":
+				if element.Type != schema.ElementTypeGroup || !element.Nesting.AutoResize {
+					t.Error("Expected an auto-resizing group container")
+				}
+			default:
+				if element.Properties.Shape != "mxgraph.kubernetes.icon2" || element.Style.Custom["prIcon"] == "" {
+					t.Errorf("Expected mxgraph.kubernetes icon, got shape '%s'", element.Properties.Shape)
+				}
+			}
+		})
+	}
+}
+
+func TestKubernetesProvider_Validation(t *testing.T) {
+	provider := NewKubernetesProvider()
+
+	err := provider.Validate("service", map[string]interface{}{"serviceType": "Internal"})
+	if err == nil {
+		t.Fatal("Expected invalid service type to cause a validation error")
+	}
+
+	var validationErr *providers.ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("Expected ValidationError, got %T", err)
+	}
+	if validationErr.Field != "serviceType" || validationErr.Code != "INVALID_ENUM" {
+		t.Errorf("Unexpected validation error %+v", validationErr)
+	}
+
+	if _, err := provider.GenerateTemplate("deployment", map[string]interface{}{"replicas": -1}); err == nil {
+		t.Error("Expected GenerateTemplate to validate parameters")
+	}
+}
+
+func TestKubernetesProvider_UnsupportedResource(t *testing.T) {
+	provider := NewKubernetesProvider()
+
+	if err := provider.Validate("job", nil); err == nil {
+		t.Error("Expected error for unsupported resource type")
+	}
+
+	if _, err := provider.GenerateTemplate("job", nil); err == nil {
+		t.Error("Expected error for unsupported resource type")
+	}
+
+	if _, err := provider.GetSchema("job"); err == nil {
+		t.Error("Expected error for unsupported resource schema")
+	}
+}
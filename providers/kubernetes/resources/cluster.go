@@ -0,0 +1,58 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// clusterTypes are the supported cluster distributions
+var clusterTypes = []string{"native", "eks", "aks", "gke", "openshift", "k3s"}
+
+// versionPattern matches Kubernetes versions
+const versionPattern = `^v?[0-9]+\.[0-9]+(\.[0-9]+)?$`
+
+// ClusterResource defines the Kubernetes Cluster resource
+type ClusterResource struct{}
+
+// NewClusterResource creates a new Cluster resource instance
+func NewClusterResource() *ClusterResource {
+	return &ClusterResource{}
+}
+
+// Definition returns the resource definition for Cluster elements
+func (r *ClusterResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "cluster",
+		Name:        "Cluster",
+		Description: "Kubernetes cluster container holding namespaces",
+		Category:    "container",
+		Schema: providers.ResourceSchema("Kubernetes Cluster", 600, 400, map[string]interface{}{
+			"clusterType": map[string]interface{}{
+				"type":        "string",
+				"description": "Cluster distribution",
+				"default":     "native",
+				"enum":        clusterTypes,
+			},
+			"version": map[string]interface{}{
+				"type":        "string",
+				"description": "Kubernetes version",
+				"pattern":     versionPattern,
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Cluster",
+				Description: "Basic Cluster container",
+				Config: map[string]interface{}{
+					"label":       "Production",
+					"clusterType": "eks",
+					"version":     "1.29",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates Cluster parameters
+func (r *ClusterResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
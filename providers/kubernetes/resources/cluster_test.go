@@ -0,0 +1,27 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestClusterResource_Validate(t *testing.T) {
+	resource := NewClusterResource()
+
+	for _, version := range []string{"1.29", "v1.29", "v1.29.3"} {
+		if err := resource.Validate(map[string]interface{}{"clusterType": "gke", "version": version}); err != nil {
+			t.Errorf("Expected version %s to be valid, got %v", version, err)
+		}
+	}
+
+	err := resource.Validate(map[string]interface{}{"version": "latest"})
+	if code := providertest.ValidationCode(err, "version"); code != "INVALID_FORMAT" {
+		t.Errorf("Expected INVALID_FORMAT for version latest, got %q", code)
+	}
+
+	err = resource.Validate(map[string]interface{}{"clusterType": "nomad"})
+	if code := providertest.ValidationCode(err, "clusterType"); code != "INVALID_ENUM" {
+		t.Errorf("Expected INVALID_ENUM for cluster type nomad, got %q", code)
+	}
+}
@@ -0,0 +1,32 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// numberParam returns a numeric parameter as float64
+func numberParam(params map[string]interface{}, field string) (float64, bool) {
+	switch v := params[field].(type) {
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	}
+	return 0, false
+}
+
+// validateReplicaBounds validates that minReplicas does not exceed maxReplicas
+func validateReplicaBounds(params map[string]interface{}) error {
+	minReplicas, hasMin := numberParam(params, "minReplicas")
+	maxReplicas, hasMax := numberParam(params, "maxReplicas")
+	if hasMin && hasMax && minReplicas > maxReplicas {
+		return &providers.ValidationError{
+			Field:   "minReplicas",
+			Message: "minReplicas must not exceed maxReplicas",
+			Code:    "OUT_OF_RANGE",
+		}
+	}
+	return nil
+}
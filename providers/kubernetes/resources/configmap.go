@@ -0,0 +1,38 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// ConfigMapResource defines the Kubernetes ConfigMap resource
+type ConfigMapResource struct{}
+
+// NewConfigMapResource creates a new ConfigMap resource instance
+func NewConfigMapResource() *ConfigMapResource {
+	return &ConfigMapResource{}
+}
+
+// Definition returns the resource definition for ConfigMap elements
+func (r *ConfigMapResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "configmap",
+		Name:        "ConfigMap",
+		Description: "ConfigMap holding non-confidential configuration",
+		Category:    "config",
+		Schema:      providers.ResourceSchema("ConfigMap", 50, 48, map[string]interface{}{}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "ConfigMap",
+				Description: "Basic ConfigMap",
+				Config: map[string]interface{}{
+					"label": "app-config",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates ConfigMap parameters
+func (r *ConfigMapResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
@@ -0,0 +1,44 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// DaemonSetResource defines the Kubernetes DaemonSet resource
+type DaemonSetResource struct{}
+
+// NewDaemonSetResource creates a new DaemonSet resource instance
+func NewDaemonSetResource() *DaemonSetResource {
+	return &DaemonSetResource{}
+}
+
+// Definition returns the resource definition for DaemonSet elements
+func (r *DaemonSetResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "daemonset",
+		Name:        "DaemonSet",
+		Description: "DaemonSet running a pod on every node",
+		Category:    "workload",
+		Schema: providers.ResourceSchema("DaemonSet", 50, 48, map[string]interface{}{
+			"image": map[string]interface{}{
+				"type":        "string",
+				"description": "Container image",
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "DaemonSet",
+				Description: "Basic DaemonSet",
+				Config: map[string]interface{}{
+					"label": "log-agent",
+					"image": "fluent/fluent-bit:3.0",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates DaemonSet parameters
+func (r *DaemonSetResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
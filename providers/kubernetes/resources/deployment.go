@@ -0,0 +1,52 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// DeploymentResource defines the Kubernetes Deployment resource
+type DeploymentResource struct{}
+
+// NewDeploymentResource creates a new Deployment resource instance
+func NewDeploymentResource() *DeploymentResource {
+	return &DeploymentResource{}
+}
+
+// Definition returns the resource definition for Deployment elements
+func (r *DeploymentResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "deployment",
+		Name:        "Deployment",
+		Description: "Deployment managing stateless replicated pods",
+		Category:    "workload",
+		Schema: providers.ResourceSchema("Deployment", 50, 48, map[string]interface{}{
+			"replicas": map[string]interface{}{
+				"type":        "integer",
+				"description": "Number of pod replicas",
+				"default":     1,
+				"minimum":     0,
+				"maximum":     1000,
+			},
+			"image": map[string]interface{}{
+				"type":        "string",
+				"description": "Container image",
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Deployment",
+				Description: "Basic Deployment",
+				Config: map[string]interface{}{
+					"label":    "web",
+					"replicas": 3,
+					"image":    "nginx:1.25",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates Deployment parameters
+func (r *DeploymentResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
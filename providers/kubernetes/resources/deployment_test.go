@@ -0,0 +1,35 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestDeploymentResource_Replicas(t *testing.T) {
+	resource := NewDeploymentResource()
+
+	// Deployments may be scaled to zero
+	if err := resource.Validate(map[string]interface{}{"replicas": 0}); err != nil {
+		t.Errorf("Expected a deployment scaled to zero to be valid, got %v", err)
+	}
+
+	tests := map[string]struct {
+		replicas interface{}
+		code     string
+	}{
+		"negative":   {replicas: -1, code: "OUT_OF_RANGE"},
+		"too many":   {replicas: 1001, code: "OUT_OF_RANGE"},
+		"fractional": {replicas: 1.5, code: "INVALID_TYPE"},
+		"string":     {replicas: "3", code: "INVALID_TYPE"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := resource.Validate(map[string]interface{}{"replicas": tt.replicas})
+			if code := providertest.ValidationCode(err, "replicas"); code != tt.code {
+				t.Errorf("Expected code %s for replicas %v, got %q", tt.code, tt.replicas, code)
+			}
+		})
+	}
+}
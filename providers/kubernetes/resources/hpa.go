@@ -0,0 +1,64 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// HPAResource defines the Kubernetes HorizontalPodAutoscaler resource
+type HPAResource struct{}
+
+// NewHPAResource creates a new HorizontalPodAutoscaler resource instance
+func NewHPAResource() *HPAResource {
+	return &HPAResource{}
+}
+
+// Definition returns the resource definition for HorizontalPodAutoscaler elements
+func (r *HPAResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "hpa",
+		Name:        "HorizontalPodAutoscaler",
+		Description: "HorizontalPodAutoscaler scaling a workload between replica bounds",
+		Category:    "workload",
+		Schema: providers.ResourceSchema("HPA", 50, 48, map[string]interface{}{
+			"minReplicas": map[string]interface{}{
+				"type":        "integer",
+				"description": "Minimum number of replicas",
+				"default":     1,
+				"minimum":     1,
+				"maximum":     1000,
+			},
+			"maxReplicas": map[string]interface{}{
+				"type":        "integer",
+				"description": "Maximum number of replicas",
+				"minimum":     1,
+				"maximum":     1000,
+			},
+			"targetCPU": map[string]interface{}{
+				"type":        "integer",
+				"description": "Target CPU utilization in percent",
+				"minimum":     1,
+				"maximum":     100,
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "HorizontalPodAutoscaler",
+				Description: "Basic HorizontalPodAutoscaler",
+				Config: map[string]interface{}{
+					"label":       "web",
+					"minReplicas": 2,
+					"maxReplicas": 10,
+					"targetCPU":   70,
+				},
+			},
+		},
+	}
+}
+
+// Validate validates HorizontalPodAutoscaler parameters
+func (r *HPAResource) Validate(params map[string]interface{}) error {
+	if err := providers.ValidateParams(r.Definition().Schema, params); err != nil {
+		return err
+	}
+	return validateReplicaBounds(params)
+}
@@ -0,0 +1,41 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestHPAResource_Validate(t *testing.T) {
+	resource := NewHPAResource()
+
+	tests := []struct {
+		name   string
+		params map[string]interface{}
+		field  string
+		code   string
+	}{
+		{name: "bounds in order", params: map[string]interface{}{"minReplicas": 2, "maxReplicas": 8, "targetCPU": 70}},
+		{name: "equal bounds", params: map[string]interface{}{"minReplicas": 4, "maxReplicas": 4}},
+		{name: "min above max", params: map[string]interface{}{"minReplicas": 10, "maxReplicas": 2}, field: "minReplicas", code: "OUT_OF_RANGE"},
+		{name: "min above max as floats", params: map[string]interface{}{"minReplicas": 10.0, "maxReplicas": 2.0}, field: "minReplicas", code: "OUT_OF_RANGE"},
+		{name: "only min", params: map[string]interface{}{"minReplicas": 10}},
+		{name: "zero min", params: map[string]interface{}{"minReplicas": 0}, field: "minReplicas", code: "OUT_OF_RANGE"},
+		{name: "target CPU above 100 percent", params: map[string]interface{}{"targetCPU": 120}, field: "targetCPU", code: "OUT_OF_RANGE"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := resource.Validate(tt.params)
+			if tt.field == "" {
+				if err != nil {
+					t.Errorf("Expected no validation error, got %v", err)
+				}
+				return
+			}
+			if code := providertest.ValidationCode(err, tt.field); code != tt.code {
+				t.Errorf("Expected code %s for %s, got %q (%v)", tt.code, tt.field, code, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// hostPattern matches host names, optionally with a leading wildcard
+const hostPattern = `^(\*\.)?([a-z0-9]([-a-z0-9]*[a-z0-9])?\.)*[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+
+// IngressResource defines the Kubernetes Ingress resource
+type IngressResource struct{}
+
+// NewIngressResource creates a new Ingress resource instance
+func NewIngressResource() *IngressResource {
+	return &IngressResource{}
+}
+
+// Definition returns the resource definition for Ingress elements
+func (r *IngressResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "ingress",
+		Name:        "Ingress",
+		Description: "Ingress routing external HTTP traffic to services",
+		Category:    "network",
+		Schema: providers.ResourceSchema("Ingress", 50, 48, map[string]interface{}{
+			"host": map[string]interface{}{
+				"type":        "string",
+				"description": "Host name",
+				"pattern":     hostPattern,
+			},
+			"ingressClass": map[string]interface{}{
+				"type":        "string",
+				"description": "Ingress class name",
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Ingress",
+				Description: "Basic Ingress",
+				Config: map[string]interface{}{
+					"label":        "shop",
+					"host":         "shop.example.com",
+					"ingressClass": "nginx",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates Ingress parameters
+func (r *IngressResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
@@ -0,0 +1,25 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestIngressResource_Host(t *testing.T) {
+	resource := NewIngressResource()
+
+	for _, host := range []string{"shop.example.com", "*.example.com", "localhost"} {
+		if err := resource.Validate(map[string]interface{}{"host": host}); err != nil {
+			t.Errorf("Expected host %s to be valid, got %v", host, err)
+		}
+	}
+
+	// Hosts are lowercase DNS names without scheme or port
+	for _, host := range []string{"https://shop.example.com", "Shop.example.com", "shop.example.com:443", "-shop.example.com"} {
+		err := resource.Validate(map[string]interface{}{"host": host})
+		if code := providertest.ValidationCode(err, "host"); code != "INVALID_FORMAT" {
+			t.Errorf("Expected INVALID_FORMAT for host %s, got %q", host, code)
+		}
+	}
+}
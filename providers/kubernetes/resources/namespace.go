@@ -0,0 +1,44 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// NamespaceResource defines the Kubernetes Namespace resource
+type NamespaceResource struct{}
+
+// NewNamespaceResource creates a new Namespace resource instance
+func NewNamespaceResource() *NamespaceResource {
+	return &NamespaceResource{}
+}
+
+// Definition returns the resource definition for Namespace elements
+func (r *NamespaceResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "namespace",
+		Name:        "Namespace",
+		Description: "Namespace container holding workloads and configuration",
+		Category:    "container",
+		Schema: providers.ResourceSchema("default", 400, 150, map[string]interface{}{
+			"environment": map[string]interface{}{
+				"type":        "string",
+				"description": "Environment the namespace belongs to",
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Namespace",
+				Description: "Basic Namespace container",
+				Config: map[string]interface{}{
+					"label":       "payments",
+					"environment": "production",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates Namespace parameters
+func (r *NamespaceResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
@@ -0,0 +1,54 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// restartPolicies are the supported pod restart policies
+var restartPolicies = []string{"Always", "OnFailure", "Never"}
+
+// PodResource defines the Kubernetes Pod resource
+type PodResource struct{}
+
+// NewPodResource creates a new Pod resource instance
+func NewPodResource() *PodResource {
+	return &PodResource{}
+}
+
+// Definition returns the resource definition for Pod elements
+func (r *PodResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "pod",
+		Name:        "Pod",
+		Description: "Single pod running one or more containers",
+		Category:    "workload",
+		Schema: providers.ResourceSchema("Pod", 50, 48, map[string]interface{}{
+			"image": map[string]interface{}{
+				"type":        "string",
+				"description": "Container image",
+			},
+			"restartPolicy": map[string]interface{}{
+				"type":        "string",
+				"description": "Pod restart policy",
+				"default":     "Always",
+				"enum":        restartPolicies,
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Pod",
+				Description: "Basic Pod",
+				Config: map[string]interface{}{
+					"label":         "migration",
+					"image":         "app:1.0",
+					"restartPolicy": "Never",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates Pod parameters
+func (r *PodResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
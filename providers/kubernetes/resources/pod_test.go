@@ -0,0 +1,23 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestPodResource_RestartPolicy(t *testing.T) {
+	resource := NewPodResource()
+
+	for _, policy := range restartPolicies {
+		if err := resource.Validate(map[string]interface{}{"restartPolicy": policy}); err != nil {
+			t.Errorf("Expected restart policy %s to be valid, got %v", policy, err)
+		}
+	}
+
+	// Restart policies are case sensitive
+	err := resource.Validate(map[string]interface{}{"restartPolicy": "always"})
+	if code := providertest.ValidationCode(err, "restartPolicy"); code != "INVALID_ENUM" {
+		t.Errorf("Expected INVALID_ENUM for restart policy always, got %q", code)
+	}
+}
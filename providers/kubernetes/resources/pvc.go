@@ -0,0 +1,58 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// quantityPattern matches Kubernetes resource quantities
+const quantityPattern = `^[0-9]+(\.[0-9]+)?(Ki|Mi|Gi|Ti|Pi|Ei|k|M|G|T|P|E)?$`
+
+// accessModes are the supported volume access modes
+var accessModes = []string{"ReadWriteOnce", "ReadOnlyMany", "ReadWriteMany", "ReadWriteOncePod"}
+
+// PVCResource defines the Kubernetes PersistentVolumeClaim resource
+type PVCResource struct{}
+
+// NewPVCResource creates a new PersistentVolumeClaim resource instance
+func NewPVCResource() *PVCResource {
+	return &PVCResource{}
+}
+
+// Definition returns the resource definition for PersistentVolumeClaim elements
+func (r *PVCResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "pvc",
+		Name:        "PersistentVolumeClaim",
+		Description: "PersistentVolumeClaim requesting storage",
+		Category:    "storage",
+		Schema: providers.ResourceSchema("PVC", 50, 48, map[string]interface{}{
+			"storage": map[string]interface{}{
+				"type":        "string",
+				"description": "Requested storage size",
+				"pattern":     quantityPattern,
+			},
+			"accessMode": map[string]interface{}{
+				"type":        "string",
+				"description": "Volume access mode",
+				"default":     "ReadWriteOnce",
+				"enum":        accessModes,
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "PersistentVolumeClaim",
+				Description: "Basic PersistentVolumeClaim",
+				Config: map[string]interface{}{
+					"label":      "data",
+					"storage":    "10Gi",
+					"accessMode": "ReadWriteOnce",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates PersistentVolumeClaim parameters
+func (r *PVCResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
@@ -0,0 +1,29 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestPVCResource_Validate(t *testing.T) {
+	resource := NewPVCResource()
+
+	for _, storage := range []string{"10Gi", "512Mi", "1.5Ti", "100"} {
+		if err := resource.Validate(map[string]interface{}{"storage": storage}); err != nil {
+			t.Errorf("Expected storage %s to be valid, got %v", storage, err)
+		}
+	}
+
+	for _, storage := range []string{"10 GB", "10gi", "Gi"} {
+		err := resource.Validate(map[string]interface{}{"storage": storage})
+		if code := providertest.ValidationCode(err, "storage"); code != "INVALID_FORMAT" {
+			t.Errorf("Expected INVALID_FORMAT for storage %s, got %q", storage, code)
+		}
+	}
+
+	err := resource.Validate(map[string]interface{}{"accessMode": "ReadWriteAll"})
+	if code := providertest.ValidationCode(err, "accessMode"); code != "INVALID_ENUM" {
+		t.Errorf("Expected INVALID_ENUM for access mode ReadWriteAll, got %q", code)
+	}
+}
@@ -0,0 +1,56 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// secretTypes are the built-in secret types
+var secretTypes = []string{
+	"Opaque",
+	"kubernetes.io/tls",
+	"kubernetes.io/dockerconfigjson",
+	"kubernetes.io/basic-auth",
+	"kubernetes.io/ssh-auth",
+	"kubernetes.io/service-account-token",
+}
+
+// SecretResource defines the Kubernetes Secret resource
+type SecretResource struct{}
+
+// NewSecretResource creates a new Secret resource instance
+func NewSecretResource() *SecretResource {
+	return &SecretResource{}
+}
+
+// Definition returns the resource definition for Secret elements
+func (r *SecretResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "secret",
+		Name:        "Secret",
+		Description: "Secret holding confidential data",
+		Category:    "config",
+		Schema: providers.ResourceSchema("Secret", 50, 48, map[string]interface{}{
+			"secretType": map[string]interface{}{
+				"type":        "string",
+				"description": "Secret type",
+				"default":     "Opaque",
+				"enum":        secretTypes,
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Secret",
+				Description: "Basic Secret",
+				Config: map[string]interface{}{
+					"label":      "tls-cert",
+					"secretType": "kubernetes.io/tls",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates Secret parameters
+func (r *SecretResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
@@ -0,0 +1,23 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestSecretResource_SecretType(t *testing.T) {
+	resource := NewSecretResource()
+
+	for _, secretType := range secretTypes {
+		if err := resource.Validate(map[string]interface{}{"secretType": secretType}); err != nil {
+			t.Errorf("Expected secret type %s to be valid, got %v", secretType, err)
+		}
+	}
+
+	// Secret types are given with their kubernetes.io prefix
+	err := resource.Validate(map[string]interface{}{"secretType": "tls"})
+	if code := providertest.ValidationCode(err, "secretType"); code != "INVALID_ENUM" {
+		t.Errorf("Expected INVALID_ENUM for secret type tls, got %q", code)
+	}
+}
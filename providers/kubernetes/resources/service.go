@@ -0,0 +1,56 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// serviceTypes are the supported service types
+var serviceTypes = []string{"ClusterIP", "NodePort", "LoadBalancer", "ExternalName"}
+
+// ServiceResource defines the Kubernetes Service resource
+type ServiceResource struct{}
+
+// NewServiceResource creates a new Service resource instance
+func NewServiceResource() *ServiceResource {
+	return &ServiceResource{}
+}
+
+// Definition returns the resource definition for Service elements
+func (r *ServiceResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "service",
+		Name:        "Service",
+		Description: "Service exposing pods on a stable address",
+		Category:    "network",
+		Schema: providers.ResourceSchema("Service", 50, 48, map[string]interface{}{
+			"serviceType": map[string]interface{}{
+				"type":        "string",
+				"description": "Service type",
+				"default":     "ClusterIP",
+				"enum":        serviceTypes,
+			},
+			"port": map[string]interface{}{
+				"type":        "integer",
+				"description": "Service port",
+				"minimum":     1,
+				"maximum":     65535,
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Service",
+				Description: "Basic Service",
+				Config: map[string]interface{}{
+					"label":       "web",
+					"serviceType": "LoadBalancer",
+					"port":        443,
+				},
+			},
+		},
+	}
+}
+
+// Validate validates Service parameters
+func (r *ServiceResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
@@ -0,0 +1,27 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestServiceResource_Validate(t *testing.T) {
+	resource := NewServiceResource()
+
+	if err := resource.Validate(map[string]interface{}{"serviceType": "LoadBalancer", "port": 443}); err != nil {
+		t.Errorf("Expected load balancer service on port 443 to be valid, got %v", err)
+	}
+
+	for _, port := range []int{0, 65536} {
+		err := resource.Validate(map[string]interface{}{"port": port})
+		if code := providertest.ValidationCode(err, "port"); code != "OUT_OF_RANGE" {
+			t.Errorf("Expected OUT_OF_RANGE for port %d, got %q", port, code)
+		}
+	}
+
+	err := resource.Validate(map[string]interface{}{"serviceType": "Headless"})
+	if code := providertest.ValidationCode(err, "serviceType"); code != "INVALID_ENUM" {
+		t.Errorf("Expected INVALID_ENUM for service type Headless, got %q", code)
+	}
+}
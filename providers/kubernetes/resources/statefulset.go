@@ -0,0 +1,52 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// StatefulSetResource defines the Kubernetes StatefulSet resource
+type StatefulSetResource struct{}
+
+// NewStatefulSetResource creates a new StatefulSet resource instance
+func NewStatefulSetResource() *StatefulSetResource {
+	return &StatefulSetResource{}
+}
+
+// Definition returns the resource definition for StatefulSet elements
+func (r *StatefulSetResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "statefulset",
+		Name:        "StatefulSet",
+		Description: "StatefulSet managing pods with stable identities and storage",
+		Category:    "workload",
+		Schema: providers.ResourceSchema("StatefulSet", 50, 48, map[string]interface{}{
+			"replicas": map[string]interface{}{
+				"type":        "integer",
+				"description": "Number of pod replicas",
+				"default":     1,
+				"minimum":     0,
+				"maximum":     1000,
+			},
+			"image": map[string]interface{}{
+				"type":        "string",
+				"description": "Container image",
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "StatefulSet",
+				Description: "Basic StatefulSet",
+				Config: map[string]interface{}{
+					"label":    "postgres",
+					"replicas": 3,
+					"image":    "postgres:16",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates StatefulSet parameters
+func (r *StatefulSetResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
@@ -0,0 +1,20 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestStatefulSetResource_Replicas(t *testing.T) {
+	resource := NewStatefulSetResource()
+
+	if err := resource.Validate(map[string]interface{}{"replicas": 3, "image": "postgres:16"}); err != nil {
+		t.Errorf("Expected a stateful set with 3 replicas to be valid, got %v", err)
+	}
+
+	err := resource.Validate(map[string]interface{}{"replicas": -2})
+	if code := providertest.ValidationCode(err, "replicas"); code != "OUT_OF_RANGE" {
+		t.Errorf("Expected OUT_OF_RANGE for -2 replicas, got %q", code)
+	}
+}
@@ -0,0 +1,35 @@
+package templates
+
+import (
+	"strings"
+
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// ClusterTemplate handles template generation for Cluster elements
+type ClusterTemplate struct{}
+
+// NewClusterTemplate creates a new Cluster template generator
+func NewClusterTemplate() *ClusterTemplate {
+	return &ClusterTemplate{}
+}
+
+// Generate creates a schema.Element from Cluster parameters
+func (t *ClusterTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	var details []string
+	if clusterType := getStringParam(params, "clusterType", "native"); clusterType != "native" {
+		details = append(details, strings.ToUpper(clusterType))
+	}
+	if version := getStringParam(params, "version", ""); version != "" {
+		details = append(details, "v"+strings.TrimPrefix(version, "v"))
+	}
+
+	return newContainer(params, containerSpec{
+		label:       "Kubernetes Cluster",
+		fill:        colorCluster,
+		width:       600,
+		height:      400,
+		spacing:     20,
+		arrangement: schema.ArrangementVertical,
+	}, strings.Join(details, " ")), nil
+}
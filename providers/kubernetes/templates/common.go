@@ -0,0 +1,171 @@
+package templates
+
+import (
+	"fmt"
+
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// Kubernetes brand colors
+const (
+	colorKubernetes = "#326CE5"
+	colorCluster    = "#F5F8FE"
+	colorLabel      = "#1A3D7C"
+)
+
+// containerSpec describes the appearance and child layout of a cluster or namespace
+type containerSpec struct {
+	label       string
+	fill        string
+	dashed      bool
+	width       float64
+	height      float64
+	arrangement schema.Arrangement
+	spacing     float64 // Room between children, including labels below icons
+}
+
+// iconSpec describes a Kubernetes object icon
+type iconSpec struct {
+	label string
+	icon  string // mxgraph.kubernetes.icon2 prIcon name
+}
+
+// newContainer creates a cluster or namespace container that grows to fit its children
+func newContainer(params map[string]interface{}, spec containerSpec, detail string) *schema.Element {
+	fill := spec.fill
+	if fill == "" {
+		fill = "none"
+	}
+
+	style := schema.Style{
+		FillColor:     getStringParam(params, "fillColor", fill),
+		StrokeColor:   getStringParam(params, "strokeColor", colorKubernetes),
+		StrokeWidth:   2,
+		FontColor:     getStringParam(params, "fontColor", colorLabel),
+		FontSize:      12,
+		FontStyle:     "1",
+		TextAlign:     "left",
+		VerticalAlign: "top",
+		Rounded:       true,
+		Custom: map[string]string{
+			"html":        "1",
+			"whiteSpace":  "wrap",
+			"container":   "1",
+			"collapsible": "0",
+			"spacingLeft": "10",
+			"spacingTop":  "5",
+		},
+	}
+	if spec.dashed {
+		style.StrokeDashArray = "8 4"
+	}
+
+	return &schema.Element{
+		Type: schema.ElementTypeGroup,
+		Properties: schema.ElementProperties{
+			X:      getFloatParam(params, "x", 0),
+			Y:      getFloatParam(params, "y", 0),
+			Width:  getFloatParam(params, "width", spec.width),
+			Height: getFloatParam(params, "height", spec.height),
+			Label:  formatLabel(getStringParam(params, "label", spec.label), detail),
+		},
+		Style: style,
+		// Children are laid out below the label and the container grows to fit them,
+		// so namespaces sized by their workloads nest inside their cluster
+		Nesting: schema.NestingConfig{
+			Mode:        schema.NestingModeChild,
+			AutoResize:  true,
+			Arrangement: spec.arrangement,
+			Spacing:     spec.spacing,
+			Padding: schema.Padding{
+				Top:    40,
+				Right:  20,
+				Bottom: 20,
+				Left:   20,
+			},
+		},
+	}
+}
+
+// newIcon creates a Kubernetes object icon with its label below the icon
+func newIcon(params map[string]interface{}, spec iconSpec, detail string) *schema.Element {
+	return &schema.Element{
+		Type: schema.ElementTypeShape,
+		Properties: schema.ElementProperties{
+			X:      getFloatParam(params, "x", 0),
+			Y:      getFloatParam(params, "y", 0),
+			Width:  getFloatParam(params, "width", 50),
+			Height: getFloatParam(params, "height", 48),
+			Label:  formatLabel(getStringParam(params, "label", spec.label), detail),
+			Shape:  "mxgraph.kubernetes.icon2",
+		},
+		Style: schema.Style{
+			FillColor:             getStringParam(params, "fillColor", colorKubernetes),
+			StrokeColor:           "#ffffff",
+			FontSize:              12,
+			VerticalLabelPosition: "bottom",
+			VerticalAlign:         "top",
+			TextAlign:             "center",
+			Custom: map[string]string{
+				"prIcon":     spec.icon,
+				"html":       "1",
+				"aspect":     "fixed",
+				"whiteSpace": "wrap",
+			},
+		},
+	}
+}
+
+// formatLabel appends details such as the replica count to a label
+func formatLabel(label, detail string) string {
+	if detail == "" {
+		return label
+	}
+	return fmt.Sprintf("%s (%s)", label, detail)
+}
+
+func getStringParam(params map[string]interface{}, key, defaultValue string) string {
+	if val, ok := params[key]; ok {
+		if str, ok := val.(string); ok {
+			return str
+		}
+	}
+	return defaultValue
+}
+
+func getFloatParam(params map[string]interface{}, key string, defaultValue float64) float64 {
+	if val, ok := params[key]; ok {
+		switch v := val.(type) {
+		case float64:
+			return v
+		case int:
+			return float64(v)
+		case int64:
+			return float64(v)
+		}
+	}
+	return defaultValue
+}
+
+func getIntParam(params map[string]interface{}, key string, defaultValue int) int {
+	if val, ok := params[key]; ok {
+		switch v := val.(type) {
+		case int:
+			return v
+		case int64:
+			return int(v)
+		case float64:
+			return int(v)
+		}
+	}
+	return defaultValue
+}
+
+// replicaDetail describes the replica count of a workload
+func replicaDetail(params map[string]interface{}) string {
+	replicas := getIntParam(params, "replicas", 1)
+	if replicas == 1 {
+		return "1 replica"
+	}
+	return fmt.Sprintf("%d replicas", replicas)
+}
@@ -0,0 +1,86 @@
+package templates
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+func TestClusterTemplate_Generate(t *testing.T) {
+	element, err := NewClusterTemplate().Generate(map[string]interface{}{
+		"label":       "production",
+		"clusterType": "eks",
+		"version":     "1.29",
+	})
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	if element.Properties.Label != "production (EKS v1.29)" {
+		t.Errorf("Unexpected label '%s'", element.Properties.Label)
+	}
+
+	// Namespaces are stacked below the cluster label and the cluster grows to fit them
+	if element.Nesting.Arrangement != schema.ArrangementVertical || !element.Nesting.AutoResize {
+		t.Error("Expected cluster to stack and fit its namespaces")
+	}
+	if element.Nesting.Padding.Top < 30 {
+		t.Errorf("Expected room for the cluster label, got top padding %v", element.Nesting.Padding.Top)
+	}
+}
+
+func TestNamespaceTemplate_Generate(t *testing.T) {
+	element, err := NewNamespaceTemplate().Generate(map[string]interface{}{})
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	if element.Type != schema.ElementTypeGroup || element.Properties.Label != "default" {
+		t.Errorf("Expected default namespace group, got %s '%s'", element.Type, element.Properties.Label)
+	}
+	if element.Style.StrokeDashArray == "" {
+		t.Error("Expected dashed namespace border")
+	}
+
+	cluster, _ := NewClusterTemplate().Generate(map[string]interface{}{})
+	if element.Properties.Width >= cluster.Properties.Width || element.Properties.Height >= cluster.Properties.Height {
+		t.Error("Expected default namespace to fit inside the default cluster")
+	}
+}
+
+func TestWorkloadTemplates_Generate(t *testing.T) {
+	tests := []struct {
+		name   string
+		params map[string]interface{}
+		gen    func(map[string]interface{}) (*schema.Element, error)
+		icon   string
+		label  string
+	}{
+		{name: "deployment", params: map[string]interface{}{"label": "web", "replicas": 3}, gen: NewDeploymentTemplate().Generate, icon: "deploy", label: "web (3 replicas)"},
+		{name: "statefulset", params: map[string]interface{}{}, gen: NewStatefulSetTemplate().Generate, icon: "sts", label: "StatefulSet (1 replica)"},
+		{name: "service", params: map[string]interface{}{"port": 80}, gen: NewServiceTemplate().Generate, icon: "svc", label: "Service (ClusterIP:80)"},
+		{name: "secret", params: map[string]interface{}{"secretType": "kubernetes.io/tls"}, gen: NewSecretTemplate().Generate, icon: "secret", label: "Secret (tls)"},
+		{name: "hpa", params: map[string]interface{}{"minReplicas": 2, "maxReplicas": 8}, gen: NewHPATemplate().Generate, icon: "hpa", label: "HPA (2-8)"},
+		{name: "pod", params: map[string]interface{}{"label": "worker"}, gen: NewPodTemplate().Generate, icon: "pod", label: "worker"},
+		{name: "daemonset", params: map[string]interface{}{"image": "fluent-bit:3.0"}, gen: NewDaemonSetTemplate().Generate, icon: "ds", label: "DaemonSet"},
+		{name: "configmap", params: map[string]interface{}{}, gen: NewConfigMapTemplate().Generate, icon: "cm", label: "ConfigMap"},
+		{name: "ingress", params: map[string]interface{}{"host": "shop.example.com"}, gen: NewIngressTemplate().Generate, icon: "ing", label: "Ingress (shop.example.com)"},
+		{name: "pvc", params: map[string]interface{}{"storage": "10Gi"}, gen: NewPVCTemplate().Generate, icon: "pvc", label: "PVC (10Gi)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			element, err := tt.gen(tt.params)
+			if err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+
+			if element.Style.Custom["prIcon"] != tt.icon {
+				t.Errorf("Expected icon %s, got %s", tt.icon, element.Style.Custom["prIcon"])
+			}
+			if element.Properties.Label != tt.label {
+				t.Errorf("Expected label '%s', got '%s'", tt.label, element.Properties.Label)
+			}
+		})
+	}
+}
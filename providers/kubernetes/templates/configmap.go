@@ -0,0 +1,21 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// ConfigMapTemplate handles template generation for ConfigMap elements
+type ConfigMapTemplate struct{}
+
+// NewConfigMapTemplate creates a new ConfigMap template generator
+func NewConfigMapTemplate() *ConfigMapTemplate {
+	return &ConfigMapTemplate{}
+}
+
+// Generate creates a schema.Element from ConfigMap parameters
+func (t *ConfigMapTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	return newIcon(params, iconSpec{
+		label: "ConfigMap",
+		icon:  "cm",
+	}, ""), nil
+}
@@ -0,0 +1,21 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// DaemonSetTemplate handles template generation for DaemonSet elements
+type DaemonSetTemplate struct{}
+
+// NewDaemonSetTemplate creates a new DaemonSet template generator
+func NewDaemonSetTemplate() *DaemonSetTemplate {
+	return &DaemonSetTemplate{}
+}
+
+// Generate creates a schema.Element from DaemonSet parameters
+func (t *DaemonSetTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	return newIcon(params, iconSpec{
+		label: "DaemonSet",
+		icon:  "ds",
+	}, ""), nil
+}
@@ -0,0 +1,21 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// DeploymentTemplate handles template generation for Deployment elements
+type DeploymentTemplate struct{}
+
+// NewDeploymentTemplate creates a new Deployment template generator
+func NewDeploymentTemplate() *DeploymentTemplate {
+	return &DeploymentTemplate{}
+}
+
+// Generate creates a schema.Element from Deployment parameters
+func (t *DeploymentTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	return newIcon(params, iconSpec{
+		label: "Deployment",
+		icon:  "deploy",
+	}, replicaDetail(params)), nil
+}
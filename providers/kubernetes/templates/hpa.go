@@ -0,0 +1,28 @@
+package templates
+
+import (
+	"fmt"
+
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// HPATemplate handles template generation for HorizontalPodAutoscaler elements
+type HPATemplate struct{}
+
+// NewHPATemplate creates a new HorizontalPodAutoscaler template generator
+func NewHPATemplate() *HPATemplate {
+	return &HPATemplate{}
+}
+
+// Generate creates a schema.Element from HorizontalPodAutoscaler parameters
+func (t *HPATemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	detail := ""
+	if maxReplicas := getIntParam(params, "maxReplicas", 0); maxReplicas > 0 {
+		detail = fmt.Sprintf("%d-%d", getIntParam(params, "minReplicas", 1), maxReplicas)
+	}
+
+	return newIcon(params, iconSpec{
+		label: "HPA",
+		icon:  "hpa",
+	}, detail), nil
+}
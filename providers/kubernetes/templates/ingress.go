@@ -0,0 +1,21 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// IngressTemplate handles template generation for Ingress elements
+type IngressTemplate struct{}
+
+// NewIngressTemplate creates a new Ingress template generator
+func NewIngressTemplate() *IngressTemplate {
+	return &IngressTemplate{}
+}
+
+// Generate creates a schema.Element from Ingress parameters
+func (t *IngressTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	return newIcon(params, iconSpec{
+		label: "Ingress",
+		icon:  "ing",
+	}, getStringParam(params, "host", "")), nil
+}
@@ -0,0 +1,25 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// NamespaceTemplate handles template generation for Namespace elements
+type NamespaceTemplate struct{}
+
+// NewNamespaceTemplate creates a new Namespace template generator
+func NewNamespaceTemplate() *NamespaceTemplate {
+	return &NamespaceTemplate{}
+}
+
+// Generate creates a schema.Element from Namespace parameters
+func (t *NamespaceTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	return newContainer(params, containerSpec{
+		label:       "default",
+		dashed:      true,
+		width:       400,
+		height:      150,
+		spacing:     70,
+		arrangement: schema.ArrangementHorizontal,
+	}, getStringParam(params, "environment", "")), nil
+}
@@ -0,0 +1,21 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// PodTemplate handles template generation for Pod elements
+type PodTemplate struct{}
+
+// NewPodTemplate creates a new Pod template generator
+func NewPodTemplate() *PodTemplate {
+	return &PodTemplate{}
+}
+
+// Generate creates a schema.Element from Pod parameters
+func (t *PodTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	return newIcon(params, iconSpec{
+		label: "Pod",
+		icon:  "pod",
+	}, ""), nil
+}
@@ -0,0 +1,21 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// PVCTemplate handles template generation for PersistentVolumeClaim elements
+type PVCTemplate struct{}
+
+// NewPVCTemplate creates a new PersistentVolumeClaim template generator
+func NewPVCTemplate() *PVCTemplate {
+	return &PVCTemplate{}
+}
+
+// Generate creates a schema.Element from PersistentVolumeClaim parameters
+func (t *PVCTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	return newIcon(params, iconSpec{
+		label: "PVC",
+		icon:  "pvc",
+	}, getStringParam(params, "storage", "")), nil
+}
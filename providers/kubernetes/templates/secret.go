@@ -0,0 +1,28 @@
+package templates
+
+import (
+	"strings"
+
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// SecretTemplate handles template generation for Secret elements
+type SecretTemplate struct{}
+
+// NewSecretTemplate creates a new Secret template generator
+func NewSecretTemplate() *SecretTemplate {
+	return &SecretTemplate{}
+}
+
+// Generate creates a schema.Element from Secret parameters
+func (t *SecretTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	detail := ""
+	if secretType := getStringParam(params, "secretType", "Opaque"); secretType != "Opaque" {
+		detail = strings.TrimPrefix(secretType, "kubernetes.io/")
+	}
+
+	return newIcon(params, iconSpec{
+		label: "Secret",
+		icon:  "secret",
+	}, detail), nil
+}
@@ -0,0 +1,28 @@
+package templates
+
+import (
+	"fmt"
+
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// ServiceTemplate handles template generation for Service elements
+type ServiceTemplate struct{}
+
+// NewServiceTemplate creates a new Service template generator
+func NewServiceTemplate() *ServiceTemplate {
+	return &ServiceTemplate{}
+}
+
+// Generate creates a schema.Element from Service parameters
+func (t *ServiceTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	detail := getStringParam(params, "serviceType", "ClusterIP")
+	if port := getIntParam(params, "port", 0); port > 0 {
+		detail = fmt.Sprintf("%s:%d", detail, port)
+	}
+
+	return newIcon(params, iconSpec{
+		label: "Service",
+		icon:  "svc",
+	}, detail), nil
+}
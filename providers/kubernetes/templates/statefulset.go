@@ -0,0 +1,21 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// StatefulSetTemplate handles template generation for StatefulSet elements
+type StatefulSetTemplate struct{}
+
+// NewStatefulSetTemplate creates a new StatefulSet template generator
+func NewStatefulSetTemplate() *StatefulSetTemplate {
+	return &StatefulSetTemplate{}
+}
+
+// Generate creates a schema.Element from StatefulSet parameters
+func (t *StatefulSetTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	return newIcon(params, iconSpec{
+		label: "StatefulSet",
+		icon:  "sts",
+	}, replicaDetail(params)), nil
+}
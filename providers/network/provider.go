@@ -0,0 +1,195 @@
+package network
+
+import (
+	"fmt"
+
+	"github.com/LederWorks/hippodamus/pkg/providers"
+	"github.com/LederWorks/hippodamus/pkg/schema"
+	"github.com/LederWorks/hippodamus/providers/network/resources"
+	"github.com/LederWorks/hippodamus/providers/network/templates"
+)
+
+// NetworkProvider implements the Provider interface for on-premises and hybrid network topologies
+type NetworkProvider struct {
+	version string
+	// Resource instances
+	routerResource       *resources.RouterResource
+	switchResource       *resources.SwitchResource
+	firewallResource     *resources.FirewallResource
+	loadBalancerResource *resources.LoadBalancerResource
+	serverResource       *resources.ServerResource
+	workstationResource  *resources.WorkstationResource
+	cloudResource        *resources.CloudResource
+	vpnGatewayResource   *resources.VPNGatewayResource
+	vlanZoneResource     *resources.VLANZoneResource
+	linkResource         *resources.LinkResource
+	wanLinkResource      *resources.LinkResource
+	// Template instances
+	routerTemplate       *templates.RouterTemplate
+	switchTemplate       *templates.SwitchTemplate
+	firewallTemplate     *templates.FirewallTemplate
+	loadBalancerTemplate *templates.LoadBalancerTemplate
+	serverTemplate       *templates.ServerTemplate
+	workstationTemplate  *templates.WorkstationTemplate
+	cloudTemplate        *templates.CloudTemplate
+	vpnGatewayTemplate   *templates.VPNGatewayTemplate
+	vlanZoneTemplate     *templates.VLANZoneTemplate
+	linkTemplate         *templates.LinkTemplate
+	wanLinkTemplate      *templates.LinkTemplate
+}
+
+// NewNetworkProvider creates a new network provider instance
+func NewNetworkProvider() *NetworkProvider {
+	return NewNetworkProviderWithVersion("dev")
+}
+
+// NewNetworkProviderWithVersion creates a new network provider instance with a specific version
+func NewNetworkProviderWithVersion(version string) *NetworkProvider {
+	return &NetworkProvider{
+		version:              version,
+		routerResource:       resources.NewRouterResource(),
+		switchResource:       resources.NewSwitchResource(),
+		firewallResource:     resources.NewFirewallResource(),
+		loadBalancerResource: resources.NewLoadBalancerResource(),
+		serverResource:       resources.NewServerResource(),
+		workstationResource:  resources.NewWorkstationResource(),
+		cloudResource:        resources.NewCloudResource(),
+		vpnGatewayResource:   resources.NewVPNGatewayResource(),
+		vlanZoneResource:     resources.NewVLANZoneResource(),
+		linkResource:         resources.NewLinkResource(),
+		wanLinkResource:      resources.NewWANLinkResource(),
+		routerTemplate:       templates.NewRouterTemplate(),
+		switchTemplate:       templates.NewSwitchTemplate(),
+		firewallTemplate:     templates.NewFirewallTemplate(),
+		loadBalancerTemplate: templates.NewLoadBalancerTemplate(),
+		serverTemplate:       templates.NewServerTemplate(),
+		workstationTemplate:  templates.NewWorkstationTemplate(),
+		cloudTemplate:        templates.NewCloudTemplate(),
+		vpnGatewayTemplate:   templates.NewVPNGatewayTemplate(),
+		vlanZoneTemplate:     templates.NewVLANZoneTemplate(),
+		linkTemplate:         templates.NewLinkTemplate(),
+		wanLinkTemplate:      templates.NewWANLinkTemplate(),
+	}
+}
+
+// Name returns the provider name
+func (p *NetworkProvider) Name() string {
+	return "network"
+}
+
+// Version returns the provider version
+func (p *NetworkProvider) Version() string {
+	return p.version
+}
+
+// Resources returns the list of supported network resources
+func (p *NetworkProvider) Resources() []providers.ResourceDefinition {
+	return []providers.ResourceDefinition{
+		p.routerResource.Definition(),
+		p.switchResource.Definition(),
+		p.firewallResource.Definition(),
+		p.loadBalancerResource.Definition(),
+		p.serverResource.Definition(),
+		p.workstationResource.Definition(),
+		p.cloudResource.Definition(),
+		p.vpnGatewayResource.Definition(),
+		p.vlanZoneResource.Definition(),
+		p.linkResource.Definition(),
+		p.wanLinkResource.Definition(),
+	}
+}
+
+// Validate validates network resource parameters
+func (p *NetworkProvider) Validate(resourceType string, params map[string]interface{}) error {
+	switch resourceType {
+	case "router":
+		return p.routerResource.Validate(params)
+	case "switch":
+		return p.switchResource.Validate(params)
+	case "firewall":
+		return p.firewallResource.Validate(params)
+	case "load-balancer":
+		return p.loadBalancerResource.Validate(params)
+	case "server":
+		return p.serverResource.Validate(params)
+	case "workstation":
+		return p.workstationResource.Validate(params)
+	case "cloud":
+		return p.cloudResource.Validate(params)
+	case "vpn-gateway":
+		return p.vpnGatewayResource.Validate(params)
+	case "vlan-zone":
+		return p.vlanZoneResource.Validate(params)
+	case "link":
+		return p.linkResource.Validate(params)
+	case "wan-link":
+		return p.wanLinkResource.Validate(params)
+	default:
+		return p.unsupportedResource(resourceType)
+	}
+}
+
+// GenerateTemplate generates network resource templates
+func (p *NetworkProvider) GenerateTemplate(resourceType string, params map[string]interface{}) (*schema.Element, error) {
+	if err := p.Validate(resourceType, params); err != nil {
+		return nil, err
+	}
+
+	switch resourceType {
+	case "router":
+		return p.routerTemplate.Generate(params)
+	case "switch":
+		return p.switchTemplate.Generate(params)
+	case "firewall":
+		return p.firewallTemplate.Generate(params)
+	case "load-balancer":
+		return p.loadBalancerTemplate.Generate(params)
+	case "server":
+		return p.serverTemplate.Generate(params)
+	case "workstation":
+		return p.workstationTemplate.Generate(params)
+	case "cloud":
+		return p.cloudTemplate.Generate(params)
+	case "vpn-gateway":
+		return p.vpnGatewayTemplate.Generate(params)
+	case "vlan-zone":
+		return p.vlanZoneTemplate.Generate(params)
+	case "link":
+		return p.linkTemplate.Generate(params)
+	case "wan-link":
+		return p.wanLinkTemplate.Generate(params)
+	default:
+		return nil, p.unsupportedResource(resourceType)
+	}
+}
+
+// GetSchema returns the JSON schema for a resource type
+func (p *NetworkProvider) GetSchema(resourceType string) (map[string]interface{}, error) {
+	for _, resource := range p.Resources() {
+		if resource.Type == resourceType {
+			return resource.Schema, nil
+		}
+	}
+
+	return nil, &providers.ProviderError{
+		Provider: p.Name(),
+		Resource: resourceType,
+		Message:  fmt.Sprintf("schema not found for resource type: %s", resourceType),
+		Code:     "SCHEMA_NOT_FOUND",
+	}
+}
+
+// GetVersion returns the provider version
+func (p *NetworkProvider) GetVersion() string {
+	return p.version
+}
+
+// unsupportedResource returns the error for resource types the provider does not support
+func (p *NetworkProvider) unsupportedResource(resourceType string) error {
+	return &providers.ProviderError{
+		Provider: p.Name(),
+		Resource: resourceType,
+		Message:  fmt.Sprintf("unsupported resource type: %s", resourceType),
+		Code:     "UNSUPPORTED_RESOURCE",
+	}
+}
@@ -0,0 +1,139 @@
+package network
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers"
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+	"github.com/LederWorks/hippodamus/pkg/schema"
+	"github.com/LederWorks/hippodamus/pkg/templates"
+)
+
+func TestNetworkProvider_Basic(t *testing.T) {
+	provider := NewNetworkProvider()
+
+	if provider.Name() != "network" {
+		t.Errorf("Expected provider name 'network', got '%s'", provider.Name())
+	}
+
+	if provider.Version() != "dev" {
+		t.Errorf("Expected version 'dev', got '%s'", provider.Version())
+	}
+
+	expected := []string{
+		"router", "switch", "firewall", "load-balancer", "server", "workstation", "cloud", "vpn-gateway",
+		"vlan-zone", "link", "wan-link",
+	}
+	resources := provider.Resources()
+	if len(resources) != len(expected) {
+		t.Fatalf("Expected %d resources, got %d", len(expected), len(resources))
+	}
+
+	for i, resourceType := range expected {
+		if resources[i].Type != resourceType {
+			t.Errorf("Expected resource %d to be '%s', got '%s'", i, resourceType, resources[i].Type)
+		}
+	}
+}
+
+func TestNetworkProvider_Conformance(t *testing.T) {
+	providertest.Run(t, NewNetworkProvider())
+}
+
+func TestNetworkProvider_Examples(t *testing.T) {
+	provider := NewNetworkProvider()
+
+	for _, resource := range provider.Resources() {
+		for _, example := range resource.Examples {
+			t.Run(resource.Type+"/"+example.Name, func(t *testing.T) {
+				element, err := provider.GenerateTemplate(resource.Type, example.Config)
+				if err != nil {
+					t.Fatalf("GenerateTemplate() error = %v", err)
+				}
+
+				switch resource.Category {
+				case "links":
+					if element.Type != schema.ElementTypeConnector || len(element.Children) != 2 {
+						t.Error("Expected a connector with interface labels at both ends")
+					}
+				case "networks":
+					if resource.Type == "vlan-zone" && element.Type != schema.ElementTypeGroup {
+						t.Error("Expected a zone container")
+					}
+				default:
+					if element.Properties.Shape == "" {
+						t.Error("Expected a device stencil")
+					}
+				}
+			})
+		}
+	}
+}
+
+// Zones declare that the CIDR blocks of siblings must not overlap, which the template
+// processor enforces among the zones that share a parent
+func TestNetworkProvider_SiblingRules(t *testing.T) {
+	if err := providers.DefaultRegistry.Register(NewNetworkProvider()); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+
+	zone := func(id, cidr string, children ...schema.Element) schema.Element {
+		return schema.Element{ID: id, Name: id, Resource: "network-vlan-zone", Parameters: map[string]interface{}{"cidr": cidr}, Children: children}
+	}
+
+	tests := []struct {
+		name     string
+		elements []schema.Element
+		wantErr  string
+	}{
+		{
+			name:     "disjoint zones",
+			elements: []schema.Element{zone("servers", "10.0.10.0/24"), zone("clients", "10.0.20.0/24")},
+		},
+		{
+			name:     "overlapping zones",
+			elements: []schema.Element{zone("servers", "10.0.10.0/24"), zone("office", "10.0.0.0/16")},
+			wantErr:  "cidr: CIDR block 10.0.0.0/16 of office overlaps 10.0.10.0/24 of servers",
+		},
+		{
+			name:     "nested zones",
+			elements: []schema.Element{zone("campus", "10.0.0.0/16", zone("servers", "10.0.10.0/24"))},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &schema.DiagramConfig{
+				Diagram: schema.Diagram{Pages: []schema.Page{{ID: "topology", Name: "Topology", Elements: tt.elements}}},
+			}
+
+			err := templates.NewTemplateProcessor("").ProcessDiagram(config)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ProcessDiagram() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestNetworkProvider_UnsupportedResource(t *testing.T) {
+	provider := NewNetworkProvider()
+
+	if err := provider.Validate("access-point", nil); err == nil {
+		t.Error("Expected error for unsupported resource type")
+	}
+
+	if _, err := provider.GenerateTemplate("access-point", nil); err == nil {
+		t.Error("Expected error for unsupported resource type")
+	}
+
+	if _, err := provider.GetSchema("access-point"); err == nil {
+		t.Error("Expected error for unsupported resource schema")
+	}
+}
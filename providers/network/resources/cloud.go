@@ -0,0 +1,41 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// CloudResource defines the network Cloud resource
+type CloudResource struct{}
+
+// NewCloudResource creates a new Cloud resource instance
+func NewCloudResource() *CloudResource {
+	return &CloudResource{}
+}
+
+// Definition returns the resource definition for Cloud elements
+func (r *CloudResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "cloud",
+		Name:        "Cloud",
+		Description: "External network such as the Internet or a provider MPLS cloud",
+		Category:    "networks",
+		Schema:      deviceSchema("Internet", 90, 50, map[string]interface{}{}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Cloud",
+				Description: "Basic Cloud",
+				Config: map[string]interface{}{
+					"label": "Internet",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates Cloud parameters
+func (r *CloudResource) Validate(params map[string]interface{}) error {
+	if err := providers.ValidateParams(r.Definition().Schema, params); err != nil {
+		return err
+	}
+	return providers.ValidateIP(params, "ip")
+}
@@ -0,0 +1,24 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// deviceSchema builds the JSON schema of a network device, which may show its hostname and
+// management address below the icon
+func deviceSchema(defaultLabel string, width, height float64, properties map[string]interface{}) map[string]interface{} {
+	merged := map[string]interface{}{
+		"hostname": map[string]interface{}{
+			"type":        "string",
+			"description": "Hostname shown below the label",
+		},
+		"ip": map[string]interface{}{
+			"type":        "string",
+			"description": "Management IP address shown below the label",
+		},
+	}
+	for name, property := range properties {
+		merged[name] = property
+	}
+	return providers.ResourceSchema(defaultLabel, width, height, merged)
+}
@@ -0,0 +1,42 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// FirewallResource defines the network Firewall resource
+type FirewallResource struct{}
+
+// NewFirewallResource creates a new Firewall resource instance
+func NewFirewallResource() *FirewallResource {
+	return &FirewallResource{}
+}
+
+// Definition returns the resource definition for Firewall elements
+func (r *FirewallResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "firewall",
+		Name:        "Firewall",
+		Description: "Firewall filtering traffic between zones",
+		Category:    "security",
+		Schema:      deviceSchema("Firewall", 29, 67, map[string]interface{}{}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Firewall",
+				Description: "Basic Firewall",
+				Config: map[string]interface{}{
+					"label": "Edge Firewall",
+					"ip":    "10.0.0.2",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates Firewall parameters
+func (r *FirewallResource) Validate(params map[string]interface{}) error {
+	if err := providers.ValidateParams(r.Definition().Schema, params); err != nil {
+		return err
+	}
+	return providers.ValidateIP(params, "ip")
+}
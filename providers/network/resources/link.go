@@ -0,0 +1,96 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// linkMedia are the supported physical media of a link
+var linkMedia = []string{"copper", "fiber", "wireless"}
+
+// bandwidthPattern matches bandwidths such as "100Mbps" or "2.5 Gbps"
+const bandwidthPattern = `^[0-9]+(\.[0-9]+)?\s*[KMGT]?bps$`
+
+// LinkResource defines a network link between two devices
+type LinkResource struct {
+	resourceType string
+	name         string
+	description  string
+}
+
+// NewLinkResource creates a new Link resource instance
+func NewLinkResource() *LinkResource {
+	return &LinkResource{resourceType: "link", name: "Link", description: "Local link between two devices"}
+}
+
+// NewWANLinkResource creates a new WAN Link resource instance
+func NewWANLinkResource() *LinkResource {
+	return &LinkResource{resourceType: "wan-link", name: "WAN Link", description: "Wide area link between sites, such as an MPLS circuit or leased line"}
+}
+
+// Definition returns the resource definition for the link
+func (r *LinkResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        r.resourceType,
+		Name:        r.name,
+		Description: r.description,
+		Category:    "links",
+		Schema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"source": map[string]interface{}{
+					"type":        "string",
+					"description": "Source device ID",
+					"minLength":   1,
+				},
+				"target": map[string]interface{}{
+					"type":        "string",
+					"description": "Target device ID",
+					"minLength":   1,
+				},
+				"label": map[string]interface{}{
+					"type":        "string",
+					"description": "Link name, shown with the bandwidth in the middle of the link",
+				},
+				"bandwidth": map[string]interface{}{
+					"type":        "string",
+					"description": "Link bandwidth, e.g. \"10Gbps\"",
+					"pattern":     bandwidthPattern,
+				},
+				"sourceInterface": map[string]interface{}{
+					"type":        "string",
+					"description": "Interface at the source end, e.g. \"Gi0/1\"",
+				},
+				"targetInterface": map[string]interface{}{
+					"type":        "string",
+					"description": "Interface at the target end",
+				},
+				"media": map[string]interface{}{
+					"type":        "string",
+					"description": "Physical medium",
+					"default":     "copper",
+					"enum":        linkMedia,
+				},
+			},
+			"required": []string{"source", "target"},
+		},
+		Examples: []providers.ResourceExample{
+			{
+				Name:        r.name,
+				Description: r.name + " with bandwidth and interfaces",
+				Config: map[string]interface{}{
+					"source":          "core-router",
+					"target":          "edge-firewall",
+					"bandwidth":       "10Gbps",
+					"sourceInterface": "Te0/1",
+					"targetInterface": "eth1",
+					"media":           "fiber",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates the link parameters
+func (r *LinkResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
@@ -0,0 +1,39 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestLinkResource_Validate(t *testing.T) {
+	resource := NewLinkResource()
+
+	for _, bandwidth := range []string{"100Mbps", "2.5 Gbps", "10Gbps"} {
+		if err := resource.Validate(map[string]interface{}{"source": "a", "target": "b", "bandwidth": bandwidth, "media": "fiber"}); err != nil {
+			t.Errorf("Expected bandwidth %s to be valid, got %v", bandwidth, err)
+		}
+	}
+
+	err := resource.Validate(map[string]interface{}{"source": "a", "target": "b", "bandwidth": "fast"})
+	if code := providertest.ValidationCode(err, "bandwidth"); code != "INVALID_FORMAT" {
+		t.Errorf("Expected INVALID_FORMAT for bandwidth fast, got %q", code)
+	}
+	err = resource.Validate(map[string]interface{}{"source": "a", "target": "b", "media": "coax"})
+	if code := providertest.ValidationCode(err, "media"); code != "INVALID_ENUM" {
+		t.Errorf("Expected INVALID_ENUM for media coax, got %q", code)
+	}
+}
+
+func TestLinkResource_WANLink(t *testing.T) {
+	resource := NewWANLinkResource()
+
+	if err := resource.Validate(map[string]interface{}{"source": "berlin", "target": "paris", "bandwidth": "1Gbps"}); err != nil {
+		t.Errorf("Expected WAN link between two sites to be valid, got %v", err)
+	}
+
+	err := resource.Validate(map[string]interface{}{"target": "paris"})
+	if code := providertest.ValidationCode(err, "source"); code != "REQUIRED" {
+		t.Errorf("Expected REQUIRED for a WAN link without source, got %q", code)
+	}
+}
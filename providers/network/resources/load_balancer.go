@@ -0,0 +1,42 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// LoadBalancerResource defines the network Load Balancer resource
+type LoadBalancerResource struct{}
+
+// NewLoadBalancerResource creates a new Load Balancer resource instance
+func NewLoadBalancerResource() *LoadBalancerResource {
+	return &LoadBalancerResource{}
+}
+
+// Definition returns the resource definition for Load Balancer elements
+func (r *LoadBalancerResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "load-balancer",
+		Name:        "Load Balancer",
+		Description: "Load balancer distributing traffic across servers",
+		Category:    "devices",
+		Schema:      deviceSchema("Load Balancer", 50, 40, map[string]interface{}{}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Load Balancer",
+				Description: "Basic Load Balancer",
+				Config: map[string]interface{}{
+					"label": "Web LB",
+					"ip":    "10.0.10.5",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates Load Balancer parameters
+func (r *LoadBalancerResource) Validate(params map[string]interface{}) error {
+	if err := providers.ValidateParams(r.Definition().Schema, params); err != nil {
+		return err
+	}
+	return providers.ValidateIP(params, "ip")
+}
@@ -0,0 +1,43 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// RouterResource defines the network Router resource
+type RouterResource struct{}
+
+// NewRouterResource creates a new Router resource instance
+func NewRouterResource() *RouterResource {
+	return &RouterResource{}
+}
+
+// Definition returns the resource definition for Router elements
+func (r *RouterResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "router",
+		Name:        "Router",
+		Description: "Router forwarding traffic between networks",
+		Category:    "devices",
+		Schema:      deviceSchema("Router", 50, 33, map[string]interface{}{}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Router",
+				Description: "Basic Router",
+				Config: map[string]interface{}{
+					"label":    "Core Router",
+					"hostname": "rtr-core-01",
+					"ip":       "10.0.0.1",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates Router parameters
+func (r *RouterResource) Validate(params map[string]interface{}) error {
+	if err := providers.ValidateParams(r.Definition().Schema, params); err != nil {
+		return err
+	}
+	return providers.ValidateIP(params, "ip")
+}
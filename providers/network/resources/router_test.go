@@ -0,0 +1,22 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestRouterResource_Address(t *testing.T) {
+	resource := NewRouterResource()
+
+	for _, ip := range []string{"10.0.0.1", "2001:db8::1"} {
+		if err := resource.Validate(map[string]interface{}{"hostname": "rtr-01", "ip": ip}); err != nil {
+			t.Errorf("Expected management address %s to be valid, got %v", ip, err)
+		}
+	}
+
+	err := resource.Validate(map[string]interface{}{"ip": "10.0.0.300"})
+	if code := providertest.ValidationCode(err, "ip"); code != "INVALID_FORMAT" {
+		t.Errorf("Expected INVALID_FORMAT for address 10.0.0.300, got %q", code)
+	}
+}
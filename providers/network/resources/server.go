@@ -0,0 +1,43 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// ServerResource defines the network Server resource
+type ServerResource struct{}
+
+// NewServerResource creates a new Server resource instance
+func NewServerResource() *ServerResource {
+	return &ServerResource{}
+}
+
+// Definition returns the resource definition for Server elements
+func (r *ServerResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "server",
+		Name:        "Server",
+		Description: "Server hosting applications or services",
+		Category:    "hosts",
+		Schema:      deviceSchema("Server", 45, 70, map[string]interface{}{}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Server",
+				Description: "Basic Server",
+				Config: map[string]interface{}{
+					"label":    "Web Server",
+					"hostname": "web-01",
+					"ip":       "10.0.10.11",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates Server parameters
+func (r *ServerResource) Validate(params map[string]interface{}) error {
+	if err := providers.ValidateParams(r.Definition().Schema, params); err != nil {
+		return err
+	}
+	return providers.ValidateIP(params, "ip")
+}
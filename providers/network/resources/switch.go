@@ -0,0 +1,48 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// SwitchResource defines the network Switch resource
+type SwitchResource struct{}
+
+// NewSwitchResource creates a new Switch resource instance
+func NewSwitchResource() *SwitchResource {
+	return &SwitchResource{}
+}
+
+// Definition returns the resource definition for Switch elements
+func (r *SwitchResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "switch",
+		Name:        "Switch",
+		Description: "Switch connecting hosts in a network, optionally routing between VLANs",
+		Category:    "devices",
+		Schema: deviceSchema("Switch", 64, 32, map[string]interface{}{
+			"layer3": map[string]interface{}{
+				"type":        "boolean",
+				"description": "Whether the switch routes between VLANs, drawn with the layer 3 switch stencil",
+				"default":     false,
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Switch",
+				Description: "Basic Switch",
+				Config: map[string]interface{}{
+					"label":  "Distribution Switch",
+					"layer3": true,
+				},
+			},
+		},
+	}
+}
+
+// Validate validates Switch parameters
+func (r *SwitchResource) Validate(params map[string]interface{}) error {
+	if err := providers.ValidateParams(r.Definition().Schema, params); err != nil {
+		return err
+	}
+	return providers.ValidateIP(params, "ip")
+}
@@ -0,0 +1,20 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestSwitchResource_Layer3(t *testing.T) {
+	resource := NewSwitchResource()
+
+	if err := resource.Validate(map[string]interface{}{"layer3": true, "ip": "10.0.0.2"}); err != nil {
+		t.Errorf("Expected layer 3 switch to be valid, got %v", err)
+	}
+
+	err := resource.Validate(map[string]interface{}{"layer3": "yes"})
+	if code := providertest.ValidationCode(err, "layer3"); code != "INVALID_TYPE" {
+		t.Errorf("Expected INVALID_TYPE for layer3 flag yes, got %q", code)
+	}
+}
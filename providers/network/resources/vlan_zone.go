@@ -0,0 +1,55 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// VLANZoneResource defines the network VLAN Zone resource
+type VLANZoneResource struct{}
+
+// NewVLANZoneResource creates a new VLAN Zone resource instance
+func NewVLANZoneResource() *VLANZoneResource {
+	return &VLANZoneResource{}
+}
+
+// Definition returns the resource definition for VLAN Zone elements
+func (r *VLANZoneResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "vlan-zone",
+		Name:        "VLAN Zone",
+		Description: "Network segment grouping the devices of a VLAN or security zone",
+		Category:    "networks",
+		Schema: providers.ResourceSchema("Zone", 400, 200, map[string]interface{}{
+			"vlanId": map[string]interface{}{
+				"type":        "integer",
+				"description": "VLAN ID",
+				"minimum":     1,
+				"maximum":     4094,
+			},
+			"cidr": map[string]interface{}{
+				"type":        "string",
+				"description": "CIDR block, which must not overlap the CIDR blocks of sibling zones",
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "VLAN Zone",
+				Description: "Server VLAN",
+				Config: map[string]interface{}{
+					"label":  "Servers",
+					"vlanId": 10,
+					"cidr":   "10.0.10.0/24",
+				},
+			},
+		},
+		DisjointCIDRs: []string{"cidr"},
+	}
+}
+
+// Validate validates VLAN Zone parameters
+func (r *VLANZoneResource) Validate(params map[string]interface{}) error {
+	if err := providers.ValidateParams(r.Definition().Schema, params); err != nil {
+		return err
+	}
+	return providers.ValidateCIDR(params, "cidr")
+}
@@ -0,0 +1,31 @@
+package resources
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestVLANZoneResource_Validate(t *testing.T) {
+	resource := NewVLANZoneResource()
+
+	if err := resource.Validate(map[string]interface{}{"vlanId": 100, "cidr": "192.168.100.0/24"}); err != nil {
+		t.Errorf("Expected zone with VLAN and CIDR block to be valid, got %v", err)
+	}
+
+	err := resource.Validate(map[string]interface{}{"vlanId": 4095})
+	if code := providertest.ValidationCode(err, "vlanId"); code != "OUT_OF_RANGE" {
+		t.Errorf("Expected OUT_OF_RANGE for VLAN 4095, got %q", code)
+	}
+	err = resource.Validate(map[string]interface{}{"cidr": "10.0.0.0/33"})
+	if code := providertest.ValidationCode(err, "cidr"); code != "INVALID_FORMAT" {
+		t.Errorf("Expected INVALID_FORMAT for CIDR block 10.0.0.0/33, got %q", code)
+	}
+}
+
+func TestVLANZoneResource_DisjointCIDRs(t *testing.T) {
+	if cidrs := NewVLANZoneResource().Definition().DisjointCIDRs; !reflect.DeepEqual(cidrs, []string{"cidr"}) {
+		t.Errorf("Expected the CIDR blocks of sibling zones to be disjoint, got %v", cidrs)
+	}
+}
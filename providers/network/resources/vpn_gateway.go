@@ -0,0 +1,42 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// VPNGatewayResource defines the network VPN Gateway resource
+type VPNGatewayResource struct{}
+
+// NewVPNGatewayResource creates a new VPN Gateway resource instance
+func NewVPNGatewayResource() *VPNGatewayResource {
+	return &VPNGatewayResource{}
+}
+
+// Definition returns the resource definition for VPN Gateway elements
+func (r *VPNGatewayResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "vpn-gateway",
+		Name:        "VPN Gateway",
+		Description: "VPN gateway terminating site-to-site or remote access tunnels",
+		Category:    "security",
+		Schema:      deviceSchema("VPN Gateway", 64, 39, map[string]interface{}{}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "VPN Gateway",
+				Description: "Basic VPN Gateway",
+				Config: map[string]interface{}{
+					"label": "Branch VPN",
+					"ip":    "203.0.113.10",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates VPN Gateway parameters
+func (r *VPNGatewayResource) Validate(params map[string]interface{}) error {
+	if err := providers.ValidateParams(r.Definition().Schema, params); err != nil {
+		return err
+	}
+	return providers.ValidateIP(params, "ip")
+}
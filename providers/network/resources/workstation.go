@@ -0,0 +1,42 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// WorkstationResource defines the network Workstation resource
+type WorkstationResource struct{}
+
+// NewWorkstationResource creates a new Workstation resource instance
+func NewWorkstationResource() *WorkstationResource {
+	return &WorkstationResource{}
+}
+
+// Definition returns the resource definition for Workstation elements
+func (r *WorkstationResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "workstation",
+		Name:        "Workstation",
+		Description: "End user computer",
+		Category:    "hosts",
+		Schema:      deviceSchema("Workstation", 70, 60, map[string]interface{}{}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Workstation",
+				Description: "Basic Workstation",
+				Config: map[string]interface{}{
+					"label":    "Office PC",
+					"hostname": "pc-042",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates Workstation parameters
+func (r *WorkstationResource) Validate(params map[string]interface{}) error {
+	if err := providers.ValidateParams(r.Definition().Schema, params); err != nil {
+		return err
+	}
+	return providers.ValidateIP(params, "ip")
+}
@@ -0,0 +1,30 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// CloudTemplate handles template generation for Cloud elements
+type CloudTemplate struct{}
+
+// NewCloudTemplate creates a new Cloud template generator
+func NewCloudTemplate() *CloudTemplate {
+	return &CloudTemplate{}
+}
+
+// Generate creates a schema.Element from Cloud parameters
+func (t *CloudTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	element := newDevice(params, deviceSpec{
+		label:  "Internet",
+		shape:  "mxgraph.networks.cloud",
+		family: familyNetworks,
+		width:  90,
+		height: 50,
+	})
+
+	// The label sits inside the cloud
+	element.Style.VerticalLabelPosition = "middle"
+	element.Style.VerticalAlign = "middle"
+	element.Style.FontColor = getStringParam(params, "fontColor", "#FFFFFF")
+	return element, nil
+}
@@ -0,0 +1,149 @@
+package templates
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// Network diagram colors
+const (
+	colorCisco         = "#036897"
+	colorNetworksFill  = "#29AAE1"
+	colorNetworksLine  = "#2D6195"
+	colorLink          = "#4D4D4D"
+	colorFiber         = "#F2931E"
+	colorWAN           = "#B85450"
+	colorZoneFill      = "#F5F5F5"
+	colorZoneStroke    = "#666666"
+	colorZoneFont      = "#333333"
+	colorInterfaceFont = "#666666"
+)
+
+// stencilFamily is a draw.io stencil library, which determines the default device colors
+type stencilFamily int
+
+const (
+	familyCisco    stencilFamily = iota // mxgraph.cisco, white outlines on a blue fill
+	familyNetworks                      // mxgraph.networks, blue outlines on a light blue fill
+)
+
+// deviceSpec describes the icon of a network device
+type deviceSpec struct {
+	label  string
+	shape  string
+	family stencilFamily
+	width  float64
+	height float64
+}
+
+// newDevice creates a network device icon with its label, hostname and management address
+// below the icon
+func newDevice(params map[string]interface{}, spec deviceSpec) *schema.Element {
+	fill, stroke := colorCisco, "#FFFFFF"
+	custom := map[string]string{
+		"html":           "1",
+		"whiteSpace":     "wrap",
+		"outlineConnect": "0",
+		"dashed":         "0",
+	}
+	if spec.family == familyCisco {
+		custom["pointerEvents"] = "1"
+	} else {
+		fill, stroke = colorNetworksFill, colorNetworksLine
+		custom["gradientColor"] = "none"
+	}
+
+	return &schema.Element{
+		Type: schema.ElementTypeShape,
+		Properties: schema.ElementProperties{
+			X:      getFloatParam(params, "x", 0),
+			Y:      getFloatParam(params, "y", 0),
+			Width:  getFloatParam(params, "width", spec.width),
+			Height: getFloatParam(params, "height", spec.height),
+			Label:  formatLabel(getStringParam(params, "label", spec.label), getStringParam(params, "hostname", ""), getStringParam(params, "ip", "")),
+			Shape:  spec.shape,
+		},
+		Style: schema.Style{
+			FillColor:             getStringParam(params, "fillColor", fill),
+			StrokeColor:           getStringParam(params, "strokeColor", stroke),
+			StrokeWidth:           2,
+			FontColor:             getStringParam(params, "fontColor", colorZoneFont),
+			FontSize:              11,
+			VerticalLabelPosition: "bottom",
+			VerticalAlign:         "top",
+			TextAlign:             "center",
+			Custom:                custom,
+		},
+	}
+}
+
+// formatLabel renders a label followed by details such as the hostname and address, one per line
+func formatLabel(label string, details ...string) string {
+	var lines []string
+	for _, line := range append([]string{label}, details...) {
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, "<br>")
+}
+
+// zoneDetail describes the VLAN and CIDR block of a zone, e.g. "VLAN 10, 10.0.10.0/24"
+func zoneDetail(params map[string]interface{}) string {
+	var details []string
+	if vlanID := getIntParam(params, "vlanId", 0); vlanID > 0 {
+		details = append(details, fmt.Sprintf("VLAN %d", vlanID))
+	}
+	if cidr := getStringParam(params, "cidr", ""); cidr != "" {
+		details = append(details, cidr)
+	}
+	return strings.Join(details, ", ")
+}
+
+func getStringParam(params map[string]interface{}, key, defaultValue string) string {
+	if val, ok := params[key]; ok {
+		if str, ok := val.(string); ok {
+			return str
+		}
+	}
+	return defaultValue
+}
+
+func getFloatParam(params map[string]interface{}, key string, defaultValue float64) float64 {
+	if val, ok := params[key]; ok {
+		switch v := val.(type) {
+		case float64:
+			return v
+		case int:
+			return float64(v)
+		case int64:
+			return float64(v)
+		}
+	}
+	return defaultValue
+}
+
+func getIntParam(params map[string]interface{}, key string, defaultValue int) int {
+	if val, ok := params[key]; ok {
+		switch v := val.(type) {
+		case int:
+			return v
+		case int64:
+			return int(v)
+		case float64:
+			return int(v)
+		}
+	}
+	return defaultValue
+}
+
+func getBoolParam(params map[string]interface{}, key string, defaultValue bool) bool {
+	if val, ok := params[key]; ok {
+		if b, ok := val.(bool); ok {
+			return b
+		}
+	}
+	return defaultValue
+}
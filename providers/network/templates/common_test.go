@@ -0,0 +1,64 @@
+package templates
+
+import (
+	"testing"
+)
+
+func TestFormatLabel(t *testing.T) {
+	if got := formatLabel("Core Router", "rtr-01", "", "10.0.0.1"); got != "Core Router<br>rtr-01<br>10.0.0.1" {
+		t.Errorf("Expected one detail per line, got %q", got)
+	}
+
+	if got := formatLabel("", "10Gbps"); got != "10Gbps" {
+		t.Errorf("Expected the detail alone, got %q", got)
+	}
+}
+
+func TestSwitchTemplate_Layer3(t *testing.T) {
+	access, _ := NewSwitchTemplate().Generate(map[string]interface{}{})
+	if access.Properties.Shape != "mxgraph.cisco.switches.workgroup_switch" {
+		t.Errorf("Expected workgroup switch stencil, got '%s'", access.Properties.Shape)
+	}
+
+	core, _ := NewSwitchTemplate().Generate(map[string]interface{}{"layer3": true})
+	if core.Properties.Shape != "mxgraph.cisco.switches.layer_3_switch" || core.Properties.Height != 64 {
+		t.Errorf("Expected layer 3 switch stencil, got '%s'", core.Properties.Shape)
+	}
+}
+
+func TestVLANZoneTemplate_Label(t *testing.T) {
+	zone, _ := NewVLANZoneTemplate().Generate(map[string]interface{}{"label": "Servers", "vlanId": 10, "cidr": "10.0.10.0/24"})
+	if zone.Properties.Label != "Servers (VLAN 10, 10.0.10.0/24)" {
+		t.Errorf("Expected VLAN and CIDR in the label, got '%s'", zone.Properties.Label)
+	}
+	if !zone.Nesting.AutoResize {
+		t.Error("Expected zone to grow to fit its devices")
+	}
+}
+
+func TestLinkTemplate_Labels(t *testing.T) {
+	link, _ := NewWANLinkTemplate().Generate(map[string]interface{}{
+		"source":          "hq",
+		"target":          "branch",
+		"label":           "MPLS",
+		"bandwidth":       "100Mbps",
+		"sourceInterface": "Gi0/0",
+		"targetInterface": "Gi0/1",
+	})
+
+	if link.Properties.Label != "MPLS<br>100Mbps" {
+		t.Errorf("Expected name and bandwidth in the middle, got '%s'", link.Properties.Label)
+	}
+	if link.Style.StrokeDashArray == "" || link.Style.Custom["edgeStyle"] != "none" {
+		t.Error("Expected a straight dashed WAN link")
+	}
+	if len(link.Children) != 2 {
+		t.Fatalf("Expected 2 interface labels, got %d", len(link.Children))
+	}
+	if source := link.Children[0]; source.Properties.Label != "Gi0/0" || source.Properties.X >= 0 {
+		t.Errorf("Expected 'Gi0/0' at the source end, got '%s' at %v", source.Properties.Label, source.Properties.X)
+	}
+	if target := link.Children[1]; target.Properties.Label != "Gi0/1" || target.Properties.X <= 0 {
+		t.Errorf("Expected 'Gi0/1' at the target end, got '%s' at %v", target.Properties.Label, target.Properties.X)
+	}
+}
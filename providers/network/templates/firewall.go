@@ -0,0 +1,24 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// FirewallTemplate handles template generation for Firewall elements
+type FirewallTemplate struct{}
+
+// NewFirewallTemplate creates a new Firewall template generator
+func NewFirewallTemplate() *FirewallTemplate {
+	return &FirewallTemplate{}
+}
+
+// Generate creates a schema.Element from Firewall parameters
+func (t *FirewallTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	return newDevice(params, deviceSpec{
+		label:  "Firewall",
+		shape:  "mxgraph.cisco.security.firewall",
+		family: familyCisco,
+		width:  29,
+		height: 67,
+	}), nil
+}
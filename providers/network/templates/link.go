@@ -0,0 +1,99 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// LinkTemplate handles template generation for network links
+type LinkTemplate struct {
+	wan bool // WAN links are drawn as thick dashed lines
+}
+
+// NewLinkTemplate creates a new Link template generator
+func NewLinkTemplate() *LinkTemplate {
+	return &LinkTemplate{}
+}
+
+// NewWANLinkTemplate creates a new WAN Link template generator
+func NewWANLinkTemplate() *LinkTemplate {
+	return &LinkTemplate{wan: true}
+}
+
+// Generate creates a schema.Element from link parameters
+func (t *LinkTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	color := colorLink
+	width := 2.0
+	var dash string
+
+	switch getStringParam(params, "media", "copper") {
+	case "fiber":
+		color = colorFiber
+	case "wireless":
+		dash = "2 4"
+	}
+	if t.wan {
+		color = colorWAN
+		width = 3
+		dash = "12 6"
+	}
+
+	element := &schema.Element{
+		Type: schema.ElementTypeConnector,
+		Properties: schema.ElementProperties{
+			Source: getStringParam(params, "source", ""),
+			Target: getStringParam(params, "target", ""),
+			Label:  formatLabel(getStringParam(params, "label", ""), getStringParam(params, "bandwidth", "")),
+		},
+		Style: schema.Style{
+			StrokeColor:     getStringParam(params, "strokeColor", color),
+			StrokeWidth:     width,
+			StrokeDashArray: dash,
+			FontColor:       colorZoneFont,
+			FontSize:        11,
+			Custom: map[string]string{
+				"html":                 "1",
+				"endArrow":             "none",
+				"startArrow":           "none",
+				"rounded":              "0",
+				"labelBackgroundColor": "#FFFFFF",
+			},
+		},
+	}
+	if t.wan {
+		// WAN links run straight between sites instead of along orthogonal routes
+		element.Style.Custom["edgeStyle"] = "none"
+	}
+
+	// Interface names sit at the ends of the link, next to their device
+	if name := getStringParam(params, "sourceInterface", ""); name != "" {
+		element.Children = append(element.Children, newInterfaceLabel("source-interface", name, -0.8, "left"))
+	}
+	if name := getStringParam(params, "targetInterface", ""); name != "" {
+		element.Children = append(element.Children, newInterfaceLabel("target-interface", name, 0.8, "right"))
+	}
+
+	return element, nil
+}
+
+// newInterfaceLabel creates a label at one end of a link, where position is -1 at the source
+// and 1 at the target
+func newInterfaceLabel(id, name string, position float64, align string) schema.Element {
+	return schema.Element{
+		ID:   id,
+		Type: schema.ElementTypeText,
+		Properties: schema.ElementProperties{
+			X:     position,
+			Y:     -10,
+			Label: name,
+		},
+		Style: schema.Style{
+			FontColor: colorInterfaceFont,
+			FontSize:  10,
+			TextAlign: align,
+			Custom: map[string]string{
+				"html":                 "1",
+				"labelBackgroundColor": "#FFFFFF",
+			},
+		},
+	}
+}
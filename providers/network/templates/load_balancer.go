@@ -0,0 +1,24 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// LoadBalancerTemplate handles template generation for Load Balancer elements
+type LoadBalancerTemplate struct{}
+
+// NewLoadBalancerTemplate creates a new Load Balancer template generator
+func NewLoadBalancerTemplate() *LoadBalancerTemplate {
+	return &LoadBalancerTemplate{}
+}
+
+// Generate creates a schema.Element from Load Balancer parameters
+func (t *LoadBalancerTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	return newDevice(params, deviceSpec{
+		label:  "Load Balancer",
+		shape:  "mxgraph.networks.load_balancer",
+		family: familyNetworks,
+		width:  50,
+		height: 40,
+	}), nil
+}
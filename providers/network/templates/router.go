@@ -0,0 +1,24 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// RouterTemplate handles template generation for Router elements
+type RouterTemplate struct{}
+
+// NewRouterTemplate creates a new Router template generator
+func NewRouterTemplate() *RouterTemplate {
+	return &RouterTemplate{}
+}
+
+// Generate creates a schema.Element from Router parameters
+func (t *RouterTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	return newDevice(params, deviceSpec{
+		label:  "Router",
+		shape:  "mxgraph.cisco.routers.router",
+		family: familyCisco,
+		width:  50,
+		height: 33,
+	}), nil
+}
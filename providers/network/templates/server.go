@@ -0,0 +1,24 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// ServerTemplate handles template generation for Server elements
+type ServerTemplate struct{}
+
+// NewServerTemplate creates a new Server template generator
+func NewServerTemplate() *ServerTemplate {
+	return &ServerTemplate{}
+}
+
+// Generate creates a schema.Element from Server parameters
+func (t *ServerTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	return newDevice(params, deviceSpec{
+		label:  "Server",
+		shape:  "mxgraph.networks.server",
+		family: familyNetworks,
+		width:  45,
+		height: 70,
+	}), nil
+}
@@ -0,0 +1,30 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// SwitchTemplate handles template generation for Switch elements
+type SwitchTemplate struct{}
+
+// NewSwitchTemplate creates a new Switch template generator
+func NewSwitchTemplate() *SwitchTemplate {
+	return &SwitchTemplate{}
+}
+
+// Generate creates a schema.Element from Switch parameters
+func (t *SwitchTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	spec := deviceSpec{
+		label:  "Switch",
+		shape:  "mxgraph.cisco.switches.workgroup_switch",
+		family: familyCisco,
+		width:  64,
+		height: 32,
+	}
+	if getBoolParam(params, "layer3", false) {
+		spec.shape = "mxgraph.cisco.switches.layer_3_switch"
+		spec.height = 64
+	}
+
+	return newDevice(params, spec), nil
+}
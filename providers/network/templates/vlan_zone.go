@@ -0,0 +1,66 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// VLANZoneTemplate handles template generation for VLAN Zone elements
+type VLANZoneTemplate struct{}
+
+// NewVLANZoneTemplate creates a new VLAN Zone template generator
+func NewVLANZoneTemplate() *VLANZoneTemplate {
+	return &VLANZoneTemplate{}
+}
+
+// Generate creates a schema.Element from VLAN Zone parameters
+func (t *VLANZoneTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	label := getStringParam(params, "label", "Zone")
+	if detail := zoneDetail(params); detail != "" {
+		label += " (" + detail + ")"
+	}
+
+	return &schema.Element{
+		Type: schema.ElementTypeGroup,
+		Properties: schema.ElementProperties{
+			X:      getFloatParam(params, "x", 0),
+			Y:      getFloatParam(params, "y", 0),
+			Width:  getFloatParam(params, "width", 400),
+			Height: getFloatParam(params, "height", 200),
+			Label:  label,
+		},
+		Style: schema.Style{
+			FillColor:       getStringParam(params, "fillColor", colorZoneFill),
+			StrokeColor:     getStringParam(params, "strokeColor", colorZoneStroke),
+			StrokeDashArray: "8 4",
+			FontColor:       getStringParam(params, "fontColor", colorZoneFont),
+			FontSize:        12,
+			FontStyle:       "1",
+			TextAlign:       "left",
+			VerticalAlign:   "top",
+			Rounded:         true,
+			Custom: map[string]string{
+				"html":        "1",
+				"whiteSpace":  "wrap",
+				"container":   "1",
+				"collapsible": "0",
+				"arcSize":     "4",
+				"spacingLeft": "10",
+				"spacingTop":  "5",
+			},
+		},
+		// Devices are laid out below the label with room for their own labels, and the
+		// zone grows to fit them
+		Nesting: schema.NestingConfig{
+			Mode:        schema.NestingModeChild,
+			AutoResize:  true,
+			Arrangement: schema.ArrangementHorizontal,
+			Spacing:     60,
+			Padding: schema.Padding{
+				Top:    40,
+				Right:  30,
+				Bottom: 50,
+				Left:   30,
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,24 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// VPNGatewayTemplate handles template generation for VPN Gateway elements
+type VPNGatewayTemplate struct{}
+
+// NewVPNGatewayTemplate creates a new VPN Gateway template generator
+func NewVPNGatewayTemplate() *VPNGatewayTemplate {
+	return &VPNGatewayTemplate{}
+}
+
+// Generate creates a schema.Element from VPN Gateway parameters
+func (t *VPNGatewayTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	return newDevice(params, deviceSpec{
+		label:  "VPN Gateway",
+		shape:  "mxgraph.cisco.misc.vpn_concentrator",
+		family: familyCisco,
+		width:  64,
+		height: 39,
+	}), nil
+}
@@ -0,0 +1,24 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// WorkstationTemplate handles template generation for Workstation elements
+type WorkstationTemplate struct{}
+
+// NewWorkstationTemplate creates a new Workstation template generator
+func NewWorkstationTemplate() *WorkstationTemplate {
+	return &WorkstationTemplate{}
+}
+
+// Generate creates a schema.Element from Workstation parameters
+func (t *WorkstationTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	return newDevice(params, deviceSpec{
+		label:  "Workstation",
+		shape:  "mxgraph.networks.pc",
+		family: familyNetworks,
+		width:  70,
+		height: 60,
+	}), nil
+}
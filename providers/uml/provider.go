@@ -0,0 +1,195 @@
+package uml
+
+import (
+	"fmt"
+
+	"github.com/LederWorks/hippodamus/pkg/providers"
+	"github.com/LederWorks/hippodamus/pkg/schema"
+	"github.com/LederWorks/hippodamus/providers/uml/resources"
+	"github.com/LederWorks/hippodamus/providers/uml/templates"
+)
+
+// UMLProvider implements the Provider interface for UML class and package diagrams
+type UMLProvider struct {
+	version string
+	// Resource instances
+	classResource          *resources.ClassResource
+	interfaceResource      *resources.InterfaceResource
+	enumResource           *resources.EnumResource
+	packageResource        *resources.PackageResource
+	noteResource           *resources.NoteResource
+	associationResource    *resources.RelationshipResource
+	aggregationResource    *resources.RelationshipResource
+	compositionResource    *resources.RelationshipResource
+	generalizationResource *resources.RelationshipResource
+	realizationResource    *resources.RelationshipResource
+	dependencyResource     *resources.RelationshipResource
+	// Template instances
+	classTemplate          *templates.ClassTemplate
+	interfaceTemplate      *templates.InterfaceTemplate
+	enumTemplate           *templates.EnumTemplate
+	packageTemplate        *templates.PackageTemplate
+	noteTemplate           *templates.NoteTemplate
+	associationTemplate    *templates.RelationshipTemplate
+	aggregationTemplate    *templates.RelationshipTemplate
+	compositionTemplate    *templates.RelationshipTemplate
+	generalizationTemplate *templates.RelationshipTemplate
+	realizationTemplate    *templates.RelationshipTemplate
+	dependencyTemplate     *templates.RelationshipTemplate
+}
+
+// NewUMLProvider creates a new UML provider instance
+func NewUMLProvider() *UMLProvider {
+	return NewUMLProviderWithVersion("dev")
+}
+
+// NewUMLProviderWithVersion creates a new UML provider instance with a specific version
+func NewUMLProviderWithVersion(version string) *UMLProvider {
+	return &UMLProvider{
+		version:                version,
+		classResource:          resources.NewClassResource(),
+		interfaceResource:      resources.NewInterfaceResource(),
+		enumResource:           resources.NewEnumResource(),
+		packageResource:        resources.NewPackageResource(),
+		noteResource:           resources.NewNoteResource(),
+		associationResource:    resources.NewAssociationResource(),
+		aggregationResource:    resources.NewAggregationResource(),
+		compositionResource:    resources.NewCompositionResource(),
+		generalizationResource: resources.NewGeneralizationResource(),
+		realizationResource:    resources.NewRealizationResource(),
+		dependencyResource:     resources.NewDependencyResource(),
+		classTemplate:          templates.NewClassTemplate(),
+		interfaceTemplate:      templates.NewInterfaceTemplate(),
+		enumTemplate:           templates.NewEnumTemplate(),
+		packageTemplate:        templates.NewPackageTemplate(),
+		noteTemplate:           templates.NewNoteTemplate(),
+		associationTemplate:    templates.NewAssociationTemplate(),
+		aggregationTemplate:    templates.NewAggregationTemplate(),
+		compositionTemplate:    templates.NewCompositionTemplate(),
+		generalizationTemplate: templates.NewGeneralizationTemplate(),
+		realizationTemplate:    templates.NewRealizationTemplate(),
+		dependencyTemplate:     templates.NewDependencyTemplate(),
+	}
+}
+
+// Name returns the provider name
+func (p *UMLProvider) Name() string {
+	return "uml"
+}
+
+// Version returns the provider version
+func (p *UMLProvider) Version() string {
+	return p.version
+}
+
+// Resources returns the list of supported UML resources
+func (p *UMLProvider) Resources() []providers.ResourceDefinition {
+	return []providers.ResourceDefinition{
+		p.classResource.Definition(),
+		p.interfaceResource.Definition(),
+		p.enumResource.Definition(),
+		p.packageResource.Definition(),
+		p.noteResource.Definition(),
+		p.associationResource.Definition(),
+		p.aggregationResource.Definition(),
+		p.compositionResource.Definition(),
+		p.generalizationResource.Definition(),
+		p.realizationResource.Definition(),
+		p.dependencyResource.Definition(),
+	}
+}
+
+// Validate validates UML resource parameters
+func (p *UMLProvider) Validate(resourceType string, params map[string]interface{}) error {
+	switch resourceType {
+	case "class":
+		return p.classResource.Validate(params)
+	case "interface":
+		return p.interfaceResource.Validate(params)
+	case "enum":
+		return p.enumResource.Validate(params)
+	case "package":
+		return p.packageResource.Validate(params)
+	case "note":
+		return p.noteResource.Validate(params)
+	case "association":
+		return p.associationResource.Validate(params)
+	case "aggregation":
+		return p.aggregationResource.Validate(params)
+	case "composition":
+		return p.compositionResource.Validate(params)
+	case "generalization":
+		return p.generalizationResource.Validate(params)
+	case "realization":
+		return p.realizationResource.Validate(params)
+	case "dependency":
+		return p.dependencyResource.Validate(params)
+	default:
+		return p.unsupportedResource(resourceType)
+	}
+}
+
+// GenerateTemplate generates UML resource templates
+func (p *UMLProvider) GenerateTemplate(resourceType string, params map[string]interface{}) (*schema.Element, error) {
+	if err := p.Validate(resourceType, params); err != nil {
+		return nil, err
+	}
+
+	switch resourceType {
+	case "class":
+		return p.classTemplate.Generate(params)
+	case "interface":
+		return p.interfaceTemplate.Generate(params)
+	case "enum":
+		return p.enumTemplate.Generate(params)
+	case "package":
+		return p.packageTemplate.Generate(params)
+	case "note":
+		return p.noteTemplate.Generate(params)
+	case "association":
+		return p.associationTemplate.Generate(params)
+	case "aggregation":
+		return p.aggregationTemplate.Generate(params)
+	case "composition":
+		return p.compositionTemplate.Generate(params)
+	case "generalization":
+		return p.generalizationTemplate.Generate(params)
+	case "realization":
+		return p.realizationTemplate.Generate(params)
+	case "dependency":
+		return p.dependencyTemplate.Generate(params)
+	default:
+		return nil, p.unsupportedResource(resourceType)
+	}
+}
+
+// GetSchema returns the JSON schema for a resource type
+func (p *UMLProvider) GetSchema(resourceType string) (map[string]interface{}, error) {
+	for _, resource := range p.Resources() {
+		if resource.Type == resourceType {
+			return resource.Schema, nil
+		}
+	}
+
+	return nil, &providers.ProviderError{
+		Provider: p.Name(),
+		Resource: resourceType,
+		Message:  fmt.Sprintf("schema not found for resource type: %s", resourceType),
+		Code:     "SCHEMA_NOT_FOUND",
+	}
+}
+
+// GetVersion returns the provider version
+func (p *UMLProvider) GetVersion() string {
+	return p.version
+}
+
+// unsupportedResource returns the error for resource types the provider does not support
+func (p *UMLProvider) unsupportedResource(resourceType string) error {
+	return &providers.ProviderError{
+		Provider: p.Name(),
+		Resource: resourceType,
+		Message:  fmt.Sprintf("unsupported resource type: %s", resourceType),
+		Code:     "UNSUPPORTED_RESOURCE",
+	}
+}
@@ -0,0 +1,164 @@
+package uml
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/drawio"
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+func TestUMLProvider_Basic(t *testing.T) {
+	provider := NewUMLProvider()
+
+	if provider.Name() != "uml" {
+		t.Errorf("Expected provider name 'uml', got '%s'", provider.Name())
+	}
+
+	if provider.Version() != "dev" {
+		t.Errorf("Expected version 'dev', got '%s'", provider.Version())
+	}
+
+	expected := []string{
+		"class", "interface", "enum", "package", "note",
+		"association", "aggregation", "composition", "generalization", "realization", "dependency",
+	}
+	resources := provider.Resources()
+	if len(resources) != len(expected) {
+		t.Fatalf("Expected %d resources, got %d", len(expected), len(resources))
+	}
+
+	for i, resourceType := range expected {
+		if resources[i].Type != resourceType {
+			t.Errorf("Expected resource %d to be '%s', got '%s'", i, resourceType, resources[i].Type)
+		}
+	}
+}
+
+func TestUMLProvider_Conformance(t *testing.T) {
+	providertest.Run(t, NewUMLProvider())
+}
+
+func TestUMLProvider_Examples(t *testing.T) {
+	provider := NewUMLProvider()
+
+	for _, resource := range provider.Resources() {
+		for _, example := range resource.Examples {
+			t.Run(resource.Type+"/"+example.Name, func(t *testing.T) {
+				element, err := provider.GenerateTemplate(resource.Type, example.Config)
+				if err != nil {
+					t.Fatalf("GenerateTemplate() error = %v", err)
+				}
+
+				switch resource.Category {
+				case "relationships":
+					if element.Type != schema.ElementTypeConnector || element.Properties.Source == "" || element.Properties.Target == "" {
+						t.Error("Expected a connector between source and target")
+					}
+				case "classifiers":
+					if element.Type != schema.ElementTypeSwimLane || element.Style.Custom["childLayout"] != "stackLayout" {
+						t.Error("Expected a stacked swimlane classifier")
+					}
+					if len(element.Children) == 0 {
+						t.Error("Expected one child row per member")
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestUMLProvider_RelationshipArrows(t *testing.T) {
+	provider := NewUMLProvider()
+	params := map[string]interface{}{"source": "a", "target": "b"}
+
+	tests := []struct {
+		resourceType string
+		endArrow     string
+		endFill      string
+		dashed       bool
+	}{
+		{resourceType: "association", endArrow: "none"},
+		{resourceType: "aggregation", endArrow: "diamondThin", endFill: "0"},
+		{resourceType: "composition", endArrow: "diamondThin", endFill: "1"},
+		{resourceType: "generalization", endArrow: "block", endFill: "0"},
+		{resourceType: "realization", endArrow: "block", endFill: "0", dashed: true},
+		{resourceType: "dependency", endArrow: "open", dashed: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.resourceType, func(t *testing.T) {
+			element, err := provider.GenerateTemplate(tt.resourceType, params)
+			if err != nil {
+				t.Fatalf("GenerateTemplate() error = %v", err)
+			}
+
+			if element.Style.Custom["endArrow"] != tt.endArrow || element.Style.Custom["endFill"] != tt.endFill {
+				t.Errorf("Expected endArrow=%s endFill=%s, got %v", tt.endArrow, tt.endFill, element.Style.Custom)
+			}
+			if (element.Style.StrokeDashArray != "") != tt.dashed {
+				t.Errorf("Expected dashed=%v, got '%s'", tt.dashed, element.Style.StrokeDashArray)
+			}
+		})
+	}
+}
+
+func TestUMLProvider_UnsupportedResource(t *testing.T) {
+	provider := NewUMLProvider()
+
+	if err := provider.Validate("actor", nil); err == nil {
+		t.Error("Expected error for unsupported resource type")
+	}
+
+	if _, err := provider.GenerateTemplate("actor", nil); err == nil {
+		t.Error("Expected error for unsupported resource type")
+	}
+
+	if _, err := provider.GetSchema("actor"); err == nil {
+		t.Error("Expected error for unsupported resource schema")
+	}
+}
+
+func TestUMLProvider_RelationshipInPackage(t *testing.T) {
+	provider := NewUMLProvider()
+
+	generate := func(resourceType, id string, params map[string]interface{}) schema.Element {
+		element, err := provider.GenerateTemplate(resourceType, params)
+		if err != nil {
+			t.Fatalf("GenerateTemplate(%s) error = %v", resourceType, err)
+		}
+		element.ID, element.Name = id, id
+		return *element
+	}
+
+	pkg := generate("package", "orders", map[string]interface{}{"label": "orders"})
+	pkg.Children = append(pkg.Children,
+		generate("class", "order", map[string]interface{}{"label": "Order"}),
+		generate("class", "line", map[string]interface{}{"label": "Line"}),
+		generate("association", "lines", map[string]interface{}{"source": "order", "target": "line", "sourceMultiplicity": "1", "targetMultiplicity": "*"}),
+	)
+	config := &schema.DiagramConfig{
+		Diagram: schema.Diagram{Pages: []schema.Page{{ID: "model", Name: "Model", Elements: []schema.Element{pkg}}}},
+	}
+
+	document, err := drawio.NewGenerator().Generate(config)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	// Multiplicities stay at the ends of the relationship, also within a package
+	want := map[string]float64{"model/orders/lines/source-multiplicity": -1, "model/orders/lines/target-multiplicity": 1}
+	for _, cell := range document.Diagram[0].GraphModel.Root.Cells {
+		x, exists := want[cell.ID]
+		if !exists {
+			continue
+		}
+		if cell.Geometry == nil || cell.Geometry.Relative != "1" || cell.Geometry.X != x || cell.Geometry.Y != 0 {
+			t.Errorf("Expected %s at relative x=%v, got %+v", cell.ID, x, cell.Geometry)
+		}
+		delete(want, cell.ID)
+	}
+	for id := range want {
+		t.Errorf("Expected a cell %s", id)
+	}
+}
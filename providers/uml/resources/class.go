@@ -0,0 +1,58 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// ClassResource defines the UML Class resource
+type ClassResource struct{}
+
+// NewClassResource creates a new Class resource instance
+func NewClassResource() *ClassResource {
+	return &ClassResource{}
+}
+
+// Definition returns the resource definition for Class elements
+func (r *ClassResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "class",
+		Name:        "Class",
+		Description: "Class with attribute and method compartments",
+		Category:    "classifiers",
+		Schema: classifierSchema("Class", map[string]interface{}{
+			"attributes": memberSchema("Attributes"),
+			"methods":    memberSchema("Methods"),
+			"abstract": map[string]interface{}{
+				"type":        "boolean",
+				"description": "Whether the class is abstract, shown in italics",
+				"default":     false,
+			},
+			"stereotype": map[string]interface{}{
+				"type":        "string",
+				"description": "Stereotype shown above the name, e.g. \"entity\"",
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Class",
+				Description: "Class with attributes and methods",
+				Config: map[string]interface{}{
+					"label": "Order",
+					"attributes": []interface{}{
+						"- id: UUID",
+						map[string]interface{}{"name": "status", "type": "OrderStatus", "visibility": "private"},
+					},
+					"methods": []interface{}{
+						"+ place(): void",
+						map[string]interface{}{"name": "count()", "type": "int", "static": true},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Validate validates Class parameters
+func (r *ClassResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
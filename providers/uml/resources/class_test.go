@@ -0,0 +1,34 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestClassResource_Members(t *testing.T) {
+	resource := NewClassResource()
+
+	err := resource.Validate(map[string]interface{}{
+		"attributes": []interface{}{"- id: UUID", map[string]interface{}{"name": "total", "type": "Money", "visibility": "protected"}},
+		"methods":    []interface{}{"+ pay(): void", map[string]interface{}{"name": "count()", "type": "int", "static": true}},
+		"abstract":   true,
+	})
+	if err != nil {
+		t.Errorf("Expected class with string and object members to be valid, got %v", err)
+	}
+
+	// String members start with a visibility marker, object members name themselves
+	err = resource.Validate(map[string]interface{}{"attributes": []interface{}{"id: UUID"}})
+	if code := providertest.ValidationCode(err, "attributes[0]"); code != "INVALID_FORMAT" {
+		t.Errorf("Expected INVALID_FORMAT for an attribute without visibility, got %q", code)
+	}
+	err = resource.Validate(map[string]interface{}{"attributes": []interface{}{"+ id", map[string]interface{}{"type": "int"}}})
+	if code := providertest.ValidationCode(err, "attributes[1].name"); code != "REQUIRED" {
+		t.Errorf("Expected REQUIRED for an attribute without name, got %q", code)
+	}
+	err = resource.Validate(map[string]interface{}{"methods": []interface{}{map[string]interface{}{"name": "pay()", "visibility": "internal"}}})
+	if code := providertest.ValidationCode(err, "methods[0].visibility"); code != "INVALID_ENUM" {
+		t.Errorf("Expected INVALID_ENUM for method visibility internal, got %q", code)
+	}
+}
@@ -0,0 +1,70 @@
+package resources
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// classifierSchema builds the JSON schema of a class, interface or enum, whose height
+// follows from its members
+func classifierSchema(defaultLabel string, properties map[string]interface{}) map[string]interface{} {
+	schema := providers.ResourceSchema(defaultLabel, 160, 0, properties)
+	delete(schema["properties"].(map[string]interface{}), "height")
+	return schema
+}
+
+// memberSchema returns the JSON schema of a list of class members. A member is either a
+// string, which must match the pattern, or an object, which must match the properties.
+func memberSchema(description string) map[string]interface{} {
+	return map[string]interface{}{
+		"type":        "array",
+		"description": description + `, either "+ name: Type" strings or objects with name, type, visibility and static`,
+		"items": map[string]interface{}{
+			"type":    []string{"string", "object"},
+			"pattern": memberPattern,
+			"properties": map[string]interface{}{
+				"name":       map[string]interface{}{"type": "string", "minLength": 1},
+				"type":       map[string]interface{}{"type": "string"},
+				"visibility": map[string]interface{}{"type": "string", "enum": visibilities, "default": "public"},
+				"static":     map[string]interface{}{"type": "boolean", "default": false},
+			},
+			"required": []string{"name"},
+		},
+	}
+}
+
+// visibilities are the UML member visibilities
+var visibilities = []string{"public", "private", "protected", "package"}
+
+// memberPattern matches members starting with a visibility marker
+const memberPattern = `^[-+#~]\s*\S`
+
+// multiplicityPattern matches multiplicities such as "1", "*", "0..1" and "1..*"
+var multiplicityPattern = regexp.MustCompile(`^(\*|[0-9]+)(\.\.(\*|[0-9]+))?$`)
+
+// validateMultiplicity validates that the lower bound of an optional multiplicity range is a
+// number no larger than the upper bound, which the JSON schema cannot express
+func validateMultiplicity(params map[string]interface{}, field string) error {
+	value, ok := params[field].(string)
+	if !ok {
+		return nil
+	}
+
+	match := multiplicityPattern.FindStringSubmatch(value)
+	if match == nil || match[3] == "" {
+		return nil
+	}
+
+	lower, err := strconv.Atoi(match[1])
+	if upper, upperErr := strconv.Atoi(match[3]); err != nil || (upperErr == nil && lower > upper) {
+		return &providers.ValidationError{
+			Field:   field,
+			Message: fmt.Sprintf(`invalid multiplicity %q, expected a value like "1", "*", "0..1" or "1..*"`, value),
+			Code:    "INVALID_FORMAT",
+		}
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// EnumResource defines the UML Enumeration resource
+type EnumResource struct{}
+
+// NewEnumResource creates a new Enumeration resource instance
+func NewEnumResource() *EnumResource {
+	return &EnumResource{}
+}
+
+// Definition returns the resource definition for Enumeration elements
+func (r *EnumResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "enum",
+		Name:        "Enumeration",
+		Description: "Enumeration with a literal compartment",
+		Category:    "classifiers",
+		Schema: classifierSchema("Enumeration", map[string]interface{}{
+			"values": map[string]interface{}{
+				"type":        "array",
+				"description": "Enumeration literals",
+				"items":       map[string]interface{}{"type": "string", "minLength": 1},
+			},
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Enumeration",
+				Description: "Order status",
+				Config: map[string]interface{}{
+					"label":  "OrderStatus",
+					"values": []interface{}{"PENDING", "PAID", "SHIPPED"},
+				},
+			},
+		},
+	}
+}
+
+// Validate validates Enumeration parameters
+func (r *EnumResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
@@ -0,0 +1,24 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestEnumResource_Values(t *testing.T) {
+	resource := NewEnumResource()
+
+	if err := resource.Validate(map[string]interface{}{"values": []interface{}{"PAID", "SHIPPED"}}); err != nil {
+		t.Errorf("Expected enumeration with literals to be valid, got %v", err)
+	}
+
+	err := resource.Validate(map[string]interface{}{"values": []interface{}{"PAID", 2}})
+	if code := providertest.ValidationCode(err, "values[1]"); code != "INVALID_TYPE" {
+		t.Errorf("Expected INVALID_TYPE for a numeric literal, got %q", code)
+	}
+	err = resource.Validate(map[string]interface{}{"values": []interface{}{""}})
+	if code := providertest.ValidationCode(err, "values[0]"); code != "REQUIRED" {
+		t.Errorf("Expected REQUIRED for an empty literal, got %q", code)
+	}
+}
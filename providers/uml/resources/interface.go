@@ -0,0 +1,41 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// InterfaceResource defines the UML Interface resource
+type InterfaceResource struct{}
+
+// NewInterfaceResource creates a new Interface resource instance
+func NewInterfaceResource() *InterfaceResource {
+	return &InterfaceResource{}
+}
+
+// Definition returns the resource definition for Interface elements
+func (r *InterfaceResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "interface",
+		Name:        "Interface",
+		Description: "Interface with a method compartment",
+		Category:    "classifiers",
+		Schema: classifierSchema("Interface", map[string]interface{}{
+			"methods": memberSchema("Methods"),
+		}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Interface",
+				Description: "Repository interface",
+				Config: map[string]interface{}{
+					"label":   "OrderRepository",
+					"methods": []interface{}{"+ find(id: UUID): Order", "+ save(order: Order): void"},
+				},
+			},
+		},
+	}
+}
+
+// Validate validates Interface parameters
+func (r *InterfaceResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
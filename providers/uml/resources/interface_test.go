@@ -0,0 +1,24 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestInterfaceResource_Methods(t *testing.T) {
+	resource := NewInterfaceResource()
+
+	if err := resource.Validate(map[string]interface{}{"methods": []interface{}{"+ find(): Order"}}); err != nil {
+		t.Errorf("Expected interface with a public method to be valid, got %v", err)
+	}
+
+	err := resource.Validate(map[string]interface{}{"methods": []interface{}{"find(): Order"}})
+	if code := providertest.ValidationCode(err, "methods[0]"); code != "INVALID_FORMAT" {
+		t.Errorf("Expected INVALID_FORMAT for a method without visibility, got %q", code)
+	}
+	err = resource.Validate(map[string]interface{}{"methods": []interface{}{map[string]interface{}{"name": "find()", "static": "yes"}}})
+	if code := providertest.ValidationCode(err, "methods[0].static"); code != "INVALID_TYPE" {
+		t.Errorf("Expected INVALID_TYPE for a non-boolean static flag, got %q", code)
+	}
+}
@@ -0,0 +1,38 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// NoteResource defines the UML Note resource
+type NoteResource struct{}
+
+// NewNoteResource creates a new Note resource instance
+func NewNoteResource() *NoteResource {
+	return &NoteResource{}
+}
+
+// Definition returns the resource definition for Note elements
+func (r *NoteResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "note",
+		Name:        "Note",
+		Description: "Comment with a folded corner",
+		Category:    "annotations",
+		Schema:      providers.ResourceSchema("", 160, 80, map[string]interface{}{}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Note",
+				Description: "Design remark",
+				Config: map[string]interface{}{
+					"label": "Orders are immutable once paid",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates Note parameters
+func (r *NoteResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
@@ -0,0 +1,38 @@
+package resources
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// PackageResource defines the UML Package resource
+type PackageResource struct{}
+
+// NewPackageResource creates a new Package resource instance
+func NewPackageResource() *PackageResource {
+	return &PackageResource{}
+}
+
+// Definition returns the resource definition for Package elements
+func (r *PackageResource) Definition() providers.ResourceDefinition {
+	return providers.ResourceDefinition{
+		Type:        "package",
+		Name:        "Package",
+		Description: "Package grouping classifiers and nested packages",
+		Category:    "packages",
+		Schema:      providers.ResourceSchema("Package", 300, 200, map[string]interface{}{}),
+		Examples: []providers.ResourceExample{
+			{
+				Name:        "Package",
+				Description: "Domain package",
+				Config: map[string]interface{}{
+					"label": "shop.domain",
+				},
+			},
+		},
+	}
+}
+
+// Validate validates Package parameters
+func (r *PackageResource) Validate(params map[string]interface{}) error {
+	return providers.ValidateParams(r.Definition().Schema, params)
+}
@@ -0,0 +1,121 @@
+package resources
+
+import (
+	"fmt"
+
+	"github.com/LederWorks/hippodamus/pkg/providers"
+)
+
+// RelationshipResource defines a UML relationship between two classifiers
+type RelationshipResource struct {
+	resourceType string
+	name         string
+	description  string
+	multiplicity bool // Whether the relationship has multiplicities at its ends
+}
+
+// NewAssociationResource creates a new Association resource instance
+func NewAssociationResource() *RelationshipResource {
+	return &RelationshipResource{resourceType: "association", name: "Association", description: "Structural relationship between two classifiers", multiplicity: true}
+}
+
+// NewAggregationResource creates a new Aggregation resource instance
+func NewAggregationResource() *RelationshipResource {
+	return &RelationshipResource{resourceType: "aggregation", name: "Aggregation", description: "Shared whole-part relationship, the target is the whole", multiplicity: true}
+}
+
+// NewCompositionResource creates a new Composition resource instance
+func NewCompositionResource() *RelationshipResource {
+	return &RelationshipResource{resourceType: "composition", name: "Composition", description: "Owning whole-part relationship, the target is the whole", multiplicity: true}
+}
+
+// NewGeneralizationResource creates a new Generalization resource instance
+func NewGeneralizationResource() *RelationshipResource {
+	return &RelationshipResource{resourceType: "generalization", name: "Generalization", description: "Inheritance from the target classifier"}
+}
+
+// NewRealizationResource creates a new Realization resource instance
+func NewRealizationResource() *RelationshipResource {
+	return &RelationshipResource{resourceType: "realization", name: "Realization", description: "Implementation of the target interface"}
+}
+
+// NewDependencyResource creates a new Dependency resource instance
+func NewDependencyResource() *RelationshipResource {
+	return &RelationshipResource{resourceType: "dependency", name: "Dependency", description: "Usage of the target classifier"}
+}
+
+// Definition returns the resource definition for the relationship
+func (r *RelationshipResource) Definition() providers.ResourceDefinition {
+	properties := map[string]interface{}{
+		"source": map[string]interface{}{
+			"type":        "string",
+			"description": "Source element ID",
+			"minLength":   1,
+		},
+		"target": map[string]interface{}{
+			"type":        "string",
+			"description": "Target element ID",
+			"minLength":   1,
+		},
+		"label": map[string]interface{}{
+			"type":        "string",
+			"description": "Relationship name",
+		},
+	}
+	config := map[string]interface{}{
+		"source": "order",
+		"target": "customer",
+	}
+
+	if r.multiplicity {
+		for _, end := range []string{"source", "target"} {
+			properties[end+"Multiplicity"] = map[string]interface{}{
+				"type":        "string",
+				"description": fmt.Sprintf("Multiplicity at the %s end, e.g. \"0..*\"", end),
+				"pattern":     multiplicityPattern.String(),
+			}
+		}
+		config["sourceMultiplicity"] = "0..*"
+		config["targetMultiplicity"] = "1"
+	}
+
+	return providers.ResourceDefinition{
+		Type:        r.resourceType,
+		Name:        r.name,
+		Description: r.description,
+		Category:    "relationships",
+		Schema: map[string]interface{}{
+			"type":       "object",
+			"properties": properties,
+			"required":   []string{"source", "target"},
+		},
+		Examples: []providers.ResourceExample{
+			{
+				Name:        r.name,
+				Description: fmt.Sprintf("%s between two classes", r.name),
+				Config:      config,
+			},
+		},
+	}
+}
+
+// Validate validates the relationship parameters
+func (r *RelationshipResource) Validate(params map[string]interface{}) error {
+	if err := providers.ValidateParams(r.Definition().Schema, params); err != nil {
+		return err
+	}
+
+	for _, field := range []string{"sourceMultiplicity", "targetMultiplicity"} {
+		if _, exists := params[field]; exists && !r.multiplicity {
+			return &providers.ValidationError{
+				Field:   field,
+				Message: fmt.Sprintf("%s relationships have no multiplicities", r.resourceType),
+				Code:    "NOT_SUPPORTED",
+			}
+		}
+		if err := validateMultiplicity(params, field); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/providers/providertest"
+)
+
+func TestRelationshipResource_Multiplicity(t *testing.T) {
+	resource := NewAssociationResource()
+
+	for _, multiplicity := range []string{"1", "*", "0..1", "1..*", "2..5"} {
+		if err := resource.Validate(map[string]interface{}{"source": "a", "target": "b", "sourceMultiplicity": multiplicity}); err != nil {
+			t.Errorf("Expected multiplicity %s to be valid, got %v", multiplicity, err)
+		}
+	}
+
+	// The schema pattern accepts ranges the multiplicity check rejects
+	for _, multiplicity := range []string{"many", "5..2", "*..2"} {
+		err := resource.Validate(map[string]interface{}{"source": "a", "target": "b", "targetMultiplicity": multiplicity})
+		if code := providertest.ValidationCode(err, "targetMultiplicity"); code != "INVALID_FORMAT" {
+			t.Errorf("Expected INVALID_FORMAT for multiplicity %s, got %q", multiplicity, code)
+		}
+	}
+}
+
+func TestRelationshipResource_WithoutMultiplicity(t *testing.T) {
+	for _, resource := range []*RelationshipResource{NewGeneralizationResource(), NewRealizationResource(), NewDependencyResource()} {
+		err := resource.Validate(map[string]interface{}{"source": "a", "target": "b", "sourceMultiplicity": "1"})
+		if code := providertest.ValidationCode(err, "sourceMultiplicity"); code != "NOT_SUPPORTED" {
+			t.Errorf("Expected NOT_SUPPORTED for %s multiplicity, got %q", resource.Definition().Type, code)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// ClassTemplate handles template generation for Class elements
+type ClassTemplate struct{}
+
+// NewClassTemplate creates a new Class template generator
+func NewClassTemplate() *ClassTemplate {
+	return &ClassTemplate{}
+}
+
+// Generate creates a schema.Element from Class parameters
+func (t *ClassTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	return newClassifier(params, classifierSpec{
+		label:      "Class",
+		stereotype: getStringParam(params, "stereotype", ""),
+		abstract:   getBoolParam(params, "abstract", false),
+		compartments: [][]row{
+			memberRows(params, "attributes"),
+			memberRows(params, "methods"),
+		},
+	}), nil
+}
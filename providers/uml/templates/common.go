@@ -0,0 +1,259 @@
+package templates
+
+import (
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// UML diagram colors
+const (
+	colorFill       = "#FFFFFF"
+	colorStroke     = "#000000"
+	colorFont       = "#000000"
+	colorNoteFill   = "#FFF2CC"
+	colorNoteStroke = "#D6B656"
+)
+
+// Classifier layout in pixels
+const (
+	headerSize           = 26 // Height of the name compartment
+	stereotypeHeaderSize = 40 // Height of the name compartment with a stereotype line
+	rowHeight            = 26 // Height of an attribute, method or literal row
+	separatorHeight      = 8  // Height of the line between compartments
+	defaultClassWidth    = 160
+)
+
+// visibilityMarkers maps member visibilities to their UML notation
+var visibilityMarkers = map[string]string{
+	"public":    "+",
+	"private":   "-",
+	"protected": "#",
+	"package":   "~",
+}
+
+// row is a single line in a classifier compartment
+type row struct {
+	text   string
+	static bool // Static members are underlined
+}
+
+// classifierSpec describes the appearance of a class, interface or enumeration
+type classifierSpec struct {
+	label        string // Default classifier name
+	stereotype   string // Stereotype shown above the name, without guillemets
+	abstract     bool   // Abstract classifiers have their name in italics
+	compartments [][]row
+}
+
+// newClassifier creates a classifier as a stack of compartments: the name, followed by
+// one row per attribute, method or literal with a separator line between compartments
+func newClassifier(params map[string]interface{}, spec classifierSpec) *schema.Element {
+	width := getFloatParam(params, "width", defaultClassWidth)
+
+	label := html.EscapeString(getStringParam(params, "label", spec.label))
+	startSize := float64(headerSize)
+	if spec.stereotype != "" {
+		label = fmt.Sprintf("«%s»<br>%s", html.EscapeString(spec.stereotype), label)
+		startSize = stereotypeHeaderSize
+	}
+
+	fontStyle := "1"
+	if spec.abstract {
+		fontStyle = "3"
+	}
+
+	var children []schema.Element
+	y := startSize
+	for i, compartment := range spec.compartments {
+		if i > 0 {
+			children = append(children, newSeparator(fmt.Sprintf("separator-%d", i), y, width))
+			y += separatorHeight
+		}
+		if len(compartment) == 0 {
+			// Empty compartments keep a small gap so both separators stay visible
+			y += separatorHeight
+			continue
+		}
+		for j, r := range compartment {
+			children = append(children, newRow(fmt.Sprintf("row-%d-%d", i, j), r, y, width))
+			y += rowHeight
+		}
+	}
+
+	return &schema.Element{
+		Type: schema.ElementTypeSwimLane,
+		Properties: schema.ElementProperties{
+			X:      getFloatParam(params, "x", 0),
+			Y:      getFloatParam(params, "y", 0),
+			Width:  width,
+			Height: y,
+			Label:  label,
+			Shape:  "swimlane",
+		},
+		Style: schema.Style{
+			FillColor:   getStringParam(params, "fillColor", colorFill),
+			StrokeColor: getStringParam(params, "strokeColor", colorStroke),
+			FontColor:   getStringParam(params, "fontColor", colorFont),
+			FontStyle:   fontStyle,
+			Custom: map[string]string{
+				"html":            "1",
+				"whiteSpace":      "wrap",
+				"childLayout":     "stackLayout",
+				"horizontal":      "1",
+				"startSize":       fmt.Sprintf("%g", startSize),
+				"horizontalStack": "0",
+				"resizeParent":    "1",
+				"resizeParentMax": "0",
+				"resizeLast":      "0",
+				"collapsible":     "0",
+				"marginBottom":    "0",
+			},
+		},
+		// Rows are positioned by the classifier, below the name compartment
+		Nesting: schema.NestingConfig{
+			Mode:        schema.NestingModeChild,
+			Arrangement: schema.ArrangementFree,
+		},
+		Children: children,
+	}
+}
+
+// newRow creates a compartment row
+func newRow(id string, r row, y, width float64) schema.Element {
+	fontStyle := "0"
+	if r.static {
+		fontStyle = "4"
+	}
+
+	return schema.Element{
+		ID:   id,
+		Type: schema.ElementTypeText,
+		Properties: schema.ElementProperties{
+			Y:      y,
+			Width:  width,
+			Height: rowHeight,
+			Label:  html.EscapeString(r.text),
+		},
+		Style: schema.Style{
+			FillColor:     "none",
+			StrokeColor:   "none",
+			FontStyle:     fontStyle,
+			TextAlign:     "left",
+			VerticalAlign: "top",
+			Custom: map[string]string{
+				"spacingLeft":    "4",
+				"spacingRight":   "4",
+				"overflow":       "hidden",
+				"rotatable":      "0",
+				"portConstraint": "eastwest",
+				"whiteSpace":     "wrap",
+			},
+		},
+	}
+}
+
+// newSeparator creates the line between two compartments
+func newSeparator(id string, y, width float64) schema.Element {
+	return schema.Element{
+		ID:   id,
+		Type: schema.ElementTypeShape,
+		Properties: schema.ElementProperties{
+			Y:      y,
+			Width:  width,
+			Height: separatorHeight,
+			Label:  " ",
+			Shape:  "line",
+		},
+		Style: schema.Style{
+			FillColor:     "none",
+			StrokeColor:   "inherit",
+			TextAlign:     "left",
+			VerticalAlign: "middle",
+			LabelPosition: "right",
+			Custom: map[string]string{
+				"spacingTop":     "-1",
+				"spacingLeft":    "3",
+				"spacingRight":   "3",
+				"rotatable":      "0",
+				"points":         "[]",
+				"portConstraint": "eastwest",
+			},
+		},
+	}
+}
+
+// memberRows converts a list of members to compartment rows. Members are either strings
+// already in UML notation or maps with name, type, visibility and static.
+func memberRows(params map[string]interface{}, key string) []row {
+	list, ok := params[key].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	rows := make([]row, 0, len(list))
+	for _, item := range list {
+		switch member := item.(type) {
+		case string:
+			rows = append(rows, row{text: member})
+		case map[string]interface{}:
+			marker := visibilityMarkers[getStringParam(member, "visibility", "public")]
+			text := fmt.Sprintf("%s %s", marker, getStringParam(member, "name", ""))
+			if memberType := getStringParam(member, "type", ""); memberType != "" {
+				text += ": " + memberType
+			}
+			rows = append(rows, row{text: text, static: getBoolParam(member, "static", false)})
+		}
+	}
+	return rows
+}
+
+// stringRows converts a list of strings to compartment rows
+func stringRows(params map[string]interface{}, key string) []row {
+	list, ok := params[key].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	rows := make([]row, 0, len(list))
+	for _, item := range list {
+		if text, ok := item.(string); ok {
+			rows = append(rows, row{text: strings.TrimSpace(text)})
+		}
+	}
+	return rows
+}
+
+func getStringParam(params map[string]interface{}, key, defaultValue string) string {
+	if val, ok := params[key]; ok {
+		if str, ok := val.(string); ok {
+			return str
+		}
+	}
+	return defaultValue
+}
+
+func getFloatParam(params map[string]interface{}, key string, defaultValue float64) float64 {
+	if val, ok := params[key]; ok {
+		switch v := val.(type) {
+		case float64:
+			return v
+		case int:
+			return float64(v)
+		case int64:
+			return float64(v)
+		}
+	}
+	return defaultValue
+}
+
+func getBoolParam(params map[string]interface{}, key string, defaultValue bool) bool {
+	if val, ok := params[key]; ok {
+		if b, ok := val.(bool); ok {
+			return b
+		}
+	}
+	return defaultValue
+}
@@ -0,0 +1,86 @@
+package templates
+
+import (
+	"testing"
+
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+func TestClassTemplate_Compartments(t *testing.T) {
+	class, err := NewClassTemplate().Generate(map[string]interface{}{
+		"label":      "Order",
+		"abstract":   true,
+		"attributes": []interface{}{"- id: UUID", map[string]interface{}{"name": "count", "type": "int", "static": true}},
+		"methods":    []interface{}{map[string]interface{}{"name": "pay()", "visibility": "protected"}},
+	})
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	if class.Style.FontStyle != "3" {
+		t.Errorf("Expected bold italic name for an abstract class, got fontStyle %s", class.Style.FontStyle)
+	}
+
+	want := []struct {
+		label string
+		y     float64
+	}{
+		{label: "- id: UUID", y: 26},
+		{label: "+ count: int", y: 52},
+		{label: " ", y: 78},
+		{label: "# pay()", y: 86},
+	}
+	if len(class.Children) != len(want) {
+		t.Fatalf("Expected %d rows, got %d", len(want), len(class.Children))
+	}
+	for i, row := range want {
+		child := class.Children[i]
+		if child.Properties.Label != row.label || child.Properties.Y != row.y {
+			t.Errorf("Expected row %d '%s' at %v, got '%s' at %v", i, row.label, row.y, child.Properties.Label, child.Properties.Y)
+		}
+	}
+
+	if class.Children[1].Style.FontStyle != "4" {
+		t.Error("Expected static member to be underlined")
+	}
+	if class.Properties.Height != 112 {
+		t.Errorf("Expected height 112, got %v", class.Properties.Height)
+	}
+	if class.Nesting.Arrangement != schema.ArrangementFree {
+		t.Errorf("Expected rows to keep their positions, got %s", class.Nesting.Arrangement)
+	}
+}
+
+func TestInterfaceTemplate_Stereotype(t *testing.T) {
+	iface, _ := NewInterfaceTemplate().Generate(map[string]interface{}{"label": "Repository<T>"})
+
+	if iface.Properties.Label != "«interface»<br>Repository&lt;T&gt;" {
+		t.Errorf("Expected stereotype above the escaped name, got '%s'", iface.Properties.Label)
+	}
+	if iface.Style.Custom["startSize"] != "40" {
+		t.Errorf("Expected taller header for the stereotype, got %s", iface.Style.Custom["startSize"])
+	}
+}
+
+func TestRelationshipTemplate_Multiplicity(t *testing.T) {
+	association, _ := NewAssociationTemplate().Generate(map[string]interface{}{
+		"source":             "order",
+		"target":             "customer",
+		"navigable":          true,
+		"sourceMultiplicity": "0..*",
+		"targetMultiplicity": "1",
+	})
+
+	if association.Style.Custom["endArrow"] != "open" {
+		t.Errorf("Expected open arrow for a navigable association, got %s", association.Style.Custom["endArrow"])
+	}
+	if len(association.Children) != 2 {
+		t.Fatalf("Expected 2 multiplicity labels, got %d", len(association.Children))
+	}
+	if source := association.Children[0]; source.Properties.Label != "0..*" || source.Properties.X != -1 {
+		t.Errorf("Expected '0..*' at the source end, got '%s' at %v", source.Properties.Label, source.Properties.X)
+	}
+	if target := association.Children[1]; target.Properties.Label != "1" || target.Properties.X != 1 {
+		t.Errorf("Expected '1' at the target end, got '%s' at %v", target.Properties.Label, target.Properties.X)
+	}
+}
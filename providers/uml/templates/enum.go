@@ -0,0 +1,24 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// EnumTemplate handles template generation for Enumeration elements
+type EnumTemplate struct{}
+
+// NewEnumTemplate creates a new Enumeration template generator
+func NewEnumTemplate() *EnumTemplate {
+	return &EnumTemplate{}
+}
+
+// Generate creates a schema.Element from Enumeration parameters
+func (t *EnumTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	return newClassifier(params, classifierSpec{
+		label:      "Enumeration",
+		stereotype: "enumeration",
+		compartments: [][]row{
+			stringRows(params, "values"),
+		},
+	}), nil
+}
@@ -0,0 +1,24 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// InterfaceTemplate handles template generation for Interface elements
+type InterfaceTemplate struct{}
+
+// NewInterfaceTemplate creates a new Interface template generator
+func NewInterfaceTemplate() *InterfaceTemplate {
+	return &InterfaceTemplate{}
+}
+
+// Generate creates a schema.Element from Interface parameters
+func (t *InterfaceTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	return newClassifier(params, classifierSpec{
+		label:      "Interface",
+		stereotype: "interface",
+		compartments: [][]row{
+			memberRows(params, "methods"),
+		},
+	}), nil
+}
@@ -0,0 +1,41 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// NoteTemplate handles template generation for Note elements
+type NoteTemplate struct{}
+
+// NewNoteTemplate creates a new Note template generator
+func NewNoteTemplate() *NoteTemplate {
+	return &NoteTemplate{}
+}
+
+// Generate creates a schema.Element from Note parameters
+func (t *NoteTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	return &schema.Element{
+		Type: schema.ElementTypeShape,
+		Properties: schema.ElementProperties{
+			X:      getFloatParam(params, "x", 0),
+			Y:      getFloatParam(params, "y", 0),
+			Width:  getFloatParam(params, "width", 160),
+			Height: getFloatParam(params, "height", 80),
+			Label:  getStringParam(params, "label", ""),
+			Shape:  "note",
+		},
+		Style: schema.Style{
+			FillColor:     getStringParam(params, "fillColor", colorNoteFill),
+			StrokeColor:   getStringParam(params, "strokeColor", colorNoteStroke),
+			FontColor:     getStringParam(params, "fontColor", colorFont),
+			TextAlign:     "left",
+			VerticalAlign: "top",
+			Custom: map[string]string{
+				"html":        "1",
+				"whiteSpace":  "wrap",
+				"size":        "14",
+				"spacingLeft": "6",
+			},
+		},
+	}, nil
+}
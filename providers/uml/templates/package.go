@@ -0,0 +1,60 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// PackageTemplate handles template generation for Package elements
+type PackageTemplate struct{}
+
+// NewPackageTemplate creates a new Package template generator
+func NewPackageTemplate() *PackageTemplate {
+	return &PackageTemplate{}
+}
+
+// Generate creates a schema.Element from Package parameters
+func (t *PackageTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	return &schema.Element{
+		Type: schema.ElementTypeShape,
+		Properties: schema.ElementProperties{
+			X:      getFloatParam(params, "x", 0),
+			Y:      getFloatParam(params, "y", 0),
+			Width:  getFloatParam(params, "width", 300),
+			Height: getFloatParam(params, "height", 200),
+			Label:  getStringParam(params, "label", "Package"),
+			Shape:  "folder",
+		},
+		Style: schema.Style{
+			FillColor:     getStringParam(params, "fillColor", colorFill),
+			StrokeColor:   getStringParam(params, "strokeColor", colorStroke),
+			FontColor:     getStringParam(params, "fontColor", colorFont),
+			FontStyle:     "1",
+			TextAlign:     "left",
+			VerticalAlign: "top",
+			Custom: map[string]string{
+				"html":        "1",
+				"whiteSpace":  "wrap",
+				"container":   "1",
+				"collapsible": "0",
+				"tabWidth":    "80",
+				"tabHeight":   "20",
+				"tabPosition": "left",
+				"spacingTop":  "20",
+				"spacingLeft": "10",
+			},
+		},
+		// Classifiers and nested packages are laid out side by side below the tab
+		Nesting: schema.NestingConfig{
+			Mode:        schema.NestingModeChild,
+			AutoResize:  true,
+			Arrangement: schema.ArrangementHorizontal,
+			Spacing:     60,
+			Padding: schema.Padding{
+				Top:    60,
+				Right:  20,
+				Bottom: 20,
+				Left:   20,
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,107 @@
+package templates
+
+import (
+	"github.com/LederWorks/hippodamus/pkg/schema"
+)
+
+// RelationshipTemplate handles template generation for UML relationships
+type RelationshipTemplate struct {
+	arrow     map[string]string // Arrow head style at the target
+	dashed    bool
+	navigable bool // Whether navigable: true adds an open arrow head
+}
+
+// NewAssociationTemplate creates a new Association template generator
+func NewAssociationTemplate() *RelationshipTemplate {
+	return &RelationshipTemplate{arrow: map[string]string{"endArrow": "none"}, navigable: true}
+}
+
+// NewAggregationTemplate creates a new Aggregation template generator
+func NewAggregationTemplate() *RelationshipTemplate {
+	return &RelationshipTemplate{arrow: map[string]string{"endArrow": "diamondThin", "endFill": "0", "endSize": "24"}}
+}
+
+// NewCompositionTemplate creates a new Composition template generator
+func NewCompositionTemplate() *RelationshipTemplate {
+	return &RelationshipTemplate{arrow: map[string]string{"endArrow": "diamondThin", "endFill": "1", "endSize": "24"}}
+}
+
+// NewGeneralizationTemplate creates a new Generalization template generator
+func NewGeneralizationTemplate() *RelationshipTemplate {
+	return &RelationshipTemplate{arrow: map[string]string{"endArrow": "block", "endFill": "0", "endSize": "16"}}
+}
+
+// NewRealizationTemplate creates a new Realization template generator
+func NewRealizationTemplate() *RelationshipTemplate {
+	return &RelationshipTemplate{arrow: map[string]string{"endArrow": "block", "endFill": "0", "endSize": "16"}, dashed: true}
+}
+
+// NewDependencyTemplate creates a new Dependency template generator
+func NewDependencyTemplate() *RelationshipTemplate {
+	return &RelationshipTemplate{arrow: map[string]string{"endArrow": "open", "endSize": "12"}, dashed: true}
+}
+
+// Generate creates a schema.Element from relationship parameters
+func (t *RelationshipTemplate) Generate(params map[string]interface{}) (*schema.Element, error) {
+	custom := map[string]string{
+		"html":     "1",
+		"rounded":  "0",
+		"metaEdit": "1",
+	}
+	for key, value := range t.arrow {
+		custom[key] = value
+	}
+	if t.navigable && getBoolParam(params, "navigable", false) {
+		custom["endArrow"] = "open"
+		custom["endSize"] = "12"
+	}
+
+	element := &schema.Element{
+		Type: schema.ElementTypeConnector,
+		Properties: schema.ElementProperties{
+			Source: getStringParam(params, "source", ""),
+			Target: getStringParam(params, "target", ""),
+			Label:  getStringParam(params, "label", ""),
+		},
+		Style: schema.Style{
+			StrokeColor: getStringParam(params, "strokeColor", colorStroke),
+			FontColor:   colorFont,
+			FontSize:    11,
+			Custom:      custom,
+		},
+	}
+	if t.dashed {
+		element.Style.StrokeDashArray = "8 4"
+	}
+
+	// Multiplicities sit at the ends of the relationship, just above the line
+	if multiplicity := getStringParam(params, "sourceMultiplicity", ""); multiplicity != "" {
+		element.Children = append(element.Children, newMultiplicity("source-multiplicity", multiplicity, -1, "left"))
+	}
+	if multiplicity := getStringParam(params, "targetMultiplicity", ""); multiplicity != "" {
+		element.Children = append(element.Children, newMultiplicity("target-multiplicity", multiplicity, 1, "right"))
+	}
+
+	return element, nil
+}
+
+// newMultiplicity creates a label at one end of a relationship, where position is -1 at
+// the source and 1 at the target
+func newMultiplicity(id, multiplicity string, position float64, align string) schema.Element {
+	return schema.Element{
+		ID:   id,
+		Type: schema.ElementTypeText,
+		Properties: schema.ElementProperties{
+			X:     position,
+			Label: multiplicity,
+		},
+		Style: schema.Style{
+			FontSize:      11,
+			TextAlign:     align,
+			VerticalAlign: "bottom",
+			Custom: map[string]string{
+				"html": "1",
+			},
+		},
+	}
+}